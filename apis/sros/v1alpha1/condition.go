@@ -0,0 +1,208 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition Kinds specific to the sros provider.
+const (
+	// handled per resource
+	ConditionKindValueValidation nddv1.ConditionKind = "ValueValidationSuccess"
+
+	// handled per resource, the reconciler resets the message of the parent
+	// validation condition, the missing parents are reported in their own
+	// condition
+	ConditionKindParentDependency nddv1.ConditionKind = "ParentDependencyAvailable"
+
+	// handled per lag, a mismatch of the member ports does not delete the lag
+	ConditionKindMemberValidation nddv1.ConditionKind = "MemberValidationSuccess"
+
+	// handled per port-xc, a network node has a single port-xc owner
+	ConditionKindPortXcOwner nddv1.ConditionKind = "PortXcOwner"
+
+	// handled by the deviation server for a registration
+	ConditionKindTargetConnected nddv1.ConditionKind = "TargetConnected"
+
+	// handled per resource when connecting to the network node
+	ConditionKindCredentials nddv1.ConditionKind = "CredentialsAvailable"
+)
+
+// Condition Reasons specific to the sros provider.
+const (
+	// the resource is available on a part of the network nodes
+	ConditionReasonPartiallyAvailable nddv1.ConditionReason = "PartiallyAvailable"
+)
+
+// ValueValidationSuccess returns a condition that indicates all leaf values of
+// the resource are within the constraints of the SR OS yang model
+func ValueValidationSuccess() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindValueValidation,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonSuccess,
+	}
+}
+
+// ValueValidationFailure returns a condition that indicates leaf values of
+// the resource violate the constraints of the SR OS yang model, the message
+// contains the violating leaf paths
+func ValueValidationFailure(msg string) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindValueValidation,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonFailed,
+		Message:            msg,
+	}
+}
+
+// ParentDependencyAvailable returns a condition that indicates the parents the
+// resource depends on exist on the device
+func ParentDependencyAvailable() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindParentDependency,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonSuccess,
+	}
+}
+
+// ParentDependencyMissing returns a condition that indicates a parent the
+// resource depends on does not exist on the device, the message contains the
+// missing parent
+func ParentDependencyMissing(msg string) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindParentDependency,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonFailed,
+		Message:            msg,
+	}
+}
+
+// MemberValidationSuccess returns a condition that indicates the member ports
+// of the lag exist on the device and match the mode and encap-type of the lag
+func MemberValidationSuccess() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindMemberValidation,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonSuccess,
+	}
+}
+
+// MemberValidationFailure returns a condition that indicates member ports of
+// the lag do not exist on the device or do not match the lag, the message
+// contains the failing member ports
+func MemberValidationFailure(msg string) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindMemberValidation,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonFailed,
+		Message:            msg,
+	}
+}
+
+// PortXcOwner returns a condition that indicates the resource owns the port-xc
+// of the network node
+func PortXcOwner() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindPortXcOwner,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonSuccess,
+	}
+}
+
+// PortXcOwnedByOther returns a condition that indicates the port-xc of the
+// network node is owned by another resource, the message contains the owner
+func PortXcOwnedByOther(msg string) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindPortXcOwner,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonFailed,
+		Message:            msg,
+	}
+}
+
+// PartiallyAvailable returns a Ready condition that indicates the resource is
+// available on a part of the network nodes, the message contains the network
+// nodes that failed
+func PartiallyAvailable(msg string) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               nddv1.ConditionKindReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ConditionReasonPartiallyAvailable,
+		Message:            msg,
+	}
+}
+
+// TargetConnected returns a condition that indicates the subscriptions to the
+// device drivers of all network nodes of the resource are connected
+func TargetConnected() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindTargetConnected,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonSuccess,
+	}
+}
+
+// TargetDisconnected returns a condition that indicates the subscriptions to
+// the device drivers of network nodes of the resource are disconnected, the
+// message contains the network nodes that are retried
+func TargetDisconnected(msg string) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindTargetConnected,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonFailed,
+		Message:            msg,
+	}
+}
+
+// CredentialsAvailable returns a condition that indicates the credentials of
+// the network node of the resource are available
+func CredentialsAvailable() nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindCredentials,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonSuccess,
+	}
+}
+
+// CredentialsMissing returns a condition that indicates the credentials of the
+// network node of the resource are missing, the message contains the missing
+// secret or secret key
+func CredentialsMissing(msg string) nddv1.Condition {
+	return nddv1.Condition{
+		Kind:               ConditionKindCredentials,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             nddv1.ConditionReasonFailed,
+		Message:            msg,
+	}
+}
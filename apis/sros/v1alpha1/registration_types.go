@@ -48,14 +48,69 @@ type RegistrationParameters struct {
 	ExplicitExceptionPaths []string `json:"explicitExceptionPaths,omitempty"`
 }
 
+// RegistrationState is the state of the registration on a network node.
+type RegistrationState string
+
+const (
+	// RegistrationStateRegistered indicates the device driver of the network
+	// node has the registration of the spec
+	RegistrationStateRegistered RegistrationState = "Registered"
+	// RegistrationStateOutdated indicates the registration of the device driver
+	// is missing or differs from the spec
+	RegistrationStateOutdated RegistrationState = "Outdated"
+	// RegistrationStateFailed indicates the last request to the device driver
+	// of the network node failed
+	RegistrationStateFailed RegistrationState = "Failed"
+)
+
+// RegistrationTargetStatus is the registration status of a network node.
+type RegistrationTargetStatus struct {
+	// Name of the network node
+	Name string `json:"name"`
+
+	// State of the registration on the network node
+	State RegistrationState `json:"state"`
+
+	// LastError is the error of the last failed request to the device driver
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// Registered are the paths the device driver has registered
+	// +optional
+	Registered RegistrationParameters `json:"registered,omitempty"`
+
+	// LastUpdateTime is the last time the state, the error or the registered
+	// paths changed
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
 // RegistrationObservation are the observable fields of a Registration.
 type RegistrationObservation struct {
+	// Targets holds the registration status of every network node the
+	// Registration applies to
+	// +optional
+	Targets []RegistrationTargetStatus `json:"targets,omitempty"`
 }
 
 // A RegistrationSpec defines the desired state of a Registration.
 type RegistrationSpec struct {
 	nddv1.ResourceSpec `json:",inline"`
-	ForNetworkNode     RegistrationParameters `json:"forNetworkNode"`
+
+	// NetworkNodeSelector selects the network nodes the Registration applies to
+	// by their labels, all network nodes are selected when it is not set. The
+	// device driver of a network node holds a single registration of the device
+	// type, so Registrations must select disjoint sets of network nodes.
+	// +optional
+	NetworkNodeSelector *metav1.LabelSelector `json:"networkNodeSelector,omitempty"`
+
+	// SwVersion restricts the Registration to the network nodes that run an SR OS
+	// release matching all comma separated constraints, e.g. ">=21.7, <22".
+	// Network nodes whose software version is unknown do not match.
+	// +optional
+	SwVersion *string `json:"swVersion,omitempty"`
+
+	ForNetworkNode RegistrationParameters `json:"forNetworkNode"`
 }
 
 // A RegistrationStatus represents the observed state of a Registration.
@@ -71,6 +126,7 @@ type RegistrationStatus struct {
 // +kubebuilder:printcolumn:name="TARGET",type="string",JSONPath=".status.conditions[?(@.kind=='TargetFound')].status"
 // +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="CONNECTED",type="string",JSONPath=".status.conditions[?(@.kind=='TargetConnected')].status"
 // +kubebuilder:printcolumn:name="LOCALLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='InternalLeafrefValidationSuccess')].status"
 // +kubebuilder:printcolumn:name="EXTLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='ExternalLeafrefValidationSuccess')].status"
 // +kubebuilder:printcolumn:name="PARENTDEP",type="string",JSONPath=".status.conditions[?(@.kind=='ParentValidationSuccess')].status"
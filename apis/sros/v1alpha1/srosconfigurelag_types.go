@@ -0,0 +1,201 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ConfigureLagFinalizer is the name of the finalizer added to
+	// ConfigureLag to block delete operations until the physical node can be
+	// deprovisioned.
+	ConfigureLagFinalizer string = "lag.sros.ndd.yndd.io"
+)
+
+// ConfigureLag struct
+type ConfigureLag struct {
+	AdminState         *string                  `json:"admin-state,omitempty"`
+	ApplyGroups        *string                  `json:"apply-groups,omitempty"`
+	ApplyGroupsExclude *string                  `json:"apply-groups-exclude,omitempty"`
+	BfdLiveness        *ConfigureLagBfdLiveness `json:"bfd-liveness,omitempty"`
+	Description        *string                  `json:"description,omitempty"`
+	// +kubebuilder:validation:Enum=`dot1q`;`null`;`qinq`
+	EncapType *string           `json:"encap-type,omitempty"`
+	Lacp      *ConfigureLagLacp `json:"lacp,omitempty"`
+	// +kubebuilder:validation:Enum=`fast`;`slow`
+	// +kubebuilder:default:="fast"
+	LacpXmitInterval *string `json:"lacp-xmit-interval,omitempty"`
+	// +kubebuilder:default:=true
+	LacpXmitStdby *bool `json:"lacp-xmit-stdby,omitempty"`
+	// +kubebuilder:validation:Required
+	LagName *string `json:"lag-name,omitempty"`
+	// kubebuilder:validation:Minimum=1
+	// kubebuilder:validation:Maximum=64
+	MaxPorts *uint32 `json:"max-ports,omitempty"`
+	// +kubebuilder:validation:Enum=`access`;`hybrid`;`network`
+	Mode *string             `json:"mode,omitempty"`
+	Port []*ConfigureLagPort `json:"port,omitempty"`
+	// +kubebuilder:validation:Enum=`lacp`;`power-off`
+	// +kubebuilder:default:="lacp"
+	StandbySignaling *string `json:"standby-signaling,omitempty"`
+}
+
+// ConfigureLagBfdLiveness struct
+type ConfigureLagBfdLiveness struct {
+	Ipv4 *ConfigureLagBfdLivenessIpv4 `json:"ipv4,omitempty"`
+	Ipv6 *ConfigureLagBfdLivenessIpv6 `json:"ipv6,omitempty"`
+}
+
+// ConfigureLagBfdLivenessIpv4 struct
+type ConfigureLagBfdLivenessIpv4 struct {
+	AdminState     *string `json:"admin-state,omitempty"`
+	LocalIpAddress *string `json:"local-ip-address,omitempty"`
+	// kubebuilder:validation:Minimum=3
+	// kubebuilder:validation:Maximum=20
+	// +kubebuilder:default:=3
+	Multiplier *uint32 `json:"multiplier,omitempty"`
+	// kubebuilder:validation:Minimum=10
+	// kubebuilder:validation:Maximum=100000
+	// +kubebuilder:default:=100
+	ReceiveInterval *uint32 `json:"receive-interval,omitempty"`
+	RemoteIpAddress *string `json:"remote-ip-address,omitempty"`
+	// kubebuilder:validation:Minimum=10
+	// kubebuilder:validation:Maximum=100000
+	// +kubebuilder:default:=100
+	TransmitInterval *uint32 `json:"transmit-interval,omitempty"`
+}
+
+// ConfigureLagBfdLivenessIpv6 struct
+type ConfigureLagBfdLivenessIpv6 struct {
+	AdminState     *string `json:"admin-state,omitempty"`
+	LocalIpAddress *string `json:"local-ip-address,omitempty"`
+	// kubebuilder:validation:Minimum=3
+	// kubebuilder:validation:Maximum=20
+	// +kubebuilder:default:=3
+	Multiplier *uint32 `json:"multiplier,omitempty"`
+	// kubebuilder:validation:Minimum=10
+	// kubebuilder:validation:Maximum=100000
+	// +kubebuilder:default:=100
+	ReceiveInterval *uint32 `json:"receive-interval,omitempty"`
+	RemoteIpAddress *string `json:"remote-ip-address,omitempty"`
+	// kubebuilder:validation:Minimum=10
+	// kubebuilder:validation:Maximum=100000
+	// +kubebuilder:default:=100
+	TransmitInterval *uint32 `json:"transmit-interval,omitempty"`
+}
+
+// ConfigureLagLacp struct
+type ConfigureLagLacp struct {
+	// kubebuilder:validation:Minimum=1
+	// kubebuilder:validation:Maximum=65535
+	AdministrativeKey *uint32 `json:"administrative-key,omitempty"`
+	// +kubebuilder:validation:Enum=`active`;`passive`
+	Mode *string `json:"mode,omitempty"`
+	// +kubebuilder:validation:Pattern=`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`
+	SystemId *string `json:"system-id,omitempty"`
+	// kubebuilder:validation:Minimum=0
+	// kubebuilder:validation:Maximum=65535
+	SystemPriority *uint32 `json:"system-priority,omitempty"`
+}
+
+// ConfigureLagPort struct
+type ConfigureLagPort struct {
+	HashWeight *string `json:"hash-weight,omitempty"`
+	PortId     *string `json:"port-id,omitempty"`
+	// kubebuilder:validation:Minimum=1
+	// kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default:=32768
+	Priority *uint32 `json:"priority,omitempty"`
+	// kubebuilder:validation:Minimum=1
+	// kubebuilder:validation:Maximum=8
+	SubGroup *uint32 `json:"sub-group,omitempty"`
+}
+
+// ConfigureLagParameters are the parameter fields of a ConfigureLag.
+type ConfigureLagParameters struct {
+	// +kubebuilder:validation:Required
+	SrosConfigureLag *ConfigureLag `json:"lag,omitempty"`
+}
+
+// ConfigureLagObservation are the observable fields of a ConfigureLag, they
+// are read from the state of the lag on the network node.
+type ConfigureLagObservation struct {
+	OperState *string `json:"oper-state,omitempty"`
+	// ActiveMembers are the member ports that carry traffic
+	ActiveMembers []string `json:"active-members,omitempty"`
+	// StandbyMembers are the member ports that do not carry traffic
+	StandbyMembers []string `json:"standby-members,omitempty"`
+}
+
+// A ConfigureLagSpec defines the desired state of a ConfigureLag.
+type ConfigureLagSpec struct {
+	nddv1.ResourceSpec `json:",inline"`
+	ForNetworkNode     ConfigureLagParameters `json:"forNetworkNode"`
+}
+
+// A ConfigureLagStatus represents the observed state of a ConfigureLag.
+type ConfigureLagStatus struct {
+	nddv1.ResourceStatus `json:",inline"`
+	AtNetworkNode        ConfigureLagObservation `json:"atNetworkNode,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigureLag is the Schema for the ConfigureLag API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="TARGET",type="string",JSONPath=".status.conditions[?(@.kind=='TargetFound')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCALLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='InternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="EXTLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='ExternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="PARENTDEP",type="string",JSONPath=".status.conditions[?(@.kind=='ParentValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="OPERSTATE",type="string",JSONPath=".status.atNetworkNode.oper-state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl}
+type SrosConfigureLag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigureLagSpec   `json:"spec,omitempty"`
+	Status ConfigureLagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigureLagList contains a list of ConfigureLags
+type SrosConfigureLagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrosConfigureLag `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrosConfigureLag{}, &SrosConfigureLagList{})
+}
+
+// ConfigureLag type metadata.
+var (
+	ConfigureLagKind             = reflect.TypeOf(SrosConfigureLag{}).Name()
+	ConfigureLagGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigureLagKind}.String()
+	ConfigureLagKindAPIVersion   = ConfigureLagKind + "." + GroupVersion.String()
+	ConfigureLagGroupVersionKind = GroupVersion.WithKind(ConfigureLagKind)
+)
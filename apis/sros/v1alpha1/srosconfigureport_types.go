@@ -48,13 +48,14 @@ type ConfigurePort struct {
 	HybridBufferAllocation *ConfigurePortHybridBufferAllocation `json:"hybrid-buffer-allocation,omitempty"`
 	ModifyBufferAllocation *ConfigurePortModifyBufferAllocation `json:"modify-buffer-allocation,omitempty"`
 	// +kubebuilder:default:=false
-	MonitorAggEgressQueueStats *bool                     `json:"monitor-agg-egress-queue-stats,omitempty"`
-	Network                    *ConfigurePortNetwork     `json:"network,omitempty"`
-	Otu                        *ConfigurePortOtu         `json:"otu,omitempty"`
-	PortId                     *string                   `json:"port-id,omitempty"`
-	SonetSdh                   *ConfigurePortSonetSdh    `json:"sonet-sdh,omitempty"`
-	Tdm                        *ConfigurePortTdm         `json:"tdm,omitempty"`
-	Transceiver                *ConfigurePortTransceiver `json:"transceiver,omitempty"`
+	MonitorAggEgressQueueStats *bool                 `json:"monitor-agg-egress-queue-stats,omitempty"`
+	Network                    *ConfigurePortNetwork `json:"network,omitempty"`
+	Otu                        *ConfigurePortOtu     `json:"otu,omitempty"`
+	// +kubebuilder:validation:Required
+	PortId      *string                   `json:"port-id,omitempty"`
+	SonetSdh    *ConfigurePortSonetSdh    `json:"sonet-sdh,omitempty"`
+	Tdm         *ConfigurePortTdm         `json:"tdm,omitempty"`
+	Transceiver *ConfigurePortTransceiver `json:"transceiver,omitempty"`
 }
 
 // ConfigurePortAccess struct
@@ -144,7 +145,8 @@ type ConfigurePortConnector struct {
 	ApplyGroups        *string `json:"apply-groups,omitempty"`
 	ApplyGroupsExclude *string `json:"apply-groups-exclude,omitempty"`
 	// +kubebuilder:validation:Enum=`c1-100g`;`c1-10g`;`c1-25g`;`c1-400g`;`c1-40g`;`c1-50g`;`c10-10g`;`c2-100g`;`c4-100g`;`c4-10g`;`c4-25g`;`c8-50g`
-	Breakout *string `json:"breakout,omitempty"`
+	Breakout   *string `json:"breakout,omitempty"`
+	PortPolicy *string `json:"port-policy,omitempty"`
 	// +kubebuilder:validation:Enum=`cl91-514-528`;`cl91-514-544`
 	RsFecMode *string `json:"rs-fec-mode,omitempty"`
 }
@@ -3400,41 +3402,51 @@ type ConfigurePortTransceiver struct {
 	DigitalCoherentOptics *bool `json:"digital-coherent-optics,omitempty"`
 }
 
-// ConfigurePortPolicy struct
-type ConfigurePortPolicy struct {
-	ApplyGroups               *string `json:"apply-groups,omitempty"`
-	ApplyGroupsExclude        *string `json:"apply-groups-exclude,omitempty"`
-	Description               *string `json:"description,omitempty"`
-	EgressPortSchedulerPolicy *string `json:"egress-port-scheduler-policy,omitempty"`
-	Name                      *string `json:"name,omitempty"`
-}
-
-// ConfigurePortXc struct
-type ConfigurePortXc struct {
-	ApplyGroups        *string               `json:"apply-groups,omitempty"`
-	ApplyGroupsExclude *string               `json:"apply-groups-exclude,omitempty"`
-	Pxc                []*ConfigurePortXcPxc `json:"pxc,omitempty"`
-}
-
-// ConfigurePortXcPxc struct
-type ConfigurePortXcPxc struct {
-	AdminState         *string `json:"admin-state,omitempty"`
-	ApplyGroups        *string `json:"apply-groups,omitempty"`
-	ApplyGroupsExclude *string `json:"apply-groups-exclude,omitempty"`
-	Description        *string `json:"description,omitempty"`
-	PortId             *string `json:"port-id,omitempty"`
-	// kubebuilder:validation:Minimum=1
-	// kubebuilder:validation:Maximum=64
-	PxcId *uint32 `json:"pxc-id,omitempty"`
-}
-
 // ConfigurePortParameters are the parameter fields of a ConfigurePort.
 type ConfigurePortParameters struct {
+	// +kubebuilder:validation:Required
 	SrosConfigurePort *ConfigurePort `json:"port,omitempty"`
 }
 
-// ConfigurePortObservation are the observable fields of a ConfigurePort.
+// ConfigurePortObservation are the observable fields of a ConfigurePort, they
+// are read from the state of the port on the network node.
 type ConfigurePortObservation struct {
+	OperState *string `json:"oper-state,omitempty"`
+	// OperSpeed is the actual speed of the port in Mbps
+	OperSpeed  *uint32 `json:"oper-speed,omitempty"`
+	OperDuplex *string `json:"oper-duplex,omitempty"`
+	OperMtu    *uint32 `json:"oper-mtu,omitempty"`
+	// LastOperChange is the time the oper state of the port last changed
+	LastOperChange *string                              `json:"last-oper-change,omitempty"`
+	Transceiver    *ConfigurePortObservationTransceiver `json:"transceiver,omitempty"`
+}
+
+// ConfigurePortObservationTransceiver struct
+type ConfigurePortObservationTransceiver struct {
+	Type                        *string                                 `json:"type,omitempty"`
+	DigitalDiagnosticMonitoring *ConfigurePortObservationTransceiverDdm `json:"digital-diagnostic-monitoring,omitempty"`
+}
+
+// ConfigurePortObservationTransceiverDdm holds the current digital diagnostic
+// monitoring readings of the transceiver
+type ConfigurePortObservationTransceiverDdm struct {
+	// Temperature in degrees Celsius
+	Temperature *string `json:"temperature,omitempty"`
+	// SupplyVoltage in Volts
+	SupplyVoltage *string                                       `json:"supply-voltage,omitempty"`
+	Lane          []*ConfigurePortObservationTransceiverDdmLane `json:"lane,omitempty"`
+}
+
+// ConfigurePortObservationTransceiverDdmLane holds the current digital
+// diagnostic monitoring readings of a lane of the transceiver
+type ConfigurePortObservationTransceiverDdmLane struct {
+	LaneId *uint32 `json:"lane-id,omitempty"`
+	// TransmitBiasCurrent in mA
+	TransmitBiasCurrent *string `json:"transmit-bias-current,omitempty"`
+	// TransmitOutputPower in dBm
+	TransmitOutputPower *string `json:"transmit-output-power,omitempty"`
+	// ReceiveOpticalPower in dBm
+	ReceiveOpticalPower *string `json:"receive-optical-power,omitempty"`
 }
 
 // A ConfigurePortSpec defines the desired state of a ConfigurePort.
@@ -3459,6 +3471,7 @@ type ConfigurePortStatus struct {
 // +kubebuilder:printcolumn:name="LOCALLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='InternalLeafrefValidationSuccess')].status"
 // +kubebuilder:printcolumn:name="EXTLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='ExternalLeafrefValidationSuccess')].status"
 // +kubebuilder:printcolumn:name="PARENTDEP",type="string",JSONPath=".status.conditions[?(@.kind=='ParentValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="OPERSTATE",type="string",JSONPath=".status.atNetworkNode.oper-state"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={ndd,srl}
 type SrosConfigurePort struct {
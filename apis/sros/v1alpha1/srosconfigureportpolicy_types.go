@@ -0,0 +1,105 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ConfigurePortPolicyFinalizer is the name of the finalizer added to
+	// ConfigurePortPolicy to block delete operations until the physical node
+	// can be deprovisioned.
+	ConfigurePortPolicyFinalizer string = "port-policy.sros.ndd.yndd.io"
+)
+
+// ConfigurePortPolicy struct
+type ConfigurePortPolicy struct {
+	ApplyGroups               *string `json:"apply-groups,omitempty"`
+	ApplyGroupsExclude        *string `json:"apply-groups-exclude,omitempty"`
+	Description               *string `json:"description,omitempty"`
+	EgressPortSchedulerPolicy *string `json:"egress-port-scheduler-policy,omitempty"`
+	// +kubebuilder:validation:Required
+	Name *string `json:"name,omitempty"`
+}
+
+// ConfigurePortPolicyParameters are the parameter fields of a ConfigurePortPolicy.
+type ConfigurePortPolicyParameters struct {
+	// +kubebuilder:validation:Required
+	SrosConfigurePortPolicy *ConfigurePortPolicy `json:"port-policy,omitempty"`
+}
+
+// ConfigurePortPolicyObservation are the observable fields of a ConfigurePortPolicy.
+type ConfigurePortPolicyObservation struct {
+}
+
+// A ConfigurePortPolicySpec defines the desired state of a ConfigurePortPolicy.
+type ConfigurePortPolicySpec struct {
+	nddv1.ResourceSpec `json:",inline"`
+	ForNetworkNode     ConfigurePortPolicyParameters `json:"forNetworkNode"`
+}
+
+// A ConfigurePortPolicyStatus represents the observed state of a ConfigurePortPolicy.
+type ConfigurePortPolicyStatus struct {
+	nddv1.ResourceStatus `json:",inline"`
+	AtNetworkNode        ConfigurePortPolicyObservation `json:"atNetworkNode,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigurePortPolicy is the Schema for the ConfigurePortPolicy API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="TARGET",type="string",JSONPath=".status.conditions[?(@.kind=='TargetFound')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCALLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='InternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="EXTLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='ExternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="PARENTDEP",type="string",JSONPath=".status.conditions[?(@.kind=='ParentValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl}
+type SrosConfigurePortPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurePortPolicySpec   `json:"spec,omitempty"`
+	Status ConfigurePortPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigurePortPolicyList contains a list of ConfigurePortPolicys
+type SrosConfigurePortPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrosConfigurePortPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrosConfigurePortPolicy{}, &SrosConfigurePortPolicyList{})
+}
+
+// ConfigurePortPolicy type metadata.
+var (
+	ConfigurePortPolicyKind             = reflect.TypeOf(SrosConfigurePortPolicy{}).Name()
+	ConfigurePortPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigurePortPolicyKind}.String()
+	ConfigurePortPolicyKindAPIVersion   = ConfigurePortPolicyKind + "." + GroupVersion.String()
+	ConfigurePortPolicyGroupVersionKind = GroupVersion.WithKind(ConfigurePortPolicyKind)
+)
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ConfigurePortXcFinalizer is the name of the finalizer added to
+	// ConfigurePortXc to block delete operations until the physical node can be
+	// deprovisioned.
+	ConfigurePortXcFinalizer string = "port-xc.sros.ndd.yndd.io"
+)
+
+// ConfigurePortXc struct
+type ConfigurePortXc struct {
+	ApplyGroups        *string               `json:"apply-groups,omitempty"`
+	ApplyGroupsExclude *string               `json:"apply-groups-exclude,omitempty"`
+	Pxc                []*ConfigurePortXcPxc `json:"pxc,omitempty"`
+}
+
+// ConfigurePortXcPxc struct
+type ConfigurePortXcPxc struct {
+	AdminState         *string `json:"admin-state,omitempty"`
+	ApplyGroups        *string `json:"apply-groups,omitempty"`
+	ApplyGroupsExclude *string `json:"apply-groups-exclude,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	PortId             *string `json:"port-id,omitempty"`
+	// kubebuilder:validation:Minimum=1
+	// kubebuilder:validation:Maximum=64
+	PxcId *uint32 `json:"pxc-id,omitempty"`
+}
+
+// ConfigurePortXcParameters are the parameter fields of a ConfigurePortXc.
+type ConfigurePortXcParameters struct {
+	// +kubebuilder:validation:Required
+	SrosConfigurePortXc *ConfigurePortXc `json:"port-xc,omitempty"`
+}
+
+// ConfigurePortXcObservation are the observable fields of a ConfigurePortXc.
+type ConfigurePortXcObservation struct {
+}
+
+// A ConfigurePortXcSpec defines the desired state of a ConfigurePortXc.
+type ConfigurePortXcSpec struct {
+	nddv1.ResourceSpec `json:",inline"`
+	ForNetworkNode     ConfigurePortXcParameters `json:"forNetworkNode"`
+}
+
+// A ConfigurePortXcStatus represents the observed state of a ConfigurePortXc.
+type ConfigurePortXcStatus struct {
+	nddv1.ResourceStatus `json:",inline"`
+	AtNetworkNode        ConfigurePortXcObservation `json:"atNetworkNode,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigurePortXc is the Schema for the ConfigurePortXc API, a network
+// node has a single SrosConfigurePortXc that owns the whole port-xc container.
+// Deleting it deletes /configure/port-xc, including the pxc entries that are
+// not in its spec.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="TARGET",type="string",JSONPath=".status.conditions[?(@.kind=='TargetFound')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCALLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='InternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="EXTLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='ExternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="PARENTDEP",type="string",JSONPath=".status.conditions[?(@.kind=='ParentValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl}
+type SrosConfigurePortXc struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurePortXcSpec   `json:"spec,omitempty"`
+	Status ConfigurePortXcStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigurePortXcList contains a list of ConfigurePortXcs
+type SrosConfigurePortXcList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrosConfigurePortXc `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrosConfigurePortXc{}, &SrosConfigurePortXcList{})
+}
+
+// ConfigurePortXc type metadata.
+var (
+	ConfigurePortXcKind             = reflect.TypeOf(SrosConfigurePortXc{}).Name()
+	ConfigurePortXcGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigurePortXcKind}.String()
+	ConfigurePortXcKindAPIVersion   = ConfigurePortXcKind + "." + GroupVersion.String()
+	ConfigurePortXcGroupVersionKind = GroupVersion.WithKind(ConfigurePortXcKind)
+)
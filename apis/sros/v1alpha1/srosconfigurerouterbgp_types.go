@@ -0,0 +1,221 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ConfigureRouterBgpFinalizer is the name of the finalizer added to
+	// ConfigureRouterBgp to block delete operations until the physical node can
+	// be deprovisioned.
+	ConfigureRouterBgpFinalizer string = "router-bgp.sros.ndd.yndd.io"
+)
+
+// ConfigureRouterBgp struct
+type ConfigureRouterBgp struct {
+	AdminState         *string `json:"admin-state,omitempty"`
+	ApplyGroups        *string `json:"apply-groups,omitempty"`
+	ApplyGroupsExclude *string `json:"apply-groups-exclude,omitempty"`
+	// kubebuilder:validation:Minimum=1
+	// kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default:=120
+	ConnectRetry *uint32                    `json:"connect-retry,omitempty"`
+	Description  *string                    `json:"description,omitempty"`
+	Export       *ConfigureRouterBgpExport  `json:"export,omitempty"`
+	Group        []*ConfigureRouterBgpGroup `json:"group,omitempty"`
+	Import       *ConfigureRouterBgpImport  `json:"import,omitempty"`
+	// kubebuilder:validation:Minimum=0
+	// kubebuilder:validation:Maximum=21845
+	// +kubebuilder:default:=30
+	Keepalive *uint32                       `json:"keepalive,omitempty"`
+	Neighbor  []*ConfigureRouterBgpNeighbor `json:"neighbor,omitempty"`
+	// +kubebuilder:validation:Pattern=`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])`
+	RouterId *string `json:"router-id,omitempty"`
+}
+
+// ConfigureRouterBgpExport struct
+type ConfigureRouterBgpExport struct {
+	// kubebuilder:validation:MaxItems=15
+	Policy []string `json:"policy,omitempty"`
+}
+
+// ConfigureRouterBgpGroup struct
+type ConfigureRouterBgpGroup struct {
+	AdminState  *string                        `json:"admin-state,omitempty"`
+	Description *string                        `json:"description,omitempty"`
+	Export      *ConfigureRouterBgpGroupExport `json:"export,omitempty"`
+	Family      *ConfigureRouterBgpGroupFamily `json:"family,omitempty"`
+	// +kubebuilder:validation:Required
+	GroupName    *string                        `json:"group-name,omitempty"`
+	Import       *ConfigureRouterBgpGroupImport `json:"import,omitempty"`
+	LocalAddress *string                        `json:"local-address,omitempty"`
+	// kubebuilder:validation:Minimum=1
+	// kubebuilder:validation:Maximum=4294967295
+	PeerAs *uint32 `json:"peer-as,omitempty"`
+	// +kubebuilder:validation:Enum=`external`;`internal`
+	Type *string `json:"type,omitempty"`
+}
+
+// ConfigureRouterBgpGroupExport struct
+type ConfigureRouterBgpGroupExport struct {
+	// kubebuilder:validation:MaxItems=15
+	Policy []string `json:"policy,omitempty"`
+}
+
+// ConfigureRouterBgpGroupFamily struct
+type ConfigureRouterBgpGroupFamily struct {
+	Evpn    *bool `json:"evpn,omitempty"`
+	Ipv4    *bool `json:"ipv4,omitempty"`
+	Ipv6    *bool `json:"ipv6,omitempty"`
+	VpnIpv4 *bool `json:"vpn-ipv4,omitempty"`
+	VpnIpv6 *bool `json:"vpn-ipv6,omitempty"`
+}
+
+// ConfigureRouterBgpGroupImport struct
+type ConfigureRouterBgpGroupImport struct {
+	// kubebuilder:validation:MaxItems=15
+	Policy []string `json:"policy,omitempty"`
+}
+
+// ConfigureRouterBgpImport struct
+type ConfigureRouterBgpImport struct {
+	// kubebuilder:validation:MaxItems=15
+	Policy []string `json:"policy,omitempty"`
+}
+
+// ConfigureRouterBgpNeighbor struct
+type ConfigureRouterBgpNeighbor struct {
+	AdminState  *string                           `json:"admin-state,omitempty"`
+	Description *string                           `json:"description,omitempty"`
+	Export      *ConfigureRouterBgpNeighborExport `json:"export,omitempty"`
+	Family      *ConfigureRouterBgpNeighborFamily `json:"family,omitempty"`
+	Group       *string                           `json:"group,omitempty"`
+	Import      *ConfigureRouterBgpNeighborImport `json:"import,omitempty"`
+	// +kubebuilder:validation:Required
+	IpAddress    *string `json:"ip-address,omitempty"`
+	LocalAddress *string `json:"local-address,omitempty"`
+	// kubebuilder:validation:Minimum=1
+	// kubebuilder:validation:Maximum=4294967295
+	PeerAs *uint32 `json:"peer-as,omitempty"`
+	// +kubebuilder:validation:Enum=`external`;`internal`
+	Type *string `json:"type,omitempty"`
+}
+
+// ConfigureRouterBgpNeighborExport struct
+type ConfigureRouterBgpNeighborExport struct {
+	// kubebuilder:validation:MaxItems=15
+	Policy []string `json:"policy,omitempty"`
+}
+
+// ConfigureRouterBgpNeighborFamily struct
+type ConfigureRouterBgpNeighborFamily struct {
+	Evpn    *bool `json:"evpn,omitempty"`
+	Ipv4    *bool `json:"ipv4,omitempty"`
+	Ipv6    *bool `json:"ipv6,omitempty"`
+	VpnIpv4 *bool `json:"vpn-ipv4,omitempty"`
+	VpnIpv6 *bool `json:"vpn-ipv6,omitempty"`
+}
+
+// ConfigureRouterBgpNeighborImport struct
+type ConfigureRouterBgpNeighborImport struct {
+	// kubebuilder:validation:MaxItems=15
+	Policy []string `json:"policy,omitempty"`
+}
+
+// ConfigureRouterBgpParameters are the parameter fields of a ConfigureRouterBgp.
+type ConfigureRouterBgpParameters struct {
+	// RouterName is the router instance of the bgp instance, e.g. Base
+	// +kubebuilder:validation:Required
+	RouterName *string `json:"router-name"`
+	// +kubebuilder:validation:Required
+	SrosConfigureRouterBgp *ConfigureRouterBgp `json:"bgp,omitempty"`
+}
+
+// ConfigureRouterBgpObservation are the observable fields of a
+// ConfigureRouterBgp, they are read from the state of the bgp instance on the
+// network node.
+type ConfigureRouterBgpObservation struct {
+	Neighbor []*ConfigureRouterBgpObservationNeighbor `json:"neighbor,omitempty"`
+}
+
+// ConfigureRouterBgpObservationNeighbor is the session state of a neighbor
+type ConfigureRouterBgpObservationNeighbor struct {
+	IpAddress *string `json:"ip-address,omitempty"`
+	// SessionState is the state of the bgp session, e.g. Established
+	SessionState *string `json:"session-state,omitempty"`
+	// LastState is the state of the bgp session before the current state
+	LastState *string `json:"last-state,omitempty"`
+}
+
+// A ConfigureRouterBgpSpec defines the desired state of a ConfigureRouterBgp.
+type ConfigureRouterBgpSpec struct {
+	nddv1.ResourceSpec `json:",inline"`
+	ForNetworkNode     ConfigureRouterBgpParameters `json:"forNetworkNode"`
+}
+
+// A ConfigureRouterBgpStatus represents the observed state of a ConfigureRouterBgp.
+type ConfigureRouterBgpStatus struct {
+	nddv1.ResourceStatus `json:",inline"`
+	AtNetworkNode        ConfigureRouterBgpObservation `json:"atNetworkNode,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigureRouterBgp is the Schema for the ConfigureRouterBgp API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="TARGET",type="string",JSONPath=".status.conditions[?(@.kind=='TargetFound')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCALLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='InternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="EXTLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='ExternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="PARENTDEP",type="string",JSONPath=".status.conditions[?(@.kind=='ParentValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl}
+type SrosConfigureRouterBgp struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigureRouterBgpSpec   `json:"spec,omitempty"`
+	Status ConfigureRouterBgpStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigureRouterBgpList contains a list of ConfigureRouterBgps
+type SrosConfigureRouterBgpList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrosConfigureRouterBgp `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrosConfigureRouterBgp{}, &SrosConfigureRouterBgpList{})
+}
+
+// ConfigureRouterBgp type metadata.
+var (
+	ConfigureRouterBgpKind             = reflect.TypeOf(SrosConfigureRouterBgp{}).Name()
+	ConfigureRouterBgpGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigureRouterBgpKind}.String()
+	ConfigureRouterBgpKindAPIVersion   = ConfigureRouterBgpKind + "." + GroupVersion.String()
+	ConfigureRouterBgpGroupVersionKind = GroupVersion.WithKind(ConfigureRouterBgpKind)
+)
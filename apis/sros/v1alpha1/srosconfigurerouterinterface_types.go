@@ -0,0 +1,201 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ConfigureRouterInterfaceFinalizer is the name of the finalizer added to
+	// ConfigureRouterInterface to block delete operations until the physical
+	// node can be deprovisioned.
+	ConfigureRouterInterfaceFinalizer string = "router-interface.sros.ndd.yndd.io"
+)
+
+// ConfigureRouterInterface struct
+type ConfigureRouterInterface struct {
+	AdminState         *string `json:"admin-state,omitempty"`
+	ApplyGroups        *string `json:"apply-groups,omitempty"`
+	ApplyGroupsExclude *string `json:"apply-groups-exclude,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	// +kubebuilder:validation:Required
+	InterfaceName *string `json:"interface-name,omitempty"`
+	// kubebuilder:validation:Minimum=512
+	// kubebuilder:validation:Maximum=9786
+	IpMtu    *uint32                       `json:"ip-mtu,omitempty"`
+	Ipv4     *ConfigureRouterInterfaceIpv4 `json:"ipv4,omitempty"`
+	Ipv6     *ConfigureRouterInterfaceIpv6 `json:"ipv6,omitempty"`
+	Loopback *bool                         `json:"loopback,omitempty"`
+	// Port binds the interface to a port or lag, optionally with an
+	// encapsulation value, e.g. 1/1/1, 1/1/1:100 or lag-1:100
+	Port *string                      `json:"port,omitempty"`
+	Qos  *ConfigureRouterInterfaceQos `json:"qos,omitempty"`
+}
+
+// ConfigureRouterInterfaceIpv4 struct
+type ConfigureRouterInterfaceIpv4 struct {
+	Bfd       *ConfigureRouterInterfaceIpv4Bfd         `json:"bfd,omitempty"`
+	Primary   *ConfigureRouterInterfaceIpv4Primary     `json:"primary,omitempty"`
+	Secondary []*ConfigureRouterInterfaceIpv4Secondary `json:"secondary,omitempty"`
+}
+
+// ConfigureRouterInterfaceIpv4Bfd struct
+type ConfigureRouterInterfaceIpv4Bfd struct {
+	AdminState *string `json:"admin-state,omitempty"`
+	// kubebuilder:validation:Minimum=3
+	// kubebuilder:validation:Maximum=20
+	// +kubebuilder:default:=3
+	Multiplier *uint32 `json:"multiplier,omitempty"`
+	// kubebuilder:validation:Minimum=10
+	// kubebuilder:validation:Maximum=100000
+	// +kubebuilder:default:=100
+	Receive *uint32 `json:"receive,omitempty"`
+	// kubebuilder:validation:Minimum=10
+	// kubebuilder:validation:Maximum=100000
+	// +kubebuilder:default:=100
+	TransmitInterval *uint32 `json:"transmit-interval,omitempty"`
+}
+
+// ConfigureRouterInterfaceIpv4Primary struct
+type ConfigureRouterInterfaceIpv4Primary struct {
+	// +kubebuilder:validation:Pattern=`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])`
+	Address *string `json:"address,omitempty"`
+	// kubebuilder:validation:Minimum=0
+	// kubebuilder:validation:Maximum=32
+	PrefixLength *uint8 `json:"prefix-length,omitempty"`
+}
+
+// ConfigureRouterInterfaceIpv4Secondary struct
+type ConfigureRouterInterfaceIpv4Secondary struct {
+	// +kubebuilder:validation:Pattern=`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])`
+	Address *string `json:"address,omitempty"`
+	// kubebuilder:validation:Minimum=0
+	// kubebuilder:validation:Maximum=32
+	PrefixLength *uint8 `json:"prefix-length,omitempty"`
+}
+
+// ConfigureRouterInterfaceIpv6 struct
+type ConfigureRouterInterfaceIpv6 struct {
+	Address []*ConfigureRouterInterfaceIpv6Address `json:"address,omitempty"`
+	Bfd     *ConfigureRouterInterfaceIpv6Bfd       `json:"bfd,omitempty"`
+}
+
+// ConfigureRouterInterfaceIpv6Address struct
+type ConfigureRouterInterfaceIpv6Address struct {
+	// +kubebuilder:validation:Pattern=`((:|[0-9a-fA-F]{0,4}):)([0-9a-fA-F]{0,4}:){0,5}((([0-9a-fA-F]{0,4}:)?(:|[0-9a-fA-F]{0,4}))|(((25[0-5]|2[0-4][0-9]|[01]?[0-9]?[0-9])\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9]?[0-9])))`
+	Ipv6Address *string `json:"ipv6-address,omitempty"`
+	// kubebuilder:validation:Minimum=0
+	// kubebuilder:validation:Maximum=128
+	PrefixLength *uint8 `json:"prefix-length,omitempty"`
+}
+
+// ConfigureRouterInterfaceIpv6Bfd struct
+type ConfigureRouterInterfaceIpv6Bfd struct {
+	AdminState *string `json:"admin-state,omitempty"`
+	// kubebuilder:validation:Minimum=3
+	// kubebuilder:validation:Maximum=20
+	// +kubebuilder:default:=3
+	Multiplier *uint32 `json:"multiplier,omitempty"`
+	// kubebuilder:validation:Minimum=10
+	// kubebuilder:validation:Maximum=100000
+	// +kubebuilder:default:=100
+	Receive *uint32 `json:"receive,omitempty"`
+	// kubebuilder:validation:Minimum=10
+	// kubebuilder:validation:Maximum=100000
+	// +kubebuilder:default:=100
+	TransmitInterval *uint32 `json:"transmit-interval,omitempty"`
+}
+
+// ConfigureRouterInterfaceQos struct
+type ConfigureRouterInterfaceQos struct {
+	NetworkPolicy *string `json:"network-policy,omitempty"`
+}
+
+// ConfigureRouterInterfaceParameters are the parameter fields of a ConfigureRouterInterface.
+type ConfigureRouterInterfaceParameters struct {
+	// RouterName is the router instance of a base router interface, e.g. Base,
+	// exactly one of router-name and service-name is set
+	// +kubebuilder:validation:Optional
+	RouterName *string `json:"router-name,omitempty"`
+	// ServiceName is the vprn service of a vprn interface
+	// +kubebuilder:validation:Optional
+	ServiceName *string `json:"service-name,omitempty"`
+	// +kubebuilder:validation:Required
+	SrosConfigureRouterInterface *ConfigureRouterInterface `json:"interface,omitempty"`
+}
+
+// ConfigureRouterInterfaceObservation are the observable fields of a ConfigureRouterInterface.
+type ConfigureRouterInterfaceObservation struct {
+}
+
+// A ConfigureRouterInterfaceSpec defines the desired state of a ConfigureRouterInterface.
+type ConfigureRouterInterfaceSpec struct {
+	nddv1.ResourceSpec `json:",inline"`
+	ForNetworkNode     ConfigureRouterInterfaceParameters `json:"forNetworkNode"`
+}
+
+// A ConfigureRouterInterfaceStatus represents the observed state of a ConfigureRouterInterface.
+type ConfigureRouterInterfaceStatus struct {
+	nddv1.ResourceStatus `json:",inline"`
+	AtNetworkNode        ConfigureRouterInterfaceObservation `json:"atNetworkNode,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigureRouterInterface is the Schema for the ConfigureRouterInterface API
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="TARGET",type="string",JSONPath=".status.conditions[?(@.kind=='TargetFound')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.conditions[?(@.kind=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNC",type="string",JSONPath=".status.conditions[?(@.kind=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCALLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='InternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="EXTLEAFREF",type="string",JSONPath=".status.conditions[?(@.kind=='ExternalLeafrefValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="PARENTDEP",type="string",JSONPath=".status.conditions[?(@.kind=='ParentValidationSuccess')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={ndd,srl}
+type SrosConfigureRouterInterface struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigureRouterInterfaceSpec   `json:"spec,omitempty"`
+	Status ConfigureRouterInterfaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SrosConfigureRouterInterfaceList contains a list of ConfigureRouterInterfaces
+type SrosConfigureRouterInterfaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SrosConfigureRouterInterface `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SrosConfigureRouterInterface{}, &SrosConfigureRouterInterfaceList{})
+}
+
+// ConfigureRouterInterface type metadata.
+var (
+	ConfigureRouterInterfaceKind             = reflect.TypeOf(SrosConfigureRouterInterface{}).Name()
+	ConfigureRouterInterfaceGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigureRouterInterfaceKind}.String()
+	ConfigureRouterInterfaceKindAPIVersion   = ConfigureRouterInterfaceKind + "." + GroupVersion.String()
+	ConfigureRouterInterfaceGroupVersionKind = GroupVersion.WithKind(ConfigureRouterInterfaceKind)
+)
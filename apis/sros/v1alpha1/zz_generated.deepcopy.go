@@ -21,17 +21,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePort) DeepCopyInto(out *ConfigurePort) {
+func (in *ConfigureLag) DeepCopyInto(out *ConfigureLag) {
 	*out = *in
-	if in.Access != nil {
-		in, out := &in.Access, &out.Access
-		*out = new(ConfigurePortAccess)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
@@ -47,388 +43,361 @@ func (in *ConfigurePort) DeepCopyInto(out *ConfigurePort) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Connector != nil {
-		in, out := &in.Connector, &out.Connector
-		*out = new(ConfigurePortConnector)
+	if in.BfdLiveness != nil {
+		in, out := &in.BfdLiveness, &out.BfdLiveness
+		*out = new(ConfigureLagBfdLiveness)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DdmEvents != nil {
-		in, out := &in.DdmEvents, &out.DdmEvents
-		*out = new(bool)
-		**out = **in
-	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.DistCpuProtection != nil {
-		in, out := &in.DistCpuProtection, &out.DistCpuProtection
-		*out = new(ConfigurePortDistCpuProtection)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Dwdm != nil {
-		in, out := &in.Dwdm, &out.Dwdm
-		*out = new(ConfigurePortDwdm)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ethernet != nil {
-		in, out := &in.Ethernet, &out.Ethernet
-		*out = new(ConfigurePortEthernet)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Gnss != nil {
-		in, out := &in.Gnss, &out.Gnss
-		*out = new(ConfigurePortGnss)
-		(*in).DeepCopyInto(*out)
+	if in.EncapType != nil {
+		in, out := &in.EncapType, &out.EncapType
+		*out = new(string)
+		**out = **in
 	}
-	if in.HybridBufferAllocation != nil {
-		in, out := &in.HybridBufferAllocation, &out.HybridBufferAllocation
-		*out = new(ConfigurePortHybridBufferAllocation)
+	if in.Lacp != nil {
+		in, out := &in.Lacp, &out.Lacp
+		*out = new(ConfigureLagLacp)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ModifyBufferAllocation != nil {
-		in, out := &in.ModifyBufferAllocation, &out.ModifyBufferAllocation
-		*out = new(ConfigurePortModifyBufferAllocation)
-		(*in).DeepCopyInto(*out)
+	if in.LacpXmitInterval != nil {
+		in, out := &in.LacpXmitInterval, &out.LacpXmitInterval
+		*out = new(string)
+		**out = **in
 	}
-	if in.MonitorAggEgressQueueStats != nil {
-		in, out := &in.MonitorAggEgressQueueStats, &out.MonitorAggEgressQueueStats
+	if in.LacpXmitStdby != nil {
+		in, out := &in.LacpXmitStdby, &out.LacpXmitStdby
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(ConfigurePortNetwork)
-		(*in).DeepCopyInto(*out)
+	if in.LagName != nil {
+		in, out := &in.LagName, &out.LagName
+		*out = new(string)
+		**out = **in
 	}
-	if in.Otu != nil {
-		in, out := &in.Otu, &out.Otu
-		*out = new(ConfigurePortOtu)
-		(*in).DeepCopyInto(*out)
+	if in.MaxPorts != nil {
+		in, out := &in.MaxPorts, &out.MaxPorts
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.PortId != nil {
-		in, out := &in.PortId, &out.PortId
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
 		*out = new(string)
 		**out = **in
 	}
-	if in.SonetSdh != nil {
-		in, out := &in.SonetSdh, &out.SonetSdh
-		*out = new(ConfigurePortSonetSdh)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Tdm != nil {
-		in, out := &in.Tdm, &out.Tdm
-		*out = new(ConfigurePortTdm)
-		(*in).DeepCopyInto(*out)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = make([]*ConfigureLagPort, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigureLagPort)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.Transceiver != nil {
-		in, out := &in.Transceiver, &out.Transceiver
-		*out = new(ConfigurePortTransceiver)
-		(*in).DeepCopyInto(*out)
+	if in.StandbySignaling != nil {
+		in, out := &in.StandbySignaling, &out.StandbySignaling
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePort.
-func (in *ConfigurePort) DeepCopy() *ConfigurePort {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLag.
+func (in *ConfigureLag) DeepCopy() *ConfigureLag {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePort)
+	out := new(ConfigureLag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortAccess) DeepCopyInto(out *ConfigurePortAccess) {
+func (in *ConfigureLagBfdLiveness) DeepCopyInto(out *ConfigureLagBfdLiveness) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortAccessEgress)
+	if in.Ipv4 != nil {
+		in, out := &in.Ipv4, &out.Ipv4
+		*out = new(ConfigureLagBfdLivenessIpv4)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(ConfigurePortAccessIngress)
+	if in.Ipv6 != nil {
+		in, out := &in.Ipv6, &out.Ipv6
+		*out = new(ConfigureLagBfdLivenessIpv6)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccess.
-func (in *ConfigurePortAccess) DeepCopy() *ConfigurePortAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLagBfdLiveness.
+func (in *ConfigureLagBfdLiveness) DeepCopy() *ConfigureLagBfdLiveness {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortAccess)
+	out := new(ConfigureLagBfdLiveness)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortAccessEgress) DeepCopyInto(out *ConfigurePortAccessEgress) {
+func (in *ConfigureLagBfdLivenessIpv4) DeepCopyInto(out *ConfigureLagBfdLivenessIpv4) {
 	*out = *in
-	if in.Pool != nil {
-		in, out := &in.Pool, &out.Pool
-		*out = make([]*ConfigurePortAccessEgressPool, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortAccessEgressPool)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.LocalIpAddress != nil {
+		in, out := &in.LocalIpAddress, &out.LocalIpAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ReceiveInterval != nil {
+		in, out := &in.ReceiveInterval, &out.ReceiveInterval
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RemoteIpAddress != nil {
+		in, out := &in.RemoteIpAddress, &out.RemoteIpAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransmitInterval != nil {
+		in, out := &in.TransmitInterval, &out.TransmitInterval
+		*out = new(uint32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessEgress.
-func (in *ConfigurePortAccessEgress) DeepCopy() *ConfigurePortAccessEgress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLagBfdLivenessIpv4.
+func (in *ConfigureLagBfdLivenessIpv4) DeepCopy() *ConfigureLagBfdLivenessIpv4 {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortAccessEgress)
+	out := new(ConfigureLagBfdLivenessIpv4)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortAccessEgressPool) DeepCopyInto(out *ConfigurePortAccessEgressPool) {
+func (in *ConfigureLagBfdLivenessIpv6) DeepCopyInto(out *ConfigureLagBfdLivenessIpv6) {
 	*out = *in
-	if in.AmberAlarmThreshold != nil {
-		in, out := &in.AmberAlarmThreshold, &out.AmberAlarmThreshold
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.LocalIpAddress != nil {
+		in, out := &in.LocalIpAddress, &out.LocalIpAddress
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.RedAlarmThreshold != nil {
-		in, out := &in.RedAlarmThreshold, &out.RedAlarmThreshold
+	if in.ReceiveInterval != nil {
+		in, out := &in.ReceiveInterval, &out.ReceiveInterval
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.ResvCbs != nil {
-		in, out := &in.ResvCbs, &out.ResvCbs
-		*out = new(ConfigurePortAccessEgressPoolResvCbs)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SlopePolicy != nil {
-		in, out := &in.SlopePolicy, &out.SlopePolicy
+	if in.RemoteIpAddress != nil {
+		in, out := &in.RemoteIpAddress, &out.RemoteIpAddress
 		*out = new(string)
 		**out = **in
 	}
+	if in.TransmitInterval != nil {
+		in, out := &in.TransmitInterval, &out.TransmitInterval
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessEgressPool.
-func (in *ConfigurePortAccessEgressPool) DeepCopy() *ConfigurePortAccessEgressPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLagBfdLivenessIpv6.
+func (in *ConfigureLagBfdLivenessIpv6) DeepCopy() *ConfigureLagBfdLivenessIpv6 {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortAccessEgressPool)
+	out := new(ConfigureLagBfdLivenessIpv6)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortAccessEgressPoolResvCbs) DeepCopyInto(out *ConfigurePortAccessEgressPoolResvCbs) {
+func (in *ConfigureLagLacp) DeepCopyInto(out *ConfigureLagLacp) {
 	*out = *in
-	if in.AmberAlarmAction != nil {
-		in, out := &in.AmberAlarmAction, &out.AmberAlarmAction
-		*out = new(ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction)
-		(*in).DeepCopyInto(*out)
+	if in.AdministrativeKey != nil {
+		in, out := &in.AdministrativeKey, &out.AdministrativeKey
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.Cbs != nil {
-		in, out := &in.Cbs, &out.Cbs
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SystemId != nil {
+		in, out := &in.SystemId, &out.SystemId
 		*out = new(string)
 		**out = **in
 	}
+	if in.SystemPriority != nil {
+		in, out := &in.SystemPriority, &out.SystemPriority
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessEgressPoolResvCbs.
-func (in *ConfigurePortAccessEgressPoolResvCbs) DeepCopy() *ConfigurePortAccessEgressPoolResvCbs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLagLacp.
+func (in *ConfigureLagLacp) DeepCopy() *ConfigureLagLacp {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortAccessEgressPoolResvCbs)
+	out := new(ConfigureLagLacp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction) DeepCopyInto(out *ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction) {
+func (in *ConfigureLagObservation) DeepCopyInto(out *ConfigureLagObservation) {
 	*out = *in
-	if in.Max != nil {
-		in, out := &in.Max, &out.Max
-		*out = new(uint32)
+	if in.OperState != nil {
+		in, out := &in.OperState, &out.OperState
+		*out = new(string)
 		**out = **in
 	}
-	if in.Step != nil {
-		in, out := &in.Step, &out.Step
-		*out = new(uint32)
-		**out = **in
+	if in.ActiveMembers != nil {
+		in, out := &in.ActiveMembers, &out.ActiveMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StandbyMembers != nil {
+		in, out := &in.StandbyMembers, &out.StandbyMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction.
-func (in *ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction) DeepCopy() *ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLagObservation.
+func (in *ConfigureLagObservation) DeepCopy() *ConfigureLagObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction)
+	out := new(ConfigureLagObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortAccessIngress) DeepCopyInto(out *ConfigurePortAccessIngress) {
+func (in *ConfigureLagParameters) DeepCopyInto(out *ConfigureLagParameters) {
 	*out = *in
-	if in.Pool != nil {
-		in, out := &in.Pool, &out.Pool
-		*out = make([]*ConfigurePortAccessIngressPool, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortAccessIngressPool)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.SrosConfigureLag != nil {
+		in, out := &in.SrosConfigureLag, &out.SrosConfigureLag
+		*out = new(ConfigureLag)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessIngress.
-func (in *ConfigurePortAccessIngress) DeepCopy() *ConfigurePortAccessIngress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLagParameters.
+func (in *ConfigureLagParameters) DeepCopy() *ConfigureLagParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortAccessIngress)
+	out := new(ConfigureLagParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortAccessIngressPool) DeepCopyInto(out *ConfigurePortAccessIngressPool) {
+func (in *ConfigureLagPort) DeepCopyInto(out *ConfigureLagPort) {
 	*out = *in
-	if in.AmberAlarmThreshold != nil {
-		in, out := &in.AmberAlarmThreshold, &out.AmberAlarmThreshold
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
+	if in.HashWeight != nil {
+		in, out := &in.HashWeight, &out.HashWeight
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.PortId != nil {
+		in, out := &in.PortId, &out.PortId
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.RedAlarmThreshold != nil {
-		in, out := &in.RedAlarmThreshold, &out.RedAlarmThreshold
+	if in.SubGroup != nil {
+		in, out := &in.SubGroup, &out.SubGroup
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.ResvCbs != nil {
-		in, out := &in.ResvCbs, &out.ResvCbs
-		*out = new(ConfigurePortAccessIngressPoolResvCbs)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SlopePolicy != nil {
-		in, out := &in.SlopePolicy, &out.SlopePolicy
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessIngressPool.
-func (in *ConfigurePortAccessIngressPool) DeepCopy() *ConfigurePortAccessIngressPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLagPort.
+func (in *ConfigureLagPort) DeepCopy() *ConfigureLagPort {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortAccessIngressPool)
+	out := new(ConfigureLagPort)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortAccessIngressPoolResvCbs) DeepCopyInto(out *ConfigurePortAccessIngressPoolResvCbs) {
+func (in *ConfigureLagSpec) DeepCopyInto(out *ConfigureLagSpec) {
 	*out = *in
-	if in.AmberAlarmAction != nil {
-		in, out := &in.AmberAlarmAction, &out.AmberAlarmAction
-		*out = new(ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Cbs != nil {
-		in, out := &in.Cbs, &out.Cbs
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessIngressPoolResvCbs.
-func (in *ConfigurePortAccessIngressPoolResvCbs) DeepCopy() *ConfigurePortAccessIngressPoolResvCbs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLagSpec.
+func (in *ConfigureLagSpec) DeepCopy() *ConfigureLagSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortAccessIngressPoolResvCbs)
+	out := new(ConfigureLagSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction) DeepCopyInto(out *ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction) {
+func (in *ConfigureLagStatus) DeepCopyInto(out *ConfigureLagStatus) {
 	*out = *in
-	if in.Max != nil {
-		in, out := &in.Max, &out.Max
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Step != nil {
-		in, out := &in.Step, &out.Step
-		*out = new(uint32)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtNetworkNode.DeepCopyInto(&out.AtNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction.
-func (in *ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction) DeepCopy() *ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureLagStatus.
+func (in *ConfigureLagStatus) DeepCopy() *ConfigureLagStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction)
+	out := new(ConfigureLagStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortConnector) DeepCopyInto(out *ConfigurePortConnector) {
+func (in *ConfigurePort) DeepCopyInto(out *ConfigurePort) {
 	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(ConfigurePortAccess)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -439,50 +408,100 @@ func (in *ConfigurePortConnector) DeepCopyInto(out *ConfigurePortConnector) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Breakout != nil {
-		in, out := &in.Breakout, &out.Breakout
-		*out = new(string)
+	if in.Connector != nil {
+		in, out := &in.Connector, &out.Connector
+		*out = new(ConfigurePortConnector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DdmEvents != nil {
+		in, out := &in.DdmEvents, &out.DdmEvents
+		*out = new(bool)
 		**out = **in
 	}
-	if in.RsFecMode != nil {
-		in, out := &in.RsFecMode, &out.RsFecMode
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortConnector.
-func (in *ConfigurePortConnector) DeepCopy() *ConfigurePortConnector {
-	if in == nil {
-		return nil
+	if in.DistCpuProtection != nil {
+		in, out := &in.DistCpuProtection, &out.DistCpuProtection
+		*out = new(ConfigurePortDistCpuProtection)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ConfigurePortConnector)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortDistCpuProtection) DeepCopyInto(out *ConfigurePortDistCpuProtection) {
-	*out = *in
-	if in.Policy != nil {
-		in, out := &in.Policy, &out.Policy
+	if in.Dwdm != nil {
+		in, out := &in.Dwdm, &out.Dwdm
+		*out = new(ConfigurePortDwdm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ethernet != nil {
+		in, out := &in.Ethernet, &out.Ethernet
+		*out = new(ConfigurePortEthernet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Gnss != nil {
+		in, out := &in.Gnss, &out.Gnss
+		*out = new(ConfigurePortGnss)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HybridBufferAllocation != nil {
+		in, out := &in.HybridBufferAllocation, &out.HybridBufferAllocation
+		*out = new(ConfigurePortHybridBufferAllocation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ModifyBufferAllocation != nil {
+		in, out := &in.ModifyBufferAllocation, &out.ModifyBufferAllocation
+		*out = new(ConfigurePortModifyBufferAllocation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MonitorAggEgressQueueStats != nil {
+		in, out := &in.MonitorAggEgressQueueStats, &out.MonitorAggEgressQueueStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ConfigurePortNetwork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Otu != nil {
+		in, out := &in.Otu, &out.Otu
+		*out = new(ConfigurePortOtu)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PortId != nil {
+		in, out := &in.PortId, &out.PortId
 		*out = new(string)
 		**out = **in
 	}
+	if in.SonetSdh != nil {
+		in, out := &in.SonetSdh, &out.SonetSdh
+		*out = new(ConfigurePortSonetSdh)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tdm != nil {
+		in, out := &in.Tdm, &out.Tdm
+		*out = new(ConfigurePortTdm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transceiver != nil {
+		in, out := &in.Transceiver, &out.Transceiver
+		*out = new(ConfigurePortTransceiver)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDistCpuProtection.
-func (in *ConfigurePortDistCpuProtection) DeepCopy() *ConfigurePortDistCpuProtection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePort.
+func (in *ConfigurePort) DeepCopy() *ConfigurePort {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortDistCpuProtection)
+	out := new(ConfigurePort)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortDwdm) DeepCopyInto(out *ConfigurePortDwdm) {
+func (in *ConfigurePortAccess) DeepCopyInto(out *ConfigurePortAccess) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -494,41 +513,62 @@ func (in *ConfigurePortDwdm) DeepCopyInto(out *ConfigurePortDwdm) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Channel != nil {
-		in, out := &in.Channel, &out.Channel
-		*out = new(string)
-		**out = **in
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(ConfigurePortAccessEgress)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Coherent != nil {
-		in, out := &in.Coherent, &out.Coherent
-		*out = new(ConfigurePortDwdmCoherent)
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(ConfigurePortAccessIngress)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.RxdtvAdjust != nil {
-		in, out := &in.RxdtvAdjust, &out.RxdtvAdjust
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccess.
+func (in *ConfigurePortAccess) DeepCopy() *ConfigurePortAccess {
+	if in == nil {
+		return nil
 	}
-	if in.Wavetracker != nil {
-		in, out := &in.Wavetracker, &out.Wavetracker
-		*out = new(ConfigurePortDwdmWavetracker)
-		(*in).DeepCopyInto(*out)
+	out := new(ConfigurePortAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortAccessEgress) DeepCopyInto(out *ConfigurePortAccessEgress) {
+	*out = *in
+	if in.Pool != nil {
+		in, out := &in.Pool, &out.Pool
+		*out = make([]*ConfigurePortAccessEgressPool, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortAccessEgressPool)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdm.
-func (in *ConfigurePortDwdm) DeepCopy() *ConfigurePortDwdm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessEgress.
+func (in *ConfigurePortAccessEgress) DeepCopy() *ConfigurePortAccessEgress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortDwdm)
+	out := new(ConfigurePortAccessEgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortDwdmCoherent) DeepCopyInto(out *ConfigurePortDwdmCoherent) {
+func (in *ConfigurePortAccessEgressPool) DeepCopyInto(out *ConfigurePortAccessEgressPool) {
 	*out = *in
+	if in.AmberAlarmThreshold != nil {
+		in, out := &in.AmberAlarmThreshold, &out.AmberAlarmThreshold
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -539,136 +579,122 @@ func (in *ConfigurePortDwdmCoherent) DeepCopyInto(out *ConfigurePortDwdmCoherent
 		*out = new(string)
 		**out = **in
 	}
-	if in.Channel != nil {
-		in, out := &in.Channel, &out.Channel
-		*out = new(string)
-		**out = **in
-	}
-	if in.Compatibility != nil {
-		in, out := &in.Compatibility, &out.Compatibility
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.CprWindowSize != nil {
-		in, out := &in.CprWindowSize, &out.CprWindowSize
+	if in.RedAlarmThreshold != nil {
+		in, out := &in.RedAlarmThreshold, &out.RedAlarmThreshold
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Dispersion != nil {
-		in, out := &in.Dispersion, &out.Dispersion
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
-		*out = new(string)
-		**out = **in
-	}
-	if in.ReportAlarm != nil {
-		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortDwdmCoherentReportAlarm)
+	if in.ResvCbs != nil {
+		in, out := &in.ResvCbs, &out.ResvCbs
+		*out = new(ConfigurePortAccessEgressPoolResvCbs)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.RxLosReaction != nil {
-		in, out := &in.RxLosReaction, &out.RxLosReaction
+	if in.SlopePolicy != nil {
+		in, out := &in.SlopePolicy, &out.SlopePolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.RxLosThresh != nil {
-		in, out := &in.RxLosThresh, &out.RxLosThresh
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessEgressPool.
+func (in *ConfigurePortAccessEgressPool) DeepCopy() *ConfigurePortAccessEgressPool {
+	if in == nil {
+		return nil
 	}
-	if in.Sweep != nil {
-		in, out := &in.Sweep, &out.Sweep
-		*out = new(ConfigurePortDwdmCoherentSweep)
+	out := new(ConfigurePortAccessEgressPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortAccessEgressPoolResvCbs) DeepCopyInto(out *ConfigurePortAccessEgressPoolResvCbs) {
+	*out = *in
+	if in.AmberAlarmAction != nil {
+		in, out := &in.AmberAlarmAction, &out.AmberAlarmAction
+		*out = new(ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.TargetPower != nil {
-		in, out := &in.TargetPower, &out.TargetPower
+	if in.Cbs != nil {
+		in, out := &in.Cbs, &out.Cbs
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmCoherent.
-func (in *ConfigurePortDwdmCoherent) DeepCopy() *ConfigurePortDwdmCoherent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessEgressPoolResvCbs.
+func (in *ConfigurePortAccessEgressPoolResvCbs) DeepCopy() *ConfigurePortAccessEgressPoolResvCbs {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortDwdmCoherent)
+	out := new(ConfigurePortAccessEgressPoolResvCbs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortDwdmCoherentReportAlarm) DeepCopyInto(out *ConfigurePortDwdmCoherentReportAlarm) {
+func (in *ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction) DeepCopyInto(out *ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction) {
 	*out = *in
-	if in.Hosttx != nil {
-		in, out := &in.Hosttx, &out.Hosttx
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Mod != nil {
-		in, out := &in.Mod, &out.Mod
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Modflt != nil {
-		in, out := &in.Modflt, &out.Modflt
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Netrx != nil {
-		in, out := &in.Netrx, &out.Netrx
-		*out = new(bool)
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Nettx != nil {
-		in, out := &in.Nettx, &out.Nettx
-		*out = new(bool)
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmCoherentReportAlarm.
-func (in *ConfigurePortDwdmCoherentReportAlarm) DeepCopy() *ConfigurePortDwdmCoherentReportAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction.
+func (in *ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction) DeepCopy() *ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortDwdmCoherentReportAlarm)
+	out := new(ConfigurePortAccessEgressPoolResvCbsAmberAlarmAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortDwdmCoherentSweep) DeepCopyInto(out *ConfigurePortDwdmCoherentSweep) {
+func (in *ConfigurePortAccessIngress) DeepCopyInto(out *ConfigurePortAccessIngress) {
 	*out = *in
-	if in.End != nil {
-		in, out := &in.End, &out.End
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Start != nil {
-		in, out := &in.Start, &out.Start
-		*out = new(int32)
-		**out = **in
+	if in.Pool != nil {
+		in, out := &in.Pool, &out.Pool
+		*out = make([]*ConfigurePortAccessIngressPool, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortAccessIngressPool)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmCoherentSweep.
-func (in *ConfigurePortDwdmCoherentSweep) DeepCopy() *ConfigurePortDwdmCoherentSweep {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessIngress.
+func (in *ConfigurePortAccessIngress) DeepCopy() *ConfigurePortAccessIngress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortDwdmCoherentSweep)
+	out := new(ConfigurePortAccessIngress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortDwdmWavetracker) DeepCopyInto(out *ConfigurePortDwdmWavetracker) {
+func (in *ConfigurePortAccessIngressPool) DeepCopyInto(out *ConfigurePortAccessIngressPool) {
 	*out = *in
+	if in.AmberAlarmThreshold != nil {
+		in, out := &in.AmberAlarmThreshold, &out.AmberAlarmThreshold
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -679,141 +705,151 @@ func (in *ConfigurePortDwdmWavetracker) DeepCopyInto(out *ConfigurePortDwdmWavet
 		*out = new(string)
 		**out = **in
 	}
-	if in.Encode != nil {
-		in, out := &in.Encode, &out.Encode
-		*out = new(ConfigurePortDwdmWavetrackerEncode)
-		(*in).DeepCopyInto(*out)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
 	}
-	if in.PowerControl != nil {
-		in, out := &in.PowerControl, &out.PowerControl
-		*out = new(ConfigurePortDwdmWavetrackerPowerControl)
-		(*in).DeepCopyInto(*out)
+	if in.RedAlarmThreshold != nil {
+		in, out := &in.RedAlarmThreshold, &out.RedAlarmThreshold
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.ReportAlarm != nil {
-		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortDwdmWavetrackerReportAlarm)
+	if in.ResvCbs != nil {
+		in, out := &in.ResvCbs, &out.ResvCbs
+		*out = new(ConfigurePortAccessIngressPoolResvCbs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SlopePolicy != nil {
+		in, out := &in.SlopePolicy, &out.SlopePolicy
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmWavetracker.
-func (in *ConfigurePortDwdmWavetracker) DeepCopy() *ConfigurePortDwdmWavetracker {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessIngressPool.
+func (in *ConfigurePortAccessIngressPool) DeepCopy() *ConfigurePortAccessIngressPool {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortDwdmWavetracker)
+	out := new(ConfigurePortAccessIngressPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortDwdmWavetrackerEncode) DeepCopyInto(out *ConfigurePortDwdmWavetrackerEncode) {
+func (in *ConfigurePortAccessIngressPoolResvCbs) DeepCopyInto(out *ConfigurePortAccessIngressPoolResvCbs) {
 	*out = *in
-	if in.Key1 != nil {
-		in, out := &in.Key1, &out.Key1
-		*out = new(uint32)
-		**out = **in
+	if in.AmberAlarmAction != nil {
+		in, out := &in.AmberAlarmAction, &out.AmberAlarmAction
+		*out = new(ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Key2 != nil {
-		in, out := &in.Key2, &out.Key2
-		*out = new(uint32)
+	if in.Cbs != nil {
+		in, out := &in.Cbs, &out.Cbs
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmWavetrackerEncode.
-func (in *ConfigurePortDwdmWavetrackerEncode) DeepCopy() *ConfigurePortDwdmWavetrackerEncode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessIngressPoolResvCbs.
+func (in *ConfigurePortAccessIngressPoolResvCbs) DeepCopy() *ConfigurePortAccessIngressPoolResvCbs {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortDwdmWavetrackerEncode)
+	out := new(ConfigurePortAccessIngressPoolResvCbs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortDwdmWavetrackerPowerControl) DeepCopyInto(out *ConfigurePortDwdmWavetrackerPowerControl) {
+func (in *ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction) DeepCopyInto(out *ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction) {
 	*out = *in
-	if in.TargetPower != nil {
-		in, out := &in.TargetPower, &out.TargetPower
-		*out = new(string)
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmWavetrackerPowerControl.
-func (in *ConfigurePortDwdmWavetrackerPowerControl) DeepCopy() *ConfigurePortDwdmWavetrackerPowerControl {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction.
+func (in *ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction) DeepCopy() *ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortDwdmWavetrackerPowerControl)
+	out := new(ConfigurePortAccessIngressPoolResvCbsAmberAlarmAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortDwdmWavetrackerReportAlarm) DeepCopyInto(out *ConfigurePortDwdmWavetrackerReportAlarm) {
+func (in *ConfigurePortConnector) DeepCopyInto(out *ConfigurePortConnector) {
 	*out = *in
-	if in.EncoderDegrade != nil {
-		in, out := &in.EncoderDegrade, &out.EncoderDegrade
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EncoderFailure != nil {
-		in, out := &in.EncoderFailure, &out.EncoderFailure
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MissingPluggableVoa != nil {
-		in, out := &in.MissingPluggableVoa, &out.MissingPluggableVoa
-		*out = new(bool)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
 		**out = **in
 	}
-	if in.PowerControlDegrade != nil {
-		in, out := &in.PowerControlDegrade, &out.PowerControlDegrade
-		*out = new(bool)
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
 		**out = **in
 	}
-	if in.PowerControlFailure != nil {
-		in, out := &in.PowerControlFailure, &out.PowerControlFailure
-		*out = new(bool)
+	if in.Breakout != nil {
+		in, out := &in.Breakout, &out.Breakout
+		*out = new(string)
 		**out = **in
 	}
-	if in.PowerControlHighLimit != nil {
-		in, out := &in.PowerControlHighLimit, &out.PowerControlHighLimit
-		*out = new(bool)
+	if in.PortPolicy != nil {
+		in, out := &in.PortPolicy, &out.PortPolicy
+		*out = new(string)
 		**out = **in
 	}
-	if in.PowerControlLowLimit != nil {
-		in, out := &in.PowerControlLowLimit, &out.PowerControlLowLimit
-		*out = new(bool)
+	if in.RsFecMode != nil {
+		in, out := &in.RsFecMode, &out.RsFecMode
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmWavetrackerReportAlarm.
-func (in *ConfigurePortDwdmWavetrackerReportAlarm) DeepCopy() *ConfigurePortDwdmWavetrackerReportAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortConnector.
+func (in *ConfigurePortConnector) DeepCopy() *ConfigurePortConnector {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortDwdmWavetrackerReportAlarm)
+	out := new(ConfigurePortConnector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernet) DeepCopyInto(out *ConfigurePortEthernet) {
+func (in *ConfigurePortDistCpuProtection) DeepCopyInto(out *ConfigurePortDistCpuProtection) {
 	*out = *in
-	if in.Access != nil {
-		in, out := &in.Access, &out.Access
-		*out = new(ConfigurePortEthernetAccess)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
 		*out = new(string)
 		**out = **in
 	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDistCpuProtection.
+func (in *ConfigurePortDistCpuProtection) DeepCopy() *ConfigurePortDistCpuProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortDistCpuProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortDwdm) DeepCopyInto(out *ConfigurePortDwdm) {
+	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -824,124 +860,69 @@ func (in *ConfigurePortEthernet) DeepCopyInto(out *ConfigurePortEthernet) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Autonegotiate != nil {
-		in, out := &in.Autonegotiate, &out.Autonegotiate
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
 		*out = new(string)
 		**out = **in
 	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
+	if in.Coherent != nil {
+		in, out := &in.Coherent, &out.Coherent
+		*out = new(ConfigurePortDwdmCoherent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RxdtvAdjust != nil {
+		in, out := &in.RxdtvAdjust, &out.RxdtvAdjust
 		*out = new(bool)
 		**out = **in
 	}
-	if in.CrcMonitor != nil {
-		in, out := &in.CrcMonitor, &out.CrcMonitor
-		*out = new(ConfigurePortEthernetCrcMonitor)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Dampening != nil {
-		in, out := &in.Dampening, &out.Dampening
-		*out = new(ConfigurePortEthernetDampening)
+	if in.Wavetracker != nil {
+		in, out := &in.Wavetracker, &out.Wavetracker
+		*out = new(ConfigurePortDwdmWavetracker)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DiscardRxPauseFrames != nil {
-		in, out := &in.DiscardRxPauseFrames, &out.DiscardRxPauseFrames
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdm.
+func (in *ConfigurePortDwdm) DeepCopy() *ConfigurePortDwdm {
+	if in == nil {
+		return nil
 	}
-	if in.Dot1qEtype != nil {
-		in, out := &in.Dot1qEtype, &out.Dot1qEtype
+	out := new(ConfigurePortDwdm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortDwdmCoherent) DeepCopyInto(out *ConfigurePortDwdmCoherent) {
+	*out = *in
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-	if in.Dot1x != nil {
-		in, out := &in.Dot1x, &out.Dot1x
-		*out = new(ConfigurePortEthernetDot1x)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DownOnInternalError != nil {
-		in, out := &in.DownOnInternalError, &out.DownOnInternalError
-		*out = new(ConfigurePortEthernetDownOnInternalError)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DownWhenLooped != nil {
-		in, out := &in.DownWhenLooped, &out.DownWhenLooped
-		*out = new(ConfigurePortEthernetDownWhenLooped)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Duplex != nil {
-		in, out := &in.Duplex, &out.Duplex
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
-	if in.EfmOam != nil {
-		in, out := &in.EfmOam, &out.EfmOam
-		*out = new(ConfigurePortEthernetEfmOam)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortEthernetEgress)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Elmi != nil {
-		in, out := &in.Elmi, &out.Elmi
-		*out = new(ConfigurePortEthernetElmi)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EncapType != nil {
-		in, out := &in.EncapType, &out.EncapType
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
 		*out = new(string)
 		**out = **in
 	}
-	if in.EthCfm != nil {
-		in, out := &in.EthCfm, &out.EthCfm
-		*out = new(ConfigurePortEthernetEthCfm)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HoldTime != nil {
-		in, out := &in.HoldTime, &out.HoldTime
-		*out = new(ConfigurePortEthernetHoldTime)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HsmdaSchedulerOverrides != nil {
-		in, out := &in.HsmdaSchedulerOverrides, &out.HsmdaSchedulerOverrides
-		*out = new(ConfigurePortEthernetHsmdaSchedulerOverrides)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(ConfigurePortEthernetIngress)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LacpTunnel != nil {
-		in, out := &in.LacpTunnel, &out.LacpTunnel
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Lldp != nil {
-		in, out := &in.Lldp, &out.Lldp
-		*out = new(ConfigurePortEthernetLldp)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LoadBalancingAlgorithm != nil {
-		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
+	if in.Compatibility != nil {
+		in, out := &in.Compatibility, &out.Compatibility
 		*out = new(string)
 		**out = **in
 	}
-	if in.Loopback != nil {
-		in, out := &in.Loopback, &out.Loopback
-		*out = new(ConfigurePortEthernetLoopback)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MacAddress != nil {
-		in, out := &in.MacAddress, &out.MacAddress
-		*out = new(string)
+	if in.CprWindowSize != nil {
+		in, out := &in.CprWindowSize, &out.CprWindowSize
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.MinFrameLength != nil {
-		in, out := &in.MinFrameLength, &out.MinFrameLength
-		*out = new(uint32)
+	if in.Dispersion != nil {
+		in, out := &in.Dispersion, &out.Dispersion
+		*out = new(int32)
 		**out = **in
 	}
 	if in.Mode != nil {
@@ -949,91 +930,111 @@ func (in *ConfigurePortEthernet) DeepCopyInto(out *ConfigurePortEthernet) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Mtu != nil {
-		in, out := &in.Mtu, &out.Mtu
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(ConfigurePortEthernetNetwork)
+	if in.ReportAlarm != nil {
+		in, out := &in.ReportAlarm, &out.ReportAlarm
+		*out = new(ConfigurePortDwdmCoherentReportAlarm)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PbbEtype != nil {
-		in, out := &in.PbbEtype, &out.PbbEtype
+	if in.RxLosReaction != nil {
+		in, out := &in.RxLosReaction, &out.RxLosReaction
 		*out = new(string)
 		**out = **in
 	}
-	if in.PtpAsymmetry != nil {
-		in, out := &in.PtpAsymmetry, &out.PtpAsymmetry
-		*out = new(int32)
-		**out = **in
-	}
-	if in.QinqEtype != nil {
-		in, out := &in.QinqEtype, &out.QinqEtype
+	if in.RxLosThresh != nil {
+		in, out := &in.RxLosThresh, &out.RxLosThresh
 		*out = new(string)
 		**out = **in
 	}
-	if in.ReportAlarm != nil {
-		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortEthernetReportAlarm)
+	if in.Sweep != nil {
+		in, out := &in.Sweep, &out.Sweep
+		*out = new(ConfigurePortDwdmCoherentSweep)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.RsFecMode != nil {
-		in, out := &in.RsFecMode, &out.RsFecMode
+	if in.TargetPower != nil {
+		in, out := &in.TargetPower, &out.TargetPower
 		*out = new(string)
 		**out = **in
 	}
-	if in.SingleFiber != nil {
-		in, out := &in.SingleFiber, &out.SingleFiber
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmCoherent.
+func (in *ConfigurePortDwdmCoherent) DeepCopy() *ConfigurePortDwdmCoherent {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortDwdmCoherent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortDwdmCoherentReportAlarm) DeepCopyInto(out *ConfigurePortDwdmCoherentReportAlarm) {
+	*out = *in
+	if in.Hosttx != nil {
+		in, out := &in.Hosttx, &out.Hosttx
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Speed != nil {
-		in, out := &in.Speed, &out.Speed
-		*out = new(uint32)
+	if in.Mod != nil {
+		in, out := &in.Mod, &out.Mod
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Ssm != nil {
-		in, out := &in.Ssm, &out.Ssm
-		*out = new(ConfigurePortEthernetSsm)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SymbolMonitor != nil {
-		in, out := &in.SymbolMonitor, &out.SymbolMonitor
-		*out = new(ConfigurePortEthernetSymbolMonitor)
-		(*in).DeepCopyInto(*out)
+	if in.Modflt != nil {
+		in, out := &in.Modflt, &out.Modflt
+		*out = new(bool)
+		**out = **in
 	}
-	if in.UtilStatsInterval != nil {
-		in, out := &in.UtilStatsInterval, &out.UtilStatsInterval
-		*out = new(uint32)
+	if in.Netrx != nil {
+		in, out := &in.Netrx, &out.Netrx
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Xgig != nil {
-		in, out := &in.Xgig, &out.Xgig
-		*out = new(string)
+	if in.Nettx != nil {
+		in, out := &in.Nettx, &out.Nettx
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernet.
-func (in *ConfigurePortEthernet) DeepCopy() *ConfigurePortEthernet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmCoherentReportAlarm.
+func (in *ConfigurePortDwdmCoherentReportAlarm) DeepCopy() *ConfigurePortDwdmCoherentReportAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernet)
+	out := new(ConfigurePortDwdmCoherentReportAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccess) DeepCopyInto(out *ConfigurePortEthernetAccess) {
+func (in *ConfigurePortDwdmCoherentSweep) DeepCopyInto(out *ConfigurePortDwdmCoherentSweep) {
 	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
-		*out = new(string)
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = new(int32)
 		**out = **in
 	}
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmCoherentSweep.
+func (in *ConfigurePortDwdmCoherentSweep) DeepCopy() *ConfigurePortDwdmCoherentSweep {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortDwdmCoherentSweep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortDwdmWavetracker) DeepCopyInto(out *ConfigurePortDwdmWavetracker) {
+	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -1044,93 +1045,1646 @@ func (in *ConfigurePortEthernetAccess) DeepCopyInto(out *ConfigurePortEthernetAc
 		*out = new(string)
 		**out = **in
 	}
-	if in.Bandwidth != nil {
-		in, out := &in.Bandwidth, &out.Bandwidth
-		*out = new(uint64)
-		**out = **in
+	if in.Encode != nil {
+		in, out := &in.Encode, &out.Encode
+		*out = new(ConfigurePortDwdmWavetrackerEncode)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.BookingFactor != nil {
-		in, out := &in.BookingFactor, &out.BookingFactor
-		*out = new(uint32)
-		**out = **in
+	if in.PowerControl != nil {
+		in, out := &in.PowerControl, &out.PowerControl
+		*out = new(ConfigurePortDwdmWavetrackerPowerControl)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
+	if in.ReportAlarm != nil {
+		in, out := &in.ReportAlarm, &out.ReportAlarm
+		*out = new(ConfigurePortDwdmWavetrackerReportAlarm)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmWavetracker.
+func (in *ConfigurePortDwdmWavetracker) DeepCopy() *ConfigurePortDwdmWavetracker {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortDwdmWavetracker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortDwdmWavetrackerEncode) DeepCopyInto(out *ConfigurePortDwdmWavetrackerEncode) {
+	*out = *in
+	if in.Key1 != nil {
+		in, out := &in.Key1, &out.Key1
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Key2 != nil {
+		in, out := &in.Key2, &out.Key2
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmWavetrackerEncode.
+func (in *ConfigurePortDwdmWavetrackerEncode) DeepCopy() *ConfigurePortDwdmWavetrackerEncode {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortDwdmWavetrackerEncode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortDwdmWavetrackerPowerControl) DeepCopyInto(out *ConfigurePortDwdmWavetrackerPowerControl) {
+	*out = *in
+	if in.TargetPower != nil {
+		in, out := &in.TargetPower, &out.TargetPower
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmWavetrackerPowerControl.
+func (in *ConfigurePortDwdmWavetrackerPowerControl) DeepCopy() *ConfigurePortDwdmWavetrackerPowerControl {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortDwdmWavetrackerPowerControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortDwdmWavetrackerReportAlarm) DeepCopyInto(out *ConfigurePortDwdmWavetrackerReportAlarm) {
+	*out = *in
+	if in.EncoderDegrade != nil {
+		in, out := &in.EncoderDegrade, &out.EncoderDegrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EncoderFailure != nil {
+		in, out := &in.EncoderFailure, &out.EncoderFailure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MissingPluggableVoa != nil {
+		in, out := &in.MissingPluggableVoa, &out.MissingPluggableVoa
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PowerControlDegrade != nil {
+		in, out := &in.PowerControlDegrade, &out.PowerControlDegrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PowerControlFailure != nil {
+		in, out := &in.PowerControlFailure, &out.PowerControlFailure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PowerControlHighLimit != nil {
+		in, out := &in.PowerControlHighLimit, &out.PowerControlHighLimit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PowerControlLowLimit != nil {
+		in, out := &in.PowerControlLowLimit, &out.PowerControlLowLimit
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortDwdmWavetrackerReportAlarm.
+func (in *ConfigurePortDwdmWavetrackerReportAlarm) DeepCopy() *ConfigurePortDwdmWavetrackerReportAlarm {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortDwdmWavetrackerReportAlarm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernet) DeepCopyInto(out *ConfigurePortEthernet) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(ConfigurePortEthernetAccess)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Autonegotiate != nil {
+		in, out := &in.Autonegotiate, &out.Autonegotiate
+		*out = new(string)
+		**out = **in
+	}
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CrcMonitor != nil {
+		in, out := &in.CrcMonitor, &out.CrcMonitor
+		*out = new(ConfigurePortEthernetCrcMonitor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dampening != nil {
+		in, out := &in.Dampening, &out.Dampening
+		*out = new(ConfigurePortEthernetDampening)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiscardRxPauseFrames != nil {
+		in, out := &in.DiscardRxPauseFrames, &out.DiscardRxPauseFrames
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Dot1qEtype != nil {
+		in, out := &in.Dot1qEtype, &out.Dot1qEtype
+		*out = new(string)
+		**out = **in
+	}
+	if in.Dot1x != nil {
+		in, out := &in.Dot1x, &out.Dot1x
+		*out = new(ConfigurePortEthernetDot1x)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DownOnInternalError != nil {
+		in, out := &in.DownOnInternalError, &out.DownOnInternalError
+		*out = new(ConfigurePortEthernetDownOnInternalError)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DownWhenLooped != nil {
+		in, out := &in.DownWhenLooped, &out.DownWhenLooped
+		*out = new(ConfigurePortEthernetDownWhenLooped)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duplex != nil {
+		in, out := &in.Duplex, &out.Duplex
+		*out = new(string)
+		**out = **in
+	}
+	if in.EfmOam != nil {
+		in, out := &in.EfmOam, &out.EfmOam
+		*out = new(ConfigurePortEthernetEfmOam)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Egress != nil {
 		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortEthernetAccessEgress)
+		*out = new(ConfigurePortEthernetEgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elmi != nil {
+		in, out := &in.Elmi, &out.Elmi
+		*out = new(ConfigurePortEthernetElmi)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EncapType != nil {
+		in, out := &in.EncapType, &out.EncapType
+		*out = new(string)
+		**out = **in
+	}
+	if in.EthCfm != nil {
+		in, out := &in.EthCfm, &out.EthCfm
+		*out = new(ConfigurePortEthernetEthCfm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HoldTime != nil {
+		in, out := &in.HoldTime, &out.HoldTime
+		*out = new(ConfigurePortEthernetHoldTime)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HsmdaSchedulerOverrides != nil {
+		in, out := &in.HsmdaSchedulerOverrides, &out.HsmdaSchedulerOverrides
+		*out = new(ConfigurePortEthernetHsmdaSchedulerOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(ConfigurePortEthernetIngress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LacpTunnel != nil {
+		in, out := &in.LacpTunnel, &out.LacpTunnel
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Lldp != nil {
+		in, out := &in.Lldp, &out.Lldp
+		*out = new(ConfigurePortEthernetLldp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancingAlgorithm != nil {
+		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.Loopback != nil {
+		in, out := &in.Loopback, &out.Loopback
+		*out = new(ConfigurePortEthernetLoopback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinFrameLength != nil {
+		in, out := &in.MinFrameLength, &out.MinFrameLength
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mtu != nil {
+		in, out := &in.Mtu, &out.Mtu
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ConfigurePortEthernetNetwork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PbbEtype != nil {
+		in, out := &in.PbbEtype, &out.PbbEtype
+		*out = new(string)
+		**out = **in
+	}
+	if in.PtpAsymmetry != nil {
+		in, out := &in.PtpAsymmetry, &out.PtpAsymmetry
+		*out = new(int32)
+		**out = **in
+	}
+	if in.QinqEtype != nil {
+		in, out := &in.QinqEtype, &out.QinqEtype
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReportAlarm != nil {
+		in, out := &in.ReportAlarm, &out.ReportAlarm
+		*out = new(ConfigurePortEthernetReportAlarm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RsFecMode != nil {
+		in, out := &in.RsFecMode, &out.RsFecMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SingleFiber != nil {
+		in, out := &in.SingleFiber, &out.SingleFiber
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Ssm != nil {
+		in, out := &in.Ssm, &out.Ssm
+		*out = new(ConfigurePortEthernetSsm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SymbolMonitor != nil {
+		in, out := &in.SymbolMonitor, &out.SymbolMonitor
+		*out = new(ConfigurePortEthernetSymbolMonitor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UtilStatsInterval != nil {
+		in, out := &in.UtilStatsInterval, &out.UtilStatsInterval
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Xgig != nil {
+		in, out := &in.Xgig, &out.Xgig
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernet.
+func (in *ConfigurePortEthernet) DeepCopy() *ConfigurePortEthernet {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccess) DeepCopyInto(out *ConfigurePortEthernetAccess) {
+	*out = *in
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.BookingFactor != nil {
+		in, out := &in.BookingFactor, &out.BookingFactor
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(ConfigurePortEthernetAccessEgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(ConfigurePortEthernetAccessIngress)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccess.
+func (in *ConfigurePortEthernetAccess) DeepCopy() *ConfigurePortEthernetAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgress) DeepCopyInto(out *ConfigurePortEthernetAccessEgress) {
+	*out = *in
+	if in.QueueGroup != nil {
+		in, out := &in.QueueGroup, &out.QueueGroup
+		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetAccessEgressQueueGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VirtualPort != nil {
+		in, out := &in.VirtualPort, &out.VirtualPort
+		*out = make([]*ConfigurePortEthernetAccessEgressVirtualPort, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetAccessEgressVirtualPort)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgress.
+func (in *ConfigurePortEthernetAccessEgress) DeepCopy() *ConfigurePortEthernetAccessEgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroup) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroup) {
+	*out = *in
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AggregateRate != nil {
+		in, out := &in.AggregateRate, &out.AggregateRate
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupAggregateRate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostMatch != nil {
+		in, out := &in.HostMatch, &out.HostMatch
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupHostMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HsTurbo != nil {
+		in, out := &in.HsTurbo, &out.HsTurbo
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HsmdaQueueOverrides != nil {
+		in, out := &in.HsmdaQueueOverrides, &out.HsmdaQueueOverrides
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceId != nil {
+		in, out := &in.InstanceId, &out.InstanceId
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.QueueGroupName != nil {
+		in, out := &in.QueueGroupName, &out.QueueGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueOverrides != nil {
+		in, out := &in.QueueOverrides, &out.QueueOverrides
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulerPolicy != nil {
+		in, out := &in.SchedulerPolicy, &out.SchedulerPolicy
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroup.
+func (in *ConfigurePortEthernetAccessEgressQueueGroup) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupAggregateRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupAggregateRate) {
+	*out = *in
+	if in.LimitUnusedBandwidth != nil {
+		in, out := &in.LimitUnusedBandwidth, &out.LimitUnusedBandwidth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QueueFrameBasedAccounting != nil {
+		in, out := &in.QueueFrameBasedAccounting, &out.QueueFrameBasedAccounting
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupAggregateRate.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupAggregateRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupAggregateRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupAggregateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupHostMatch) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupHostMatch) {
+	*out = *in
+	if in.IntDestId != nil {
+		in, out := &in.IntDestId, &out.IntDestId
+		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupHostMatch.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupHostMatch) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupHostMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupHostMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId) {
+	*out = *in
+	if in.DestinationString != nil {
+		in, out := &in.DestinationString, &out.DestinationString
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides) {
+	*out = *in
+	if in.PacketByteOffset != nil {
+		in, out := &in.PacketByteOffset, &out.PacketByteOffset
+		*out = new(string)
+		**out = **in
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SecondaryShaper != nil {
+		in, out := &in.SecondaryShaper, &out.SecondaryShaper
+		*out = new(string)
+		**out = **in
+	}
+	if in.WrrPolicy != nil {
+		in, out := &in.WrrPolicy, &out.WrrPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue) {
+	*out = *in
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mbs != nil {
+		in, out := &in.Mbs, &out.Mbs
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueId != nil {
+		in, out := &in.QueueId, &out.QueueId
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
+		**out = **in
+	}
+	if in.SlopePolicy != nil {
+		in, out := &in.SlopePolicy, &out.SlopePolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.WrrWeight != nil {
+		in, out := &in.WrrWeight, &out.WrrWeight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides) {
+	*out = *in
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue) {
+	*out = *in
+	if in.AdaptationRule != nil {
+		in, out := &in.AdaptationRule, &out.AdaptationRule
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.BurstLimit != nil {
+		in, out := &in.BurstLimit, &out.BurstLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.Cbs != nil {
+		in, out := &in.Cbs, &out.Cbs
+		*out = new(string)
+		**out = **in
+	}
+	if in.DropTail != nil {
+		in, out := &in.DropTail, &out.DropTail
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mbs != nil {
+		in, out := &in.Mbs, &out.Mbs
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonitorDepth != nil {
+		in, out := &in.MonitorDepth, &out.MonitorDepth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MonitorQueueDepth != nil {
+		in, out := &in.MonitorQueueDepth, &out.MonitorQueueDepth
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parent != nil {
+		in, out := &in.Parent, &out.Parent
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueId != nil {
+		in, out := &in.QueueId, &out.QueueId
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueOverrideRate != nil {
+		in, out := &in.QueueOverrideRate, &out.QueueOverrideRate
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule) {
+	*out = *in
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail) {
+	*out = *in
+	if in.Low != nil {
+		in, out := &in.Low, &out.Low
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow) {
+	*out = *in
+	if in.PercentReductionFromMbs != nil {
+		in, out := &in.PercentReductionFromMbs, &out.PercentReductionFromMbs
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) {
+	*out = *in
+	if in.FastPolling != nil {
+		in, out := &in.FastPolling, &out.FastPolling
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ViolationThreshold != nil {
+		in, out := &in.ViolationThreshold, &out.ViolationThreshold
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent) {
+	*out = *in
+	if in.CirWeight != nil {
+		in, out := &in.CirWeight, &out.CirWeight
+		*out = new(string)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate) {
+	*out = *in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) {
+	*out = *in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) {
+	*out = *in
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) {
+	*out = *in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) {
+	*out = *in
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides) {
+	*out = *in
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler) {
+	*out = *in
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parent != nil {
+		in, out := &in.Parent, &out.Parent
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulerName != nil {
+		in, out := &in.SchedulerName, &out.SchedulerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent) {
+	*out = *in
+	if in.CirWeight != nil {
+		in, out := &in.CirWeight, &out.CirWeight
+		*out = new(string)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate) {
+	*out = *in
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate.
+func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressVirtualPort) DeepCopyInto(out *ConfigurePortEthernetAccessEgressVirtualPort) {
+	*out = *in
+	if in.AggregateRate != nil {
+		in, out := &in.AggregateRate, &out.AggregateRate
+		*out = new(ConfigurePortEthernetAccessEgressVirtualPortAggregateRate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostMatch != nil {
+		in, out := &in.HostMatch, &out.HostMatch
+		*out = new(ConfigurePortEthernetAccessEgressVirtualPortHostMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HwAggShaperSchedulerPolicy != nil {
+		in, out := &in.HwAggShaperSchedulerPolicy, &out.HwAggShaperSchedulerPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonitorHwAggShaperScheduler != nil {
+		in, out := &in.MonitorHwAggShaperScheduler, &out.MonitorHwAggShaperScheduler
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MonitorPortScheduler != nil {
+		in, out := &in.MonitorPortScheduler, &out.MonitorPortScheduler
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MulticastHqosAdjustment != nil {
+		in, out := &in.MulticastHqosAdjustment, &out.MulticastHqosAdjustment
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PortSchedulerPolicy != nil {
+		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.SchedulerPolicy != nil {
+		in, out := &in.SchedulerPolicy, &out.SchedulerPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.VportName != nil {
+		in, out := &in.VportName, &out.VportName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressVirtualPort.
+func (in *ConfigurePortEthernetAccessEgressVirtualPort) DeepCopy() *ConfigurePortEthernetAccessEgressVirtualPort {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressVirtualPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressVirtualPortAggregateRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressVirtualPortAggregateRate) {
+	*out = *in
+	if in.LimitUnusedBandwidth != nil {
+		in, out := &in.LimitUnusedBandwidth, &out.LimitUnusedBandwidth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressVirtualPortAggregateRate.
+func (in *ConfigurePortEthernetAccessEgressVirtualPortAggregateRate) DeepCopy() *ConfigurePortEthernetAccessEgressVirtualPortAggregateRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressVirtualPortAggregateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressVirtualPortHostMatch) DeepCopyInto(out *ConfigurePortEthernetAccessEgressVirtualPortHostMatch) {
+	*out = *in
+	if in.IntDestId != nil {
+		in, out := &in.IntDestId, &out.IntDestId
+		*out = make([]*ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressVirtualPortHostMatch.
+func (in *ConfigurePortEthernetAccessEgressVirtualPortHostMatch) DeepCopy() *ConfigurePortEthernetAccessEgressVirtualPortHostMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressVirtualPortHostMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId) DeepCopyInto(out *ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId) {
+	*out = *in
+	if in.DestinationString != nil {
+		in, out := &in.DestinationString, &out.DestinationString
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId.
+func (in *ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId) DeepCopy() *ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngress) DeepCopyInto(out *ConfigurePortEthernetAccessIngress) {
+	*out = *in
+	if in.QueueGroup != nil {
+		in, out := &in.QueueGroup, &out.QueueGroup
+		*out = make([]*ConfigurePortEthernetAccessIngressQueueGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetAccessIngressQueueGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngress.
+func (in *ConfigurePortEthernetAccessIngress) DeepCopy() *ConfigurePortEthernetAccessIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroup) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroup) {
+	*out = *in
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueGroupName != nil {
+		in, out := &in.QueueGroupName, &out.QueueGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueOverrides != nil {
+		in, out := &in.QueueOverrides, &out.QueueOverrides
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulerPolicy != nil {
+		in, out := &in.SchedulerPolicy, &out.SchedulerPolicy
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroup.
+func (in *ConfigurePortEthernetAccessIngressQueueGroup) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngressQueueGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides) {
+	*out = *in
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = make([]*ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue) {
+	*out = *in
+	if in.AdaptationRule != nil {
+		in, out := &in.AdaptationRule, &out.AdaptationRule
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Cbs != nil {
+		in, out := &in.Cbs, &out.Cbs
+		*out = new(string)
+		**out = **in
+	}
+	if in.DropTail != nil {
+		in, out := &in.DropTail, &out.DropTail
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mbs != nil {
+		in, out := &in.Mbs, &out.Mbs
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonitorDepth != nil {
+		in, out := &in.MonitorDepth, &out.MonitorDepth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MonitorQueueDepth != nil {
+		in, out := &in.MonitorQueueDepth, &out.MonitorQueueDepth
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueId != nil {
+		in, out := &in.QueueId, &out.QueueId
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule) {
+	*out = *in
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail) {
+	*out = *in
+	if in.Low != nil {
+		in, out := &in.Low, &out.Low
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow) {
+	*out = *in
+	if in.PercentReductionFromMbs != nil {
+		in, out := &in.PercentReductionFromMbs, &out.PercentReductionFromMbs
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth) {
+	*out = *in
+	if in.FastPolling != nil {
+		in, out := &in.FastPolling, &out.FastPolling
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate) {
+	*out = *in
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(ConfigurePortEthernetAccessIngress)
-		(*in).DeepCopyInto(*out)
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccess.
-func (in *ConfigurePortEthernetAccess) DeepCopy() *ConfigurePortEthernetAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccess)
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgress) DeepCopyInto(out *ConfigurePortEthernetAccessEgress) {
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides) {
 	*out = *in
-	if in.QueueGroup != nil {
-		in, out := &in.QueueGroup, &out.QueueGroup
-		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessEgressQueueGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.VirtualPort != nil {
-		in, out := &in.VirtualPort, &out.VirtualPort
-		*out = make([]*ConfigurePortEthernetAccessEgressVirtualPort, len(*in))
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = make([]*ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessEgressVirtualPort)
+				*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgress.
-func (in *ConfigurePortEthernetAccessEgress) DeepCopy() *ConfigurePortEthernetAccessEgress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgress)
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroup) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroup) {
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler) {
 	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.AggregateRate != nil {
-		in, out := &in.AggregateRate, &out.AggregateRate
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupAggregateRate)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -1141,182 +2695,215 @@ func (in *ConfigurePortEthernetAccessEgressQueueGroup) DeepCopyInto(out *Configu
 		*out = new(string)
 		**out = **in
 	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
+	if in.Parent != nil {
+		in, out := &in.Parent, &out.Parent
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.HostMatch != nil {
-		in, out := &in.HostMatch, &out.HostMatch
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupHostMatch)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.HsTurbo != nil {
-		in, out := &in.HsTurbo, &out.HsTurbo
-		*out = new(bool)
+	if in.SchedulerName != nil {
+		in, out := &in.SchedulerName, &out.SchedulerName
+		*out = new(string)
 		**out = **in
 	}
-	if in.HsmdaQueueOverrides != nil {
-		in, out := &in.HsmdaQueueOverrides, &out.HsmdaQueueOverrides
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler {
+	if in == nil {
+		return nil
 	}
-	if in.InstanceId != nil {
-		in, out := &in.InstanceId, &out.InstanceId
-		*out = new(uint16)
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent) {
+	*out = *in
+	if in.CirWeight != nil {
+		in, out := &in.CirWeight, &out.CirWeight
+		*out = new(string)
 		**out = **in
 	}
-	if in.QueueGroupName != nil {
-		in, out := &in.QueueGroupName, &out.QueueGroupName
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
 		*out = new(string)
 		**out = **in
 	}
-	if in.QueueOverrides != nil {
-		in, out := &in.QueueOverrides, &out.QueueOverrides
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SchedulerPolicy != nil {
-		in, out := &in.SchedulerPolicy, &out.SchedulerPolicy
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroup.
-func (in *ConfigurePortEthernetAccessEgressQueueGroup) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroup)
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupAggregateRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupAggregateRate) {
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate) {
 	*out = *in
-	if in.LimitUnusedBandwidth != nil {
-		in, out := &in.LimitUnusedBandwidth, &out.LimitUnusedBandwidth
-		*out = new(bool)
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
 		**out = **in
 	}
-	if in.QueueFrameBasedAccounting != nil {
-		in, out := &in.QueueFrameBasedAccounting, &out.QueueFrameBasedAccounting
-		*out = new(bool)
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
 		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate.
+func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetCrcMonitor) DeepCopyInto(out *ConfigurePortEthernetCrcMonitor) {
+	*out = *in
+	if in.SignalDegrade != nil {
+		in, out := &in.SignalDegrade, &out.SignalDegrade
+		*out = new(ConfigurePortEthernetCrcMonitorSignalDegrade)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SignalFailure != nil {
+		in, out := &in.SignalFailure, &out.SignalFailure
+		*out = new(ConfigurePortEthernetCrcMonitorSignalFailure)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WindowSize != nil {
+		in, out := &in.WindowSize, &out.WindowSize
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupAggregateRate.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupAggregateRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupAggregateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetCrcMonitor.
+func (in *ConfigurePortEthernetCrcMonitor) DeepCopy() *ConfigurePortEthernetCrcMonitor {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupAggregateRate)
+	out := new(ConfigurePortEthernetCrcMonitor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupHostMatch) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupHostMatch) {
+func (in *ConfigurePortEthernetCrcMonitorSignalDegrade) DeepCopyInto(out *ConfigurePortEthernetCrcMonitorSignalDegrade) {
 	*out = *in
-	if in.IntDestId != nil {
-		in, out := &in.IntDestId, &out.IntDestId
-		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(uint32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupHostMatch.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupHostMatch) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupHostMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetCrcMonitorSignalDegrade.
+func (in *ConfigurePortEthernetCrcMonitorSignalDegrade) DeepCopy() *ConfigurePortEthernetCrcMonitorSignalDegrade {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupHostMatch)
+	out := new(ConfigurePortEthernetCrcMonitorSignalDegrade)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId) {
+func (in *ConfigurePortEthernetCrcMonitorSignalFailure) DeepCopyInto(out *ConfigurePortEthernetCrcMonitorSignalFailure) {
 	*out = *in
-	if in.DestinationString != nil {
-		in, out := &in.DestinationString, &out.DestinationString
-		*out = new(string)
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetCrcMonitorSignalFailure.
+func (in *ConfigurePortEthernetCrcMonitorSignalFailure) DeepCopy() *ConfigurePortEthernetCrcMonitorSignalFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupHostMatchIntDestId)
+	out := new(ConfigurePortEthernetCrcMonitorSignalFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides) {
+func (in *ConfigurePortEthernetDampening) DeepCopyInto(out *ConfigurePortEthernetDampening) {
 	*out = *in
-	if in.PacketByteOffset != nil {
-		in, out := &in.PacketByteOffset, &out.PacketByteOffset
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Queue != nil {
-		in, out := &in.Queue, &out.Queue
-		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.HalfLife != nil {
+		in, out := &in.HalfLife, &out.HalfLife
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.SecondaryShaper != nil {
-		in, out := &in.SecondaryShaper, &out.SecondaryShaper
-		*out = new(string)
+	if in.MaxSuppressTime != nil {
+		in, out := &in.MaxSuppressTime, &out.MaxSuppressTime
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.WrrPolicy != nil {
-		in, out := &in.WrrPolicy, &out.WrrPolicy
-		*out = new(string)
+	if in.ReuseThreshold != nil {
+		in, out := &in.ReuseThreshold, &out.ReuseThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SuppressThreshold != nil {
+		in, out := &in.SuppressThreshold, &out.SuppressThreshold
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDampening.
+func (in *ConfigurePortEthernetDampening) DeepCopy() *ConfigurePortEthernetDampening {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverrides)
+	out := new(ConfigurePortEthernetDampening)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue) {
+func (in *ConfigurePortEthernetDot1x) DeepCopyInto(out *ConfigurePortEthernetDot1x) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -1328,522 +2915,617 @@ func (in *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue) D
 		*out = new(string)
 		**out = **in
 	}
-	if in.Mbs != nil {
-		in, out := &in.Mbs, &out.Mbs
-		*out = new(string)
+	if in.Macsec != nil {
+		in, out := &in.Macsec, &out.Macsec
+		*out = new(ConfigurePortEthernetDot1xMacsec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxAuthenticationRequests != nil {
+		in, out := &in.MaxAuthenticationRequests, &out.MaxAuthenticationRequests
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.QueueId != nil {
-		in, out := &in.QueueId, &out.QueueId
+	if in.PerHostAuthentication != nil {
+		in, out := &in.PerHostAuthentication, &out.PerHostAuthentication
+		*out = new(ConfigurePortEthernetDot1xPerHostAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PortControl != nil {
+		in, out := &in.PortControl, &out.PortControl
 		*out = new(string)
 		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
+	if in.QuietPeriod != nil {
+		in, out := &in.QuietPeriod, &out.QuietPeriod
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.SlopePolicy != nil {
-		in, out := &in.SlopePolicy, &out.SlopePolicy
+	if in.RadiusPolicy != nil {
+		in, out := &in.RadiusPolicy, &out.RadiusPolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.WrrWeight != nil {
-		in, out := &in.WrrWeight, &out.WrrWeight
-		*out = new(int32)
+	if in.RadiusServerPolicyConfig != nil {
+		in, out := &in.RadiusServerPolicyConfig, &out.RadiusServerPolicyConfig
+		*out = new(ConfigurePortEthernetDot1xRadiusServerPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReAuthentication != nil {
+		in, out := &in.ReAuthentication, &out.ReAuthentication
+		*out = new(ConfigurePortEthernetDot1xReAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerTimeout != nil {
+		in, out := &in.ServerTimeout, &out.ServerTimeout
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SupplicantTimeout != nil {
+		in, out := &in.SupplicantTimeout, &out.SupplicantTimeout
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TransmitPeriod != nil {
+		in, out := &in.TransmitPeriod, &out.TransmitPeriod
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TunnelDot1q != nil {
+		in, out := &in.TunnelDot1q, &out.TunnelDot1q
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TunnelQinq != nil {
+		in, out := &in.TunnelQinq, &out.TunnelQinq
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tunneling != nil {
+		in, out := &in.Tunneling, &out.Tunneling
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1x.
+func (in *ConfigurePortEthernetDot1x) DeepCopy() *ConfigurePortEthernetDot1x {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupHsmdaQueueOverridesQueue)
+	out := new(ConfigurePortEthernetDot1x)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides) {
+func (in *ConfigurePortEthernetDot1xMacsec) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsec) {
 	*out = *in
-	if in.Queue != nil {
-		in, out := &in.Queue, &out.Queue
-		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue, len(*in))
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExcludeMacPolicy != nil {
+		in, out := &in.ExcludeMacPolicy, &out.ExcludeMacPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExcludeProtocol != nil {
+		in, out := &in.ExcludeProtocol, &out.ExcludeProtocol
+		*out = new(ConfigurePortEthernetDot1xMacsecExcludeProtocol)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RxMustBeEncrypted != nil {
+		in, out := &in.RxMustBeEncrypted, &out.RxMustBeEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubPort != nil {
+		in, out := &in.SubPort, &out.SubPort
+		*out = make([]*ConfigurePortEthernetDot1xMacsecSubPort, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue)
+				*out = new(ConfigurePortEthernetDot1xMacsecSubPort)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsec.
+func (in *ConfigurePortEthernetDot1xMacsec) DeepCopy() *ConfigurePortEthernetDot1xMacsec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverrides)
+	out := new(ConfigurePortEthernetDot1xMacsec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue) {
+func (in *ConfigurePortEthernetDot1xMacsecExcludeProtocol) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecExcludeProtocol) {
 	*out = *in
-	if in.AdaptationRule != nil {
-		in, out := &in.AdaptationRule, &out.AdaptationRule
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
+	if in.Cdp != nil {
+		in, out := &in.Cdp, &out.Cdp
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
+	if in.EapolStart != nil {
+		in, out := &in.EapolStart, &out.EapolStart
+		*out = new(bool)
 		**out = **in
 	}
-	if in.BurstLimit != nil {
-		in, out := &in.BurstLimit, &out.BurstLimit
-		*out = new(string)
+	if in.EfmOam != nil {
+		in, out := &in.EfmOam, &out.EfmOam
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Cbs != nil {
-		in, out := &in.Cbs, &out.Cbs
-		*out = new(string)
+	if in.EthCfm != nil {
+		in, out := &in.EthCfm, &out.EthCfm
+		*out = new(bool)
 		**out = **in
 	}
-	if in.DropTail != nil {
-		in, out := &in.DropTail, &out.DropTail
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Mbs != nil {
-		in, out := &in.Mbs, &out.Mbs
-		*out = new(string)
+	if in.Lacp != nil {
+		in, out := &in.Lacp, &out.Lacp
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MonitorDepth != nil {
-		in, out := &in.MonitorDepth, &out.MonitorDepth
+	if in.Lldp != nil {
+		in, out := &in.Lldp, &out.Lldp
 		*out = new(bool)
 		**out = **in
 	}
-	if in.MonitorQueueDepth != nil {
-		in, out := &in.MonitorQueueDepth, &out.MonitorQueueDepth
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Parent != nil {
-		in, out := &in.Parent, &out.Parent
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.QueueId != nil {
-		in, out := &in.QueueId, &out.QueueId
-		*out = new(string)
+	if in.Ptp != nil {
+		in, out := &in.Ptp, &out.Ptp
+		*out = new(bool)
 		**out = **in
 	}
-	if in.QueueOverrideRate != nil {
-		in, out := &in.QueueOverrideRate, &out.QueueOverrideRate
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate)
-		(*in).DeepCopyInto(*out)
+	if in.Ubfd != nil {
+		in, out := &in.Ubfd, &out.Ubfd
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecExcludeProtocol.
+func (in *ConfigurePortEthernetDot1xMacsecExcludeProtocol) DeepCopy() *ConfigurePortEthernetDot1xMacsecExcludeProtocol {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueue)
+	out := new(ConfigurePortEthernetDot1xMacsecExcludeProtocol)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule) {
+func (in *ConfigurePortEthernetDot1xMacsecSubPort) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPort) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.CaName != nil {
+		in, out := &in.CaName, &out.CaName
 		*out = new(string)
 		**out = **in
 	}
+	if in.EapolDestinationAddress != nil {
+		in, out := &in.EapolDestinationAddress, &out.EapolDestinationAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncapMatch != nil {
+		in, out := &in.EncapMatch, &out.EncapMatch
+		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxPeers != nil {
+		in, out := &in.MaxPeers, &out.MaxPeers
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SubPortId != nil {
+		in, out := &in.SubPortId, &out.SubPortId
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPort.
+func (in *ConfigurePortEthernetDot1xMacsecSubPort) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPort {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueAdaptationRule)
+	out := new(ConfigurePortEthernetDot1xMacsecSubPort)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail) {
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatch) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatch) {
 	*out = *in
-	if in.Low != nil {
-		in, out := &in.Low, &out.Low
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow)
+	if in.Encap != nil {
+		in, out := &in.Encap, &out.Encap
+		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatch.
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatch) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatch {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTail)
+	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow) {
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap) {
 	*out = *in
-	if in.PercentReductionFromMbs != nil {
-		in, out := &in.PercentReductionFromMbs, &out.PercentReductionFromMbs
-		*out = new(string)
-		**out = **in
+	if in.AllMatch != nil {
+		in, out := &in.AllMatch, &out.AllMatch
+		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DoubleTag != nil {
+		in, out := &in.DoubleTag, &out.DoubleTag
+		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SingleTag != nil {
+		in, out := &in.SingleTag, &out.SingleTag
+		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Untagged != nil {
+		in, out := &in.Untagged, &out.Untagged
+		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap.
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueDropTailLow)
+	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) {
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch) {
 	*out = *in
-	if in.FastPolling != nil {
-		in, out := &in.FastPolling, &out.FastPolling
+	if in.AllMatch != nil {
+		in, out := &in.AllMatch, &out.AllMatch
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ViolationThreshold != nil {
-		in, out := &in.ViolationThreshold, &out.ViolationThreshold
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch.
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueMonitorQueueDepth)
+	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent) {
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag) {
 	*out = *in
-	if in.CirWeight != nil {
-		in, out := &in.CirWeight, &out.CirWeight
-		*out = new(string)
-		**out = **in
-	}
-	if in.Weight != nil {
-		in, out := &in.Weight, &out.Weight
+	if in.DoubleTag != nil {
+		in, out := &in.DoubleTag, &out.DoubleTag
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag.
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueParent)
+	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate) {
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate)
-		(*in).DeepCopyInto(*out)
+	if in.SingleTag != nil {
+		in, out := &in.SingleTag, &out.SingleTag
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag.
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRate)
+	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) {
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.Untagged != nil {
+		in, out := &in.Untagged, &out.Untagged
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged.
+func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate)
+	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) {
+func (in *ConfigurePortEthernetDot1xPerHostAuthentication) DeepCopyInto(out *ConfigurePortEthernetDot1xPerHostAuthentication) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
-		*out = new(string)
+	if in.AllowedSourceMacs != nil {
+		in, out := &in.AllowedSourceMacs, &out.AllowedSourceMacs
+		*out = new(ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthenticatorInit != nil {
+		in, out := &in.AuthenticatorInit, &out.AuthenticatorInit
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xPerHostAuthentication.
+func (in *ConfigurePortEthernetDot1xPerHostAuthentication) DeepCopy() *ConfigurePortEthernetDot1xPerHostAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate)
+	out := new(ConfigurePortEthernetDot1xPerHostAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) {
+func (in *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs) DeepCopyInto(out *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate)
-		(*in).DeepCopyInto(*out)
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = make([]*ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs.
+func (in *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs) DeepCopy() *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate)
+	out := new(ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) {
+func (in *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress) DeepCopyInto(out *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
-		*out = new(string)
-		**out = **in
-	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.Mac != nil {
+		in, out := &in.Mac, &out.Mac
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress.
+func (in *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress) DeepCopy() *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate)
+	out := new(ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy) {
+func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfig) DeepCopyInto(out *ConfigurePortEthernetDot1xRadiusServerPolicyConfig) {
 	*out = *in
-	if in.Overrides != nil {
-		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides)
+	if in.Common != nil {
+		in, out := &in.Common, &out.Common
+		*out = new(ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PolicyName != nil {
-		in, out := &in.PolicyName, &out.PolicyName
-		*out = new(string)
-		**out = **in
+	if in.Split != nil {
+		in, out := &in.Split, &out.Split
+		*out = new(ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xRadiusServerPolicyConfig.
+func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfig) DeepCopy() *ConfigurePortEthernetDot1xRadiusServerPolicyConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicy)
+	out := new(ConfigurePortEthernetDot1xRadiusServerPolicyConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides) {
+func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon) DeepCopyInto(out *ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon) {
 	*out = *in
-	if in.Scheduler != nil {
-		in, out := &in.Scheduler, &out.Scheduler
-		*out = make([]*ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.RadiusServerPolicy != nil {
+		in, out := &in.RadiusServerPolicy, &out.RadiusServerPolicy
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon.
+func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon) DeepCopy() *ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverrides)
+	out := new(ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler) {
+func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit) DeepCopyInto(out *ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
+	if in.RadiusServerPolicyAcct != nil {
+		in, out := &in.RadiusServerPolicyAcct, &out.RadiusServerPolicyAcct
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.RadiusServerPolicyAuth != nil {
+		in, out := &in.RadiusServerPolicyAuth, &out.RadiusServerPolicyAuth
 		*out = new(string)
 		**out = **in
 	}
-	if in.Parent != nil {
-		in, out := &in.Parent, &out.Parent
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit.
+func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit) DeepCopy() *ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit {
+	if in == nil {
+		return nil
 	}
-	if in.SchedulerName != nil {
-		in, out := &in.SchedulerName, &out.SchedulerName
-		*out = new(string)
+	out := new(ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetDot1xReAuthentication) DeepCopyInto(out *ConfigurePortEthernetDot1xReAuthentication) {
+	*out = *in
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xReAuthentication.
+func (in *ConfigurePortEthernetDot1xReAuthentication) DeepCopy() *ConfigurePortEthernetDot1xReAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesScheduler)
+	out := new(ConfigurePortEthernetDot1xReAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent) {
+func (in *ConfigurePortEthernetDownOnInternalError) DeepCopyInto(out *ConfigurePortEthernetDownOnInternalError) {
 	*out = *in
-	if in.CirWeight != nil {
-		in, out := &in.CirWeight, &out.CirWeight
-		*out = new(string)
-		**out = **in
-	}
-	if in.Weight != nil {
-		in, out := &in.Weight, &out.Weight
+	if in.TxLaser != nil {
+		in, out := &in.TxLaser, &out.TxLaser
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDownOnInternalError.
+func (in *ConfigurePortEthernetDownOnInternalError) DeepCopy() *ConfigurePortEthernetDownOnInternalError {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerParent)
+	out := new(ConfigurePortEthernetDownOnInternalError)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate) {
+func (in *ConfigurePortEthernetDownWhenLooped) DeepCopyInto(out *ConfigurePortEthernetDownWhenLooped) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
-		*out = new(string)
+	if in.KeepAlive != nil {
+		in, out := &in.KeepAlive, &out.KeepAlive
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RetryTimeout != nil {
+		in, out := &in.RetryTimeout, &out.RetryTimeout
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.UseBroadcastAddress != nil {
+		in, out := &in.UseBroadcastAddress, &out.UseBroadcastAddress
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate.
-func (in *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate) DeepCopy() *ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDownWhenLooped.
+func (in *ConfigurePortEthernetDownWhenLooped) DeepCopy() *ConfigurePortEthernetDownWhenLooped {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressQueueGroupSchedulerPolicyOverridesSchedulerRate)
+	out := new(ConfigurePortEthernetDownWhenLooped)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressVirtualPort) DeepCopyInto(out *ConfigurePortEthernetAccessEgressVirtualPort) {
+func (in *ConfigurePortEthernetEfmOam) DeepCopyInto(out *ConfigurePortEthernetEfmOam) {
 	*out = *in
-	if in.AggregateRate != nil {
-		in, out := &in.AggregateRate, &out.AggregateRate
-		*out = new(ConfigurePortEthernetAccessEgressVirtualPortAggregateRate)
-		(*in).DeepCopyInto(*out)
+	if in.AcceptRemoteLoopback != nil {
+		in, out := &in.AcceptRemoteLoopback, &out.AcceptRemoteLoopback
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
 	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -1855,165 +3537,128 @@ func (in *ConfigurePortEthernetAccessEgressVirtualPort) DeepCopyInto(out *Config
 		*out = new(string)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.HostMatch != nil {
-		in, out := &in.HostMatch, &out.HostMatch
-		*out = new(ConfigurePortEthernetAccessEgressVirtualPortHostMatch)
+	if in.Discovery != nil {
+		in, out := &in.Discovery, &out.Discovery
+		*out = new(ConfigurePortEthernetEfmOamDiscovery)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.HwAggShaperSchedulerPolicy != nil {
-		in, out := &in.HwAggShaperSchedulerPolicy, &out.HwAggShaperSchedulerPolicy
-		*out = new(string)
+	if in.DyingGaspTxOnReset != nil {
+		in, out := &in.DyingGaspTxOnReset, &out.DyingGaspTxOnReset
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MonitorHwAggShaperScheduler != nil {
-		in, out := &in.MonitorHwAggShaperScheduler, &out.MonitorHwAggShaperScheduler
+	if in.GraceTx != nil {
+		in, out := &in.GraceTx, &out.GraceTx
 		*out = new(bool)
 		**out = **in
 	}
-	if in.MonitorPortScheduler != nil {
-		in, out := &in.MonitorPortScheduler, &out.MonitorPortScheduler
-		*out = new(bool)
+	if in.GraceVendorOui != nil {
+		in, out := &in.GraceVendorOui, &out.GraceVendorOui
+		*out = new(string)
 		**out = **in
 	}
-	if in.MulticastHqosAdjustment != nil {
-		in, out := &in.MulticastHqosAdjustment, &out.MulticastHqosAdjustment
-		*out = new(bool)
+	if in.HoldTime != nil {
+		in, out := &in.HoldTime, &out.HoldTime
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.PortSchedulerPolicy != nil {
-		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
-		*out = new(string)
+	if in.IgnoreEfmState != nil {
+		in, out := &in.IgnoreEfmState, &out.IgnoreEfmState
+		*out = new(bool)
 		**out = **in
 	}
-	if in.SchedulerPolicy != nil {
-		in, out := &in.SchedulerPolicy, &out.SchedulerPolicy
+	if in.LinkMonitoring != nil {
+		in, out := &in.LinkMonitoring, &out.LinkMonitoring
+		*out = new(ConfigurePortEthernetEfmOamLinkMonitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
 		*out = new(string)
 		**out = **in
 	}
-	if in.VportName != nil {
-		in, out := &in.VportName, &out.VportName
-		*out = new(string)
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressVirtualPort.
-func (in *ConfigurePortEthernetAccessEgressVirtualPort) DeepCopy() *ConfigurePortEthernetAccessEgressVirtualPort {
-	if in == nil {
-		return nil
+	if in.PeerRdiRx != nil {
+		in, out := &in.PeerRdiRx, &out.PeerRdiRx
+		*out = new(ConfigurePortEthernetEfmOamPeerRdiRx)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ConfigurePortEthernetAccessEgressVirtualPort)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressVirtualPortAggregateRate) DeepCopyInto(out *ConfigurePortEthernetAccessEgressVirtualPortAggregateRate) {
-	*out = *in
-	if in.LimitUnusedBandwidth != nil {
-		in, out := &in.LimitUnusedBandwidth, &out.LimitUnusedBandwidth
-		*out = new(bool)
+	if in.TransmitInterval != nil {
+		in, out := &in.TransmitInterval, &out.TransmitInterval
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
+	if in.TriggerFault != nil {
+		in, out := &in.TriggerFault, &out.TriggerFault
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressVirtualPortAggregateRate.
-func (in *ConfigurePortEthernetAccessEgressVirtualPortAggregateRate) DeepCopy() *ConfigurePortEthernetAccessEgressVirtualPortAggregateRate {
-	if in == nil {
-		return nil
-	}
-	out := new(ConfigurePortEthernetAccessEgressVirtualPortAggregateRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressVirtualPortHostMatch) DeepCopyInto(out *ConfigurePortEthernetAccessEgressVirtualPortHostMatch) {
-	*out = *in
-	if in.IntDestId != nil {
-		in, out := &in.IntDestId, &out.IntDestId
-		*out = make([]*ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Tunneling != nil {
+		in, out := &in.Tunneling, &out.Tunneling
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressVirtualPortHostMatch.
-func (in *ConfigurePortEthernetAccessEgressVirtualPortHostMatch) DeepCopy() *ConfigurePortEthernetAccessEgressVirtualPortHostMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOam.
+func (in *ConfigurePortEthernetEfmOam) DeepCopy() *ConfigurePortEthernetEfmOam {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressVirtualPortHostMatch)
+	out := new(ConfigurePortEthernetEfmOam)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId) DeepCopyInto(out *ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId) {
+func (in *ConfigurePortEthernetEfmOamDiscovery) DeepCopyInto(out *ConfigurePortEthernetEfmOamDiscovery) {
 	*out = *in
-	if in.DestinationString != nil {
-		in, out := &in.DestinationString, &out.DestinationString
-		*out = new(string)
-		**out = **in
+	if in.AdvertiseCapabilities != nil {
+		in, out := &in.AdvertiseCapabilities, &out.AdvertiseCapabilities
+		*out = new(ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId.
-func (in *ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId) DeepCopy() *ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamDiscovery.
+func (in *ConfigurePortEthernetEfmOamDiscovery) DeepCopy() *ConfigurePortEthernetEfmOamDiscovery {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessEgressVirtualPortHostMatchIntDestId)
+	out := new(ConfigurePortEthernetEfmOamDiscovery)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngress) DeepCopyInto(out *ConfigurePortEthernetAccessIngress) {
+func (in *ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities) DeepCopyInto(out *ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities) {
 	*out = *in
-	if in.QueueGroup != nil {
-		in, out := &in.QueueGroup, &out.QueueGroup
-		*out = make([]*ConfigurePortEthernetAccessIngressQueueGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessIngressQueueGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.LinkMonitoring != nil {
+		in, out := &in.LinkMonitoring, &out.LinkMonitoring
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngress.
-func (in *ConfigurePortEthernetAccessIngress) DeepCopy() *ConfigurePortEthernetAccessIngress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities.
+func (in *ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities) DeepCopy() *ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngress)
+	out := new(ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroup) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroup) {
+func (in *ConfigurePortEthernetEfmOamLinkMonitoring) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoring) {
 	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
@@ -2027,298 +3672,388 @@ func (in *ConfigurePortEthernetAccessIngressQueueGroup) DeepCopyInto(out *Config
 		*out = new(string)
 		**out = **in
 	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
+	if in.ErroredFrame != nil {
+		in, out := &in.ErroredFrame, &out.ErroredFrame
+		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
+	if in.ErroredFramePeriod != nil {
+		in, out := &in.ErroredFramePeriod, &out.ErroredFramePeriod
+		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.QueueGroupName != nil {
-		in, out := &in.QueueGroupName, &out.QueueGroupName
-		*out = new(string)
-		**out = **in
+	if in.ErroredFrameSeconds != nil {
+		in, out := &in.ErroredFrameSeconds, &out.ErroredFrameSeconds
+		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.QueueOverrides != nil {
-		in, out := &in.QueueOverrides, &out.QueueOverrides
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides)
+	if in.ErroredSymbols != nil {
+		in, out := &in.ErroredSymbols, &out.ErroredSymbols
+		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SchedulerPolicy != nil {
-		in, out := &in.SchedulerPolicy, &out.SchedulerPolicy
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy)
+	if in.LocalSfAction != nil {
+		in, out := &in.LocalSfAction, &out.LocalSfAction
+		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroup.
-func (in *ConfigurePortEthernetAccessIngressQueueGroup) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoring.
+func (in *ConfigurePortEthernetEfmOamLinkMonitoring) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoring {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroup)
+	out := new(ConfigurePortEthernetEfmOamLinkMonitoring)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides) {
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame) {
 	*out = *in
-	if in.Queue != nil {
-		in, out := &in.Queue, &out.Queue
-		*out = make([]*ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventNotification != nil {
+		in, out := &in.EventNotification, &out.EventNotification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SdThreshold != nil {
+		in, out := &in.SdThreshold, &out.SdThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SfThreshold != nil {
+		in, out := &in.SfThreshold, &out.SfThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(uint32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame.
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverrides)
+	out := new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue) {
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod) {
 	*out = *in
-	if in.AdaptationRule != nil {
-		in, out := &in.AdaptationRule, &out.AdaptationRule
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Cbs != nil {
-		in, out := &in.Cbs, &out.Cbs
-		*out = new(string)
+	if in.EventNotification != nil {
+		in, out := &in.EventNotification, &out.EventNotification
+		*out = new(bool)
 		**out = **in
 	}
-	if in.DropTail != nil {
-		in, out := &in.DropTail, &out.DropTail
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Mbs != nil {
-		in, out := &in.Mbs, &out.Mbs
-		*out = new(string)
+	if in.SdThreshold != nil {
+		in, out := &in.SdThreshold, &out.SdThreshold
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.MonitorDepth != nil {
-		in, out := &in.MonitorDepth, &out.MonitorDepth
-		*out = new(bool)
+	if in.SfThreshold != nil {
+		in, out := &in.SfThreshold, &out.SfThreshold
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.MonitorQueueDepth != nil {
-		in, out := &in.MonitorQueueDepth, &out.MonitorQueueDepth
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.QueueId != nil {
-		in, out := &in.QueueId, &out.QueueId
-		*out = new(string)
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod.
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueue)
+	out := new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule) {
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
-		*out = new(string)
+	if in.EventNotification != nil {
+		in, out := &in.EventNotification, &out.EventNotification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SdThreshold != nil {
+		in, out := &in.SdThreshold, &out.SdThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SfThreshold != nil {
+		in, out := &in.SfThreshold, &out.SfThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds.
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueAdaptationRule)
+	out := new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail) {
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols) {
 	*out = *in
-	if in.Low != nil {
-		in, out := &in.Low, &out.Low
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow)
-		(*in).DeepCopyInto(*out)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventNotification != nil {
+		in, out := &in.EventNotification, &out.EventNotification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SdThreshold != nil {
+		in, out := &in.SdThreshold, &out.SdThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SfThreshold != nil {
+		in, out := &in.SfThreshold, &out.SfThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(uint32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols.
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTail)
+	out := new(ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow) {
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction) {
 	*out = *in
-	if in.PercentReductionFromMbs != nil {
-		in, out := &in.PercentReductionFromMbs, &out.PercentReductionFromMbs
+	if in.EventNotificationBurst != nil {
+		in, out := &in.EventNotificationBurst, &out.EventNotificationBurst
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.InfoNotification != nil {
+		in, out := &in.InfoNotification, &out.InfoNotification
+		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalPortAction != nil {
+		in, out := &in.LocalPortAction, &out.LocalPortAction
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction.
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueDropTailLow)
+	out := new(ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth) {
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification) {
 	*out = *in
-	if in.FastPolling != nil {
-		in, out := &in.FastPolling, &out.FastPolling
+	if in.CriticalEvent != nil {
+		in, out := &in.CriticalEvent, &out.CriticalEvent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DyingGasp != nil {
+		in, out := &in.DyingGasp, &out.DyingGasp
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification.
+func (in *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueMonitorQueueDepth)
+	out := new(ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate) {
+func (in *ConfigurePortEthernetEfmOamPeerRdiRx) DeepCopyInto(out *ConfigurePortEthernetEfmOamPeerRdiRx) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.CriticalEvent != nil {
+		in, out := &in.CriticalEvent, &out.CriticalEvent
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.DyingGasp != nil {
+		in, out := &in.DyingGasp, &out.DyingGasp
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventNotification != nil {
+		in, out := &in.EventNotification, &out.EventNotification
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinkFault != nil {
+		in, out := &in.LinkFault, &out.LinkFault
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamPeerRdiRx.
+func (in *ConfigurePortEthernetEfmOamPeerRdiRx) DeepCopy() *ConfigurePortEthernetEfmOamPeerRdiRx {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupQueueOverridesQueueRate)
+	out := new(ConfigurePortEthernetEfmOamPeerRdiRx)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy) {
+func (in *ConfigurePortEthernetEgress) DeepCopyInto(out *ConfigurePortEthernetEgress) {
 	*out = *in
-	if in.Overrides != nil {
-		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides)
-		(*in).DeepCopyInto(*out)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
 	}
-	if in.PolicyName != nil {
-		in, out := &in.PolicyName, &out.PolicyName
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy {
-	if in == nil {
-		return nil
+	if in.EthBnRateChanges != nil {
+		in, out := &in.EthBnRateChanges, &out.EthBnRateChanges
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicy)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides) {
-	*out = *in
-	if in.Scheduler != nil {
-		in, out := &in.Scheduler, &out.Scheduler
-		*out = make([]*ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler, len(*in))
+	if in.ExpandedSecondaryShaper != nil {
+		in, out := &in.ExpandedSecondaryShaper, &out.ExpandedSecondaryShaper
+		*out = make([]*ConfigurePortEthernetEgressExpandedSecondaryShaper, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler)
+				*out = new(ConfigurePortEthernetEgressExpandedSecondaryShaper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.HsPortPoolPolicy != nil {
+		in, out := &in.HsPortPoolPolicy, &out.HsPortPoolPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.HsSchedulerPolicy != nil {
+		in, out := &in.HsSchedulerPolicy, &out.HsSchedulerPolicy
+		*out = new(ConfigurePortEthernetEgressHsSchedulerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HsSecondaryShaper != nil {
+		in, out := &in.HsSecondaryShaper, &out.HsSecondaryShaper
+		*out = make([]*ConfigurePortEthernetEgressHsSecondaryShaper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetEgressHsSecondaryShaper)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
+	if in.HsmdaSchedulerPolicy != nil {
+		in, out := &in.HsmdaSchedulerPolicy, &out.HsmdaSchedulerPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonitorPortScheduler != nil {
+		in, out := &in.MonitorPortScheduler, &out.MonitorPortScheduler
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PortQosPolicy != nil {
+		in, out := &in.PortQosPolicy, &out.PortQosPolicy
+		*out = new(ConfigurePortEthernetEgressPortQosPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PortSchedulerPolicy != nil {
+		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgress.
+func (in *ConfigurePortEthernetEgress) DeepCopy() *ConfigurePortEthernetEgress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverrides)
+	out := new(ConfigurePortEthernetEgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler) {
+func (in *ConfigurePortEthernetEgressExpandedSecondaryShaper) DeepCopyInto(out *ConfigurePortEthernetEgressExpandedSecondaryShaper) {
 	*out = *in
+	if in.AggregateBurst != nil {
+		in, out := &in.AggregateBurst, &out.AggregateBurst
+		*out = new(ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -2329,171 +4064,199 @@ func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSc
 		*out = new(string)
 		**out = **in
 	}
-	if in.Parent != nil {
-		in, out := &in.Parent, &out.Parent
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent)
-		(*in).DeepCopyInto(*out)
+	if in.Class != nil {
+		in, out := &in.Class, &out.Class
+		*out = make([]*ConfigurePortEthernetEgressExpandedSecondaryShaperClass, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetEgressExpandedSecondaryShaperClass)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.LowBurstMaxClass != nil {
+		in, out := &in.LowBurstMaxClass, &out.LowBurstMaxClass
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MonitorThreshold != nil {
+		in, out := &in.MonitorThreshold, &out.MonitorThreshold
+		*out = new(string)
+		**out = **in
 	}
 	if in.Rate != nil {
 		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate)
-		(*in).DeepCopyInto(*out)
+		*out = new(string)
+		**out = **in
 	}
-	if in.SchedulerName != nil {
-		in, out := &in.SchedulerName, &out.SchedulerName
+	if in.SecondaryShaperName != nil {
+		in, out := &in.SecondaryShaperName, &out.SecondaryShaperName
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressExpandedSecondaryShaper.
+func (in *ConfigurePortEthernetEgressExpandedSecondaryShaper) DeepCopy() *ConfigurePortEthernetEgressExpandedSecondaryShaper {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesScheduler)
+	out := new(ConfigurePortEthernetEgressExpandedSecondaryShaper)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent) {
+func (in *ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst) DeepCopyInto(out *ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst) {
 	*out = *in
-	if in.CirWeight != nil {
-		in, out := &in.CirWeight, &out.CirWeight
-		*out = new(string)
+	if in.HighBurstIncrease != nil {
+		in, out := &in.HighBurstIncrease, &out.HighBurstIncrease
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Weight != nil {
-		in, out := &in.Weight, &out.Weight
+	if in.LowBurstLimit != nil {
+		in, out := &in.LowBurstLimit, &out.LowBurstLimit
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst.
+func (in *ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst) DeepCopy() *ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerParent)
+	out := new(ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate) DeepCopyInto(out *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate) {
+func (in *ConfigurePortEthernetEgressExpandedSecondaryShaperClass) DeepCopyInto(out *ConfigurePortEthernetEgressExpandedSecondaryShaperClass) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate.
-func (in *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate) DeepCopy() *ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate {
-	if in == nil {
-		return nil
+	if in.BurstLimit != nil {
+		in, out := &in.BurstLimit, &out.BurstLimit
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortEthernetAccessIngressQueueGroupSchedulerPolicyOverridesSchedulerRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetCrcMonitor) DeepCopyInto(out *ConfigurePortEthernetCrcMonitor) {
-	*out = *in
-	if in.SignalDegrade != nil {
-		in, out := &in.SignalDegrade, &out.SignalDegrade
-		*out = new(ConfigurePortEthernetCrcMonitorSignalDegrade)
-		(*in).DeepCopyInto(*out)
+	if in.ClassNumber != nil {
+		in, out := &in.ClassNumber, &out.ClassNumber
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.SignalFailure != nil {
-		in, out := &in.SignalFailure, &out.SignalFailure
-		*out = new(ConfigurePortEthernetCrcMonitorSignalFailure)
-		(*in).DeepCopyInto(*out)
+	if in.MonitorThreshold != nil {
+		in, out := &in.MonitorThreshold, &out.MonitorThreshold
+		*out = new(string)
+		**out = **in
 	}
-	if in.WindowSize != nil {
-		in, out := &in.WindowSize, &out.WindowSize
-		*out = new(uint32)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetCrcMonitor.
-func (in *ConfigurePortEthernetCrcMonitor) DeepCopy() *ConfigurePortEthernetCrcMonitor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressExpandedSecondaryShaperClass.
+func (in *ConfigurePortEthernetEgressExpandedSecondaryShaperClass) DeepCopy() *ConfigurePortEthernetEgressExpandedSecondaryShaperClass {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetCrcMonitor)
+	out := new(ConfigurePortEthernetEgressExpandedSecondaryShaperClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetCrcMonitorSignalDegrade) DeepCopyInto(out *ConfigurePortEthernetCrcMonitorSignalDegrade) {
+func (in *ConfigurePortEthernetEgressHsSchedulerPolicy) DeepCopyInto(out *ConfigurePortEthernetEgressHsSchedulerPolicy) {
 	*out = *in
-	if in.Multiplier != nil {
-		in, out := &in.Multiplier, &out.Multiplier
-		*out = new(uint32)
-		**out = **in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ConfigurePortEthernetEgressHsSchedulerPolicyOverrides)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Threshold != nil {
-		in, out := &in.Threshold, &out.Threshold
-		*out = new(uint32)
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetCrcMonitorSignalDegrade.
-func (in *ConfigurePortEthernetCrcMonitorSignalDegrade) DeepCopy() *ConfigurePortEthernetCrcMonitorSignalDegrade {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSchedulerPolicy.
+func (in *ConfigurePortEthernetEgressHsSchedulerPolicy) DeepCopy() *ConfigurePortEthernetEgressHsSchedulerPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetCrcMonitorSignalDegrade)
+	out := new(ConfigurePortEthernetEgressHsSchedulerPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetCrcMonitorSignalFailure) DeepCopyInto(out *ConfigurePortEthernetCrcMonitorSignalFailure) {
+func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortEthernetEgressHsSchedulerPolicyOverrides) {
 	*out = *in
-	if in.Multiplier != nil {
-		in, out := &in.Multiplier, &out.Multiplier
-		*out = new(uint32)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
 		**out = **in
 	}
-	if in.Threshold != nil {
-		in, out := &in.Threshold, &out.Threshold
-		*out = new(uint32)
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]*ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MaxRate != nil {
+		in, out := &in.MaxRate, &out.MaxRate
+		*out = new(string)
 		**out = **in
 	}
+	if in.SchedulingClass != nil {
+		in, out := &in.SchedulingClass, &out.SchedulingClass
+		*out = make([]*ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetCrcMonitorSignalFailure.
-func (in *ConfigurePortEthernetCrcMonitorSignalFailure) DeepCopy() *ConfigurePortEthernetCrcMonitorSignalFailure {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSchedulerPolicyOverrides.
+func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverrides) DeepCopy() *ConfigurePortEthernetEgressHsSchedulerPolicyOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetCrcMonitorSignalFailure)
+	out := new(ConfigurePortEthernetEgressHsSchedulerPolicyOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDampening) DeepCopyInto(out *ConfigurePortEthernetDampening) {
+func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup) DeepCopyInto(out *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -2504,40 +4267,30 @@ func (in *ConfigurePortEthernetDampening) DeepCopyInto(out *ConfigurePortEtherne
 		*out = new(string)
 		**out = **in
 	}
-	if in.HalfLife != nil {
-		in, out := &in.HalfLife, &out.HalfLife
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.MaxSuppressTime != nil {
-		in, out := &in.MaxSuppressTime, &out.MaxSuppressTime
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.ReuseThreshold != nil {
-		in, out := &in.ReuseThreshold, &out.ReuseThreshold
+	if in.GroupId != nil {
+		in, out := &in.GroupId, &out.GroupId
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.SuppressThreshold != nil {
-		in, out := &in.SuppressThreshold, &out.SuppressThreshold
-		*out = new(uint32)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDampening.
-func (in *ConfigurePortEthernetDampening) DeepCopy() *ConfigurePortEthernetDampening {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup.
+func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup) DeepCopy() *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDampening)
+	out := new(ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1x) DeepCopyInto(out *ConfigurePortEthernetDot1x) {
+func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass) DeepCopyInto(out *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -2549,91 +4302,41 @@ func (in *ConfigurePortEthernetDot1x) DeepCopyInto(out *ConfigurePortEthernetDot
 		*out = new(string)
 		**out = **in
 	}
-	if in.Macsec != nil {
-		in, out := &in.Macsec, &out.Macsec
-		*out = new(ConfigurePortEthernetDot1xMacsec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MaxAuthenticationRequests != nil {
-		in, out := &in.MaxAuthenticationRequests, &out.MaxAuthenticationRequests
+	if in.ClassNumber != nil {
+		in, out := &in.ClassNumber, &out.ClassNumber
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.PerHostAuthentication != nil {
-		in, out := &in.PerHostAuthentication, &out.PerHostAuthentication
-		*out = new(ConfigurePortEthernetDot1xPerHostAuthentication)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PortControl != nil {
-		in, out := &in.PortControl, &out.PortControl
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
 		*out = new(string)
 		**out = **in
 	}
-	if in.QuietPeriod != nil {
-		in, out := &in.QuietPeriod, &out.QuietPeriod
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.RadiusPolicy != nil {
-		in, out := &in.RadiusPolicy, &out.RadiusPolicy
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
 		*out = new(string)
 		**out = **in
 	}
-	if in.RadiusServerPolicyConfig != nil {
-		in, out := &in.RadiusServerPolicyConfig, &out.RadiusServerPolicyConfig
-		*out = new(ConfigurePortEthernetDot1xRadiusServerPolicyConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ReAuthentication != nil {
-		in, out := &in.ReAuthentication, &out.ReAuthentication
-		*out = new(ConfigurePortEthernetDot1xReAuthentication)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ServerTimeout != nil {
-		in, out := &in.ServerTimeout, &out.ServerTimeout
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.SupplicantTimeout != nil {
-		in, out := &in.SupplicantTimeout, &out.SupplicantTimeout
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.TransmitPeriod != nil {
-		in, out := &in.TransmitPeriod, &out.TransmitPeriod
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.TunnelDot1q != nil {
-		in, out := &in.TunnelDot1q, &out.TunnelDot1q
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TunnelQinq != nil {
-		in, out := &in.TunnelQinq, &out.TunnelQinq
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Tunneling != nil {
-		in, out := &in.Tunneling, &out.Tunneling
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1x.
-func (in *ConfigurePortEthernetDot1x) DeepCopy() *ConfigurePortEthernetDot1x {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass.
+func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass) DeepCopy() *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1x)
+	out := new(ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xMacsec) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsec) {
+func (in *ConfigurePortEthernetEgressHsSecondaryShaper) DeepCopyInto(out *ConfigurePortEthernetEgressHsSecondaryShaper) {
 	*out = *in
+	if in.Aggregate != nil {
+		in, out := &in.Aggregate, &out.Aggregate
+		*out = new(ConfigurePortEthernetEgressHsSecondaryShaperAggregate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -2644,957 +4347,1086 @@ func (in *ConfigurePortEthernetDot1xMacsec) DeepCopyInto(out *ConfigurePortEther
 		*out = new(string)
 		**out = **in
 	}
-	if in.ExcludeMacPolicy != nil {
-		in, out := &in.ExcludeMacPolicy, &out.ExcludeMacPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.ExcludeProtocol != nil {
-		in, out := &in.ExcludeProtocol, &out.ExcludeProtocol
-		*out = new(ConfigurePortEthernetDot1xMacsecExcludeProtocol)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RxMustBeEncrypted != nil {
-		in, out := &in.RxMustBeEncrypted, &out.RxMustBeEncrypted
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SubPort != nil {
-		in, out := &in.SubPort, &out.SubPort
-		*out = make([]*ConfigurePortEthernetDot1xMacsecSubPort, len(*in))
+	if in.Class != nil {
+		in, out := &in.Class, &out.Class
+		*out = make([]*ConfigurePortEthernetEgressHsSecondaryShaperClass, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetDot1xMacsecSubPort)
+				*out = new(ConfigurePortEthernetEgressHsSecondaryShaperClass)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryShaperName != nil {
+		in, out := &in.SecondaryShaperName, &out.SecondaryShaperName
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsec.
-func (in *ConfigurePortEthernetDot1xMacsec) DeepCopy() *ConfigurePortEthernetDot1xMacsec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSecondaryShaper.
+func (in *ConfigurePortEthernetEgressHsSecondaryShaper) DeepCopy() *ConfigurePortEthernetEgressHsSecondaryShaper {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xMacsec)
+	out := new(ConfigurePortEthernetEgressHsSecondaryShaper)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xMacsecExcludeProtocol) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecExcludeProtocol) {
+func (in *ConfigurePortEthernetEgressHsSecondaryShaperAggregate) DeepCopyInto(out *ConfigurePortEthernetEgressHsSecondaryShaperAggregate) {
 	*out = *in
-	if in.Cdp != nil {
-		in, out := &in.Cdp, &out.Cdp
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EapolStart != nil {
-		in, out := &in.EapolStart, &out.EapolStart
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EfmOam != nil {
-		in, out := &in.EfmOam, &out.EfmOam
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EthCfm != nil {
-		in, out := &in.EthCfm, &out.EthCfm
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Lacp != nil {
-		in, out := &in.Lacp, &out.Lacp
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Lldp != nil {
-		in, out := &in.Lldp, &out.Lldp
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Ptp != nil {
-		in, out := &in.Ptp, &out.Ptp
-		*out = new(bool)
+	if in.LowBurstMaxClass != nil {
+		in, out := &in.LowBurstMaxClass, &out.LowBurstMaxClass
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Ubfd != nil {
-		in, out := &in.Ubfd, &out.Ubfd
-		*out = new(bool)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecExcludeProtocol.
-func (in *ConfigurePortEthernetDot1xMacsecExcludeProtocol) DeepCopy() *ConfigurePortEthernetDot1xMacsecExcludeProtocol {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSecondaryShaperAggregate.
+func (in *ConfigurePortEthernetEgressHsSecondaryShaperAggregate) DeepCopy() *ConfigurePortEthernetEgressHsSecondaryShaperAggregate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xMacsecExcludeProtocol)
+	out := new(ConfigurePortEthernetEgressHsSecondaryShaperAggregate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xMacsecSubPort) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPort) {
+func (in *ConfigurePortEthernetEgressHsSecondaryShaperClass) DeepCopyInto(out *ConfigurePortEthernetEgressHsSecondaryShaperClass) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.CaName != nil {
-		in, out := &in.CaName, &out.CaName
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-	if in.EapolDestinationAddress != nil {
-		in, out := &in.EapolDestinationAddress, &out.EapolDestinationAddress
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
-	if in.EncapMatch != nil {
-		in, out := &in.EncapMatch, &out.EncapMatch
-		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatch)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MaxPeers != nil {
-		in, out := &in.MaxPeers, &out.MaxPeers
+	if in.ClassNumber != nil {
+		in, out := &in.ClassNumber, &out.ClassNumber
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.SubPortId != nil {
-		in, out := &in.SubPortId, &out.SubPortId
-		*out = new(uint32)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPort.
-func (in *ConfigurePortEthernetDot1xMacsecSubPort) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPort {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSecondaryShaperClass.
+func (in *ConfigurePortEthernetEgressHsSecondaryShaperClass) DeepCopy() *ConfigurePortEthernetEgressHsSecondaryShaperClass {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xMacsecSubPort)
+	out := new(ConfigurePortEthernetEgressHsSecondaryShaperClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatch) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatch) {
+func (in *ConfigurePortEthernetEgressPortQosPolicy) DeepCopyInto(out *ConfigurePortEthernetEgressPortQosPolicy) {
 	*out = *in
-	if in.Encap != nil {
-		in, out := &in.Encap, &out.Encap
-		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap)
-		(*in).DeepCopyInto(*out)
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatch.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatch) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortQosPolicy.
+func (in *ConfigurePortEthernetEgressPortQosPolicy) DeepCopy() *ConfigurePortEthernetEgressPortQosPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatch)
+	out := new(ConfigurePortEthernetEgressPortQosPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicy) {
 	*out = *in
-	if in.AllMatch != nil {
-		in, out := &in.AllMatch, &out.AllMatch
-		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DoubleTag != nil {
-		in, out := &in.DoubleTag, &out.DoubleTag
-		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SingleTag != nil {
-		in, out := &in.SingleTag, &out.SingleTag
-		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverrides)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Untagged != nil {
-		in, out := &in.Untagged, &out.Untagged
-		*out = new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged)
-		(*in).DeepCopyInto(*out)
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicy.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicy) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncap)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverrides) {
 	*out = *in
-	if in.AllMatch != nil {
-		in, out := &in.AllMatch, &out.AllMatch
-		*out = new(bool)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
 		**out = **in
 	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = make([]*ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MaxRate != nil {
+		in, out := &in.MaxRate, &out.MaxRate
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverrides.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapAllMatch)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel) {
 	*out = *in
-	if in.DoubleTag != nil {
-		in, out := &in.DoubleTag, &out.DoubleTag
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
+	if in.PriorityLevel != nil {
+		in, out := &in.PriorityLevel, &out.PriorityLevel
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RateOrPercentRate != nil {
+		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapDoubleTag)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
 	*out = *in
-	if in.SingleTag != nil {
-		in, out := &in.SingleTag, &out.SingleTag
-		*out = new(string)
-		**out = **in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapSingleTag)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged) DeepCopyInto(out *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
 	*out = *in
-	if in.Untagged != nil {
-		in, out := &in.Untagged, &out.Untagged
-		*out = new(bool)
-		**out = **in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged.
-func (in *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged) DeepCopy() *ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xMacsecSubPortEncapMatchEncapUntagged)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xPerHostAuthentication) DeepCopyInto(out *ConfigurePortEthernetDot1xPerHostAuthentication) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
 		*out = new(string)
 		**out = **in
 	}
-	if in.AllowedSourceMacs != nil {
-		in, out := &in.AllowedSourceMacs, &out.AllowedSourceMacs
-		*out = new(ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AuthenticatorInit != nil {
-		in, out := &in.AuthenticatorInit, &out.AuthenticatorInit
-		*out = new(bool)
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xPerHostAuthentication.
-func (in *ConfigurePortEthernetDot1xPerHostAuthentication) DeepCopy() *ConfigurePortEthernetDot1xPerHostAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xPerHostAuthentication)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs) DeepCopyInto(out *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
 	*out = *in
-	if in.MacAddress != nil {
-		in, out := &in.MacAddress, &out.MacAddress
-		*out = make([]*ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs.
-func (in *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs) DeepCopy() *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacs)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress) DeepCopyInto(out *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
 	*out = *in
-	if in.Mac != nil {
-		in, out := &in.Mac, &out.Mac
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress.
-func (in *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress) DeepCopy() *ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xPerHostAuthenticationAllowedSourceMacsMacAddress)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate) {
+	*out = *in
+	if in.RateOrPercentRate != nil {
+		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfig) DeepCopyInto(out *ConfigurePortEthernetDot1xRadiusServerPolicyConfig) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
 	*out = *in
-	if in.Common != nil {
-		in, out := &in.Common, &out.Common
-		*out = new(ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon)
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Split != nil {
-		in, out := &in.Split, &out.Split
-		*out = new(ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xRadiusServerPolicyConfig.
-func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfig) DeepCopy() *ConfigurePortEthernetDot1xRadiusServerPolicyConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xRadiusServerPolicyConfig)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon) DeepCopyInto(out *ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
 	*out = *in
-	if in.RadiusServerPolicy != nil {
-		in, out := &in.RadiusServerPolicy, &out.RadiusServerPolicy
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon.
-func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon) DeepCopy() *ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xRadiusServerPolicyConfigCommon)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit) DeepCopyInto(out *ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit) {
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
 	*out = *in
-	if in.RadiusServerPolicyAcct != nil {
-		in, out := &in.RadiusServerPolicyAcct, &out.RadiusServerPolicyAcct
-		*out = new(string)
-		**out = **in
-	}
-	if in.RadiusServerPolicyAuth != nil {
-		in, out := &in.RadiusServerPolicyAuth, &out.RadiusServerPolicyAuth
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit.
-func (in *ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit) DeepCopy() *ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
+func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xRadiusServerPolicyConfigSplit)
+	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDot1xReAuthentication) DeepCopyInto(out *ConfigurePortEthernetDot1xReAuthentication) {
+func (in *ConfigurePortEthernetElmi) DeepCopyInto(out *ConfigurePortEthernetElmi) {
 	*out = *in
-	if in.Period != nil {
-		in, out := &in.Period, &out.Period
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.N393 != nil {
+		in, out := &in.N393, &out.N393
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.T391 != nil {
+		in, out := &in.T391, &out.T391
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.T392 != nil {
+		in, out := &in.T392, &out.T392
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDot1xReAuthentication.
-func (in *ConfigurePortEthernetDot1xReAuthentication) DeepCopy() *ConfigurePortEthernetDot1xReAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetElmi.
+func (in *ConfigurePortEthernetElmi) DeepCopy() *ConfigurePortEthernetElmi {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDot1xReAuthentication)
+	out := new(ConfigurePortEthernetElmi)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDownOnInternalError) DeepCopyInto(out *ConfigurePortEthernetDownOnInternalError) {
+func (in *ConfigurePortEthernetEthCfm) DeepCopyInto(out *ConfigurePortEthernetEthCfm) {
 	*out = *in
-	if in.TxLaser != nil {
-		in, out := &in.TxLaser, &out.TxLaser
-		*out = new(string)
-		**out = **in
+	if in.Mep != nil {
+		in, out := &in.Mep, &out.Mep
+		*out = make([]*ConfigurePortEthernetEthCfmMep, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetEthCfmMep)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDownOnInternalError.
-func (in *ConfigurePortEthernetDownOnInternalError) DeepCopy() *ConfigurePortEthernetDownOnInternalError {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfm.
+func (in *ConfigurePortEthernetEthCfm) DeepCopy() *ConfigurePortEthernetEthCfm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetDownOnInternalError)
+	out := new(ConfigurePortEthernetEthCfm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetDownWhenLooped) DeepCopyInto(out *ConfigurePortEthernetDownWhenLooped) {
+func (in *ConfigurePortEthernetEthCfmMep) DeepCopyInto(out *ConfigurePortEthernetEthCfmMep) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.KeepAlive != nil {
-		in, out := &in.KeepAlive, &out.KeepAlive
-		*out = new(uint32)
+	if in.Ais != nil {
+		in, out := &in.Ais, &out.Ais
+		*out = new(ConfigurePortEthernetEthCfmMepAis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlarmNotification != nil {
+		in, out := &in.AlarmNotification, &out.AlarmNotification
+		*out = new(ConfigurePortEthernetEthCfmMepAlarmNotification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
 		**out = **in
 	}
-	if in.RetryTimeout != nil {
-		in, out := &in.RetryTimeout, &out.RetryTimeout
-		*out = new(uint32)
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
 		**out = **in
 	}
-	if in.UseBroadcastAddress != nil {
-		in, out := &in.UseBroadcastAddress, &out.UseBroadcastAddress
+	if in.Ccm != nil {
+		in, out := &in.Ccm, &out.Ccm
 		*out = new(bool)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetDownWhenLooped.
-func (in *ConfigurePortEthernetDownWhenLooped) DeepCopy() *ConfigurePortEthernetDownWhenLooped {
-	if in == nil {
-		return nil
+	if in.CcmLtmPriority != nil {
+		in, out := &in.CcmLtmPriority, &out.CcmLtmPriority
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortEthernetDownWhenLooped)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOam) DeepCopyInto(out *ConfigurePortEthernetEfmOam) {
-	*out = *in
-	if in.AcceptRemoteLoopback != nil {
-		in, out := &in.AcceptRemoteLoopback, &out.AcceptRemoteLoopback
-		*out = new(bool)
+	if in.CcmPaddingSize != nil {
+		in, out := &in.CcmPaddingSize, &out.CcmPaddingSize
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.CcmTlvIgnore != nil {
+		in, out := &in.CcmTlvIgnore, &out.CcmTlvIgnore
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
+	if in.CollectLmmStats != nil {
+		in, out := &in.CollectLmmStats, &out.CollectLmmStats
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.Csf != nil {
+		in, out := &in.Csf, &out.Csf
+		*out = new(ConfigurePortEthernetEthCfmMepCsf)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.Discovery != nil {
-		in, out := &in.Discovery, &out.Discovery
-		*out = new(ConfigurePortEthernetEfmOamDiscovery)
+	if in.EthBn != nil {
+		in, out := &in.EthBn, &out.EthBn
+		*out = new(ConfigurePortEthernetEthCfmMepEthBn)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DyingGaspTxOnReset != nil {
-		in, out := &in.DyingGaspTxOnReset, &out.DyingGaspTxOnReset
+	if in.EthTest != nil {
+		in, out := &in.EthTest, &out.EthTest
+		*out = new(ConfigurePortEthernetEthCfmMepEthTest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FacilityFault != nil {
+		in, out := &in.FacilityFault, &out.FacilityFault
 		*out = new(bool)
 		**out = **in
 	}
-	if in.GraceTx != nil {
-		in, out := &in.GraceTx, &out.GraceTx
+	if in.Grace != nil {
+		in, out := &in.Grace, &out.Grace
+		*out = new(ConfigurePortEthernetEthCfmMepGrace)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstallMep != nil {
+		in, out := &in.InstallMep, &out.InstallMep
 		*out = new(bool)
 		**out = **in
 	}
-	if in.GraceVendorOui != nil {
-		in, out := &in.GraceVendorOui, &out.GraceVendorOui
+	if in.LowPriorityDefect != nil {
+		in, out := &in.LowPriorityDefect, &out.LowPriorityDefect
 		*out = new(string)
 		**out = **in
 	}
-	if in.HoldTime != nil {
-		in, out := &in.HoldTime, &out.HoldTime
+	if in.MaAdminName != nil {
+		in, out := &in.MaAdminName, &out.MaAdminName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.MdAdminName != nil {
+		in, out := &in.MdAdminName, &out.MdAdminName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MepId != nil {
+		in, out := &in.MepId, &out.MepId
+		*out = new(string)
+		**out = **in
+	}
+	if in.OneWayDelayThreshold != nil {
+		in, out := &in.OneWayDelayThreshold, &out.OneWayDelayThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Vlan != nil {
+		in, out := &in.Vlan, &out.Vlan
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMep.
+func (in *ConfigurePortEthernetEthCfmMep) DeepCopy() *ConfigurePortEthernetEthCfmMep {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetEthCfmMep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetEthCfmMepAis) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepAis) {
+	*out = *in
+	if in.ClientMegLevel != nil {
+		in, out := &in.ClientMegLevel, &out.ClientMegLevel
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.IgnoreEfmState != nil {
-		in, out := &in.IgnoreEfmState, &out.IgnoreEfmState
+	if in.InterfaceSupport != nil {
+		in, out := &in.InterfaceSupport, &out.InterfaceSupport
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LinkMonitoring != nil {
-		in, out := &in.LinkMonitoring, &out.LinkMonitoring
-		*out = new(ConfigurePortEthernetEfmOamLinkMonitoring)
-		(*in).DeepCopyInto(*out)
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
+	if in.LowPriorityDefect != nil {
+		in, out := &in.LowPriorityDefect, &out.LowPriorityDefect
 		*out = new(string)
 		**out = **in
 	}
-	if in.Multiplier != nil {
-		in, out := &in.Multiplier, &out.Multiplier
-		*out = new(uint32)
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(string)
 		**out = **in
 	}
-	if in.PeerRdiRx != nil {
-		in, out := &in.PeerRdiRx, &out.PeerRdiRx
-		*out = new(ConfigurePortEthernetEfmOamPeerRdiRx)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TransmitInterval != nil {
-		in, out := &in.TransmitInterval, &out.TransmitInterval
-		*out = new(uint32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepAis.
+func (in *ConfigurePortEthernetEthCfmMepAis) DeepCopy() *ConfigurePortEthernetEthCfmMepAis {
+	if in == nil {
+		return nil
 	}
-	if in.TriggerFault != nil {
-		in, out := &in.TriggerFault, &out.TriggerFault
-		*out = new(string)
+	out := new(ConfigurePortEthernetEthCfmMepAis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetEthCfmMepAlarmNotification) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepAlarmNotification) {
+	*out = *in
+	if in.FngAlarmTime != nil {
+		in, out := &in.FngAlarmTime, &out.FngAlarmTime
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Tunneling != nil {
-		in, out := &in.Tunneling, &out.Tunneling
-		*out = new(bool)
+	if in.FngResetTime != nil {
+		in, out := &in.FngResetTime, &out.FngResetTime
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOam.
-func (in *ConfigurePortEthernetEfmOam) DeepCopy() *ConfigurePortEthernetEfmOam {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepAlarmNotification.
+func (in *ConfigurePortEthernetEthCfmMepAlarmNotification) DeepCopy() *ConfigurePortEthernetEthCfmMepAlarmNotification {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOam)
+	out := new(ConfigurePortEthernetEthCfmMepAlarmNotification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamDiscovery) DeepCopyInto(out *ConfigurePortEthernetEfmOamDiscovery) {
+func (in *ConfigurePortEthernetEthCfmMepCsf) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepCsf) {
 	*out = *in
-	if in.AdvertiseCapabilities != nil {
-		in, out := &in.AdvertiseCapabilities, &out.AdvertiseCapabilities
-		*out = new(ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities)
-		(*in).DeepCopyInto(*out)
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamDiscovery.
-func (in *ConfigurePortEthernetEfmOamDiscovery) DeepCopy() *ConfigurePortEthernetEfmOamDiscovery {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepCsf.
+func (in *ConfigurePortEthernetEthCfmMepCsf) DeepCopy() *ConfigurePortEthernetEthCfmMepCsf {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamDiscovery)
+	out := new(ConfigurePortEthernetEthCfmMepCsf)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities) DeepCopyInto(out *ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities) {
+func (in *ConfigurePortEthernetEthCfmMepEthBn) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepEthBn) {
 	*out = *in
-	if in.LinkMonitoring != nil {
-		in, out := &in.LinkMonitoring, &out.LinkMonitoring
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RxUpdatePacing != nil {
+		in, out := &in.RxUpdatePacing, &out.RxUpdatePacing
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities.
-func (in *ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities) DeepCopy() *ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepEthBn.
+func (in *ConfigurePortEthernetEthCfmMepEthBn) DeepCopy() *ConfigurePortEthernetEthCfmMepEthBn {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamDiscoveryAdvertiseCapabilities)
+	out := new(ConfigurePortEthernetEthCfmMepEthBn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoring) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoring) {
+func (in *ConfigurePortEthernetEthCfmMepEthTest) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepEthTest) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
+	if in.BitErrorThreshold != nil {
+		in, out := &in.BitErrorThreshold, &out.BitErrorThreshold
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
+	if in.TestPattern != nil {
+		in, out := &in.TestPattern, &out.TestPattern
+		*out = new(ConfigurePortEthernetEthCfmMepEthTestTestPattern)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepEthTest.
+func (in *ConfigurePortEthernetEthCfmMepEthTest) DeepCopy() *ConfigurePortEthernetEthCfmMepEthTest {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetEthCfmMepEthTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetEthCfmMepEthTestTestPattern) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepEthTestTestPattern) {
+	*out = *in
+	if in.CrcTlv != nil {
+		in, out := &in.CrcTlv, &out.CrcTlv
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
 		*out = new(string)
 		**out = **in
 	}
-	if in.ErroredFrame != nil {
-		in, out := &in.ErroredFrame, &out.ErroredFrame
-		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ErroredFramePeriod != nil {
-		in, out := &in.ErroredFramePeriod, &out.ErroredFramePeriod
-		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ErroredFrameSeconds != nil {
-		in, out := &in.ErroredFrameSeconds, &out.ErroredFrameSeconds
-		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepEthTestTestPattern.
+func (in *ConfigurePortEthernetEthCfmMepEthTestTestPattern) DeepCopy() *ConfigurePortEthernetEthCfmMepEthTestTestPattern {
+	if in == nil {
+		return nil
 	}
-	if in.ErroredSymbols != nil {
-		in, out := &in.ErroredSymbols, &out.ErroredSymbols
-		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols)
+	out := new(ConfigurePortEthernetEthCfmMepEthTestTestPattern)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetEthCfmMepGrace) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepGrace) {
+	*out = *in
+	if in.EthEd != nil {
+		in, out := &in.EthEd, &out.EthEd
+		*out = new(ConfigurePortEthernetEthCfmMepGraceEthEd)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.LocalSfAction != nil {
-		in, out := &in.LocalSfAction, &out.LocalSfAction
-		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction)
+	if in.EthVsmGrace != nil {
+		in, out := &in.EthVsmGrace, &out.EthVsmGrace
+		*out = new(ConfigurePortEthernetEthCfmMepGraceEthVsmGrace)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoring.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoring) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepGrace.
+func (in *ConfigurePortEthernetEthCfmMepGrace) DeepCopy() *ConfigurePortEthernetEthCfmMepGrace {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamLinkMonitoring)
+	out := new(ConfigurePortEthernetEthCfmMepGrace)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame) {
+func (in *ConfigurePortEthernetEthCfmMepGraceEthEd) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepGraceEthEd) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.EventNotification != nil {
-		in, out := &in.EventNotification, &out.EventNotification
-		*out = new(bool)
+	if in.MaxRxDefectWindow != nil {
+		in, out := &in.MaxRxDefectWindow, &out.MaxRxDefectWindow
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.SdThreshold != nil {
-		in, out := &in.SdThreshold, &out.SdThreshold
-		*out = new(uint32)
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
 		**out = **in
 	}
-	if in.SfThreshold != nil {
-		in, out := &in.SfThreshold, &out.SfThreshold
-		*out = new(uint32)
+	if in.RxEthEd != nil {
+		in, out := &in.RxEthEd, &out.RxEthEd
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Window != nil {
-		in, out := &in.Window, &out.Window
-		*out = new(uint32)
+	if in.TxEthEd != nil {
+		in, out := &in.TxEthEd, &out.TxEthEd
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepGraceEthEd.
+func (in *ConfigurePortEthernetEthCfmMepGraceEthEd) DeepCopy() *ConfigurePortEthernetEthCfmMepGraceEthEd {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFrame)
+	out := new(ConfigurePortEthernetEthCfmMepGraceEthEd)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod) {
+func (in *ConfigurePortEthernetEthCfmMepGraceEthVsmGrace) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepGraceEthVsmGrace) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
+	if in.RxEthVsmGrace != nil {
+		in, out := &in.RxEthVsmGrace, &out.RxEthVsmGrace
+		*out = new(bool)
 		**out = **in
 	}
-	if in.EventNotification != nil {
-		in, out := &in.EventNotification, &out.EventNotification
+	if in.TxEthVsmGrace != nil {
+		in, out := &in.TxEthVsmGrace, &out.TxEthVsmGrace
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SdThreshold != nil {
-		in, out := &in.SdThreshold, &out.SdThreshold
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepGraceEthVsmGrace.
+func (in *ConfigurePortEthernetEthCfmMepGraceEthVsmGrace) DeepCopy() *ConfigurePortEthernetEthCfmMepGraceEthVsmGrace {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortEthernetEthCfmMepGraceEthVsmGrace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetHoldTime) DeepCopyInto(out *ConfigurePortEthernetHoldTime) {
+	*out = *in
+	if in.Down != nil {
+		in, out := &in.Down, &out.Down
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.SfThreshold != nil {
-		in, out := &in.SfThreshold, &out.SfThreshold
-		*out = new(uint32)
+	if in.Units != nil {
+		in, out := &in.Units, &out.Units
+		*out = new(string)
 		**out = **in
 	}
-	if in.Window != nil {
-		in, out := &in.Window, &out.Window
+	if in.Up != nil {
+		in, out := &in.Up, &out.Up
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetHoldTime.
+func (in *ConfigurePortEthernetHoldTime) DeepCopy() *ConfigurePortEthernetHoldTime {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFramePeriod)
+	out := new(ConfigurePortEthernetHoldTime)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds) {
+func (in *ConfigurePortEthernetHsmdaSchedulerOverrides) DeepCopyInto(out *ConfigurePortEthernetHsmdaSchedulerOverrides) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-	if in.EventNotification != nil {
-		in, out := &in.EventNotification, &out.EventNotification
-		*out = new(bool)
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
 		**out = **in
 	}
-	if in.SdThreshold != nil {
-		in, out := &in.SdThreshold, &out.SdThreshold
-		*out = new(uint32)
-		**out = **in
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]*ConfigurePortEthernetHsmdaSchedulerOverridesGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetHsmdaSchedulerOverridesGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.SfThreshold != nil {
-		in, out := &in.SfThreshold, &out.SfThreshold
-		*out = new(uint32)
+	if in.MaxRate != nil {
+		in, out := &in.MaxRate, &out.MaxRate
+		*out = new(string)
 		**out = **in
 	}
-	if in.Window != nil {
-		in, out := &in.Window, &out.Window
-		*out = new(uint32)
-		**out = **in
+	if in.SchedulingClass != nil {
+		in, out := &in.SchedulingClass, &out.SchedulingClass
+		*out = make([]*ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetHsmdaSchedulerOverrides.
+func (in *ConfigurePortEthernetHsmdaSchedulerOverrides) DeepCopy() *ConfigurePortEthernetHsmdaSchedulerOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamLinkMonitoringErroredFrameSeconds)
+	out := new(ConfigurePortEthernetHsmdaSchedulerOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols) {
+func (in *ConfigurePortEthernetHsmdaSchedulerOverridesGroup) DeepCopyInto(out *ConfigurePortEthernetHsmdaSchedulerOverridesGroup) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-	if in.EventNotification != nil {
-		in, out := &in.EventNotification, &out.EventNotification
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SdThreshold != nil {
-		in, out := &in.SdThreshold, &out.SdThreshold
-		*out = new(uint32)
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
 		**out = **in
 	}
-	if in.SfThreshold != nil {
-		in, out := &in.SfThreshold, &out.SfThreshold
+	if in.GroupId != nil {
+		in, out := &in.GroupId, &out.GroupId
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Window != nil {
-		in, out := &in.Window, &out.Window
-		*out = new(uint32)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetHsmdaSchedulerOverridesGroup.
+func (in *ConfigurePortEthernetHsmdaSchedulerOverridesGroup) DeepCopy() *ConfigurePortEthernetHsmdaSchedulerOverridesGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamLinkMonitoringErroredSymbols)
+	out := new(ConfigurePortEthernetHsmdaSchedulerOverridesGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction) {
+func (in *ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass) DeepCopyInto(out *ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass) {
 	*out = *in
-	if in.EventNotificationBurst != nil {
-		in, out := &in.EventNotificationBurst, &out.EventNotificationBurst
-		*out = new(uint32)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
 		**out = **in
 	}
-	if in.InfoNotification != nil {
-		in, out := &in.InfoNotification, &out.InfoNotification
-		*out = new(ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification)
-		(*in).DeepCopyInto(*out)
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
 	}
-	if in.LocalPortAction != nil {
-		in, out := &in.LocalPortAction, &out.LocalPortAction
+	if in.ClassNumber != nil {
+		in, out := &in.ClassNumber, &out.ClassNumber
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
 		*out = new(string)
 		**out = **in
 	}
+	if in.WeightInGroup != nil {
+		in, out := &in.WeightInGroup, &out.WeightInGroup
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass.
+func (in *ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass) DeepCopy() *ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamLinkMonitoringLocalSfAction)
+	out := new(ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification) DeepCopyInto(out *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification) {
+func (in *ConfigurePortEthernetIngress) DeepCopyInto(out *ConfigurePortEthernetIngress) {
 	*out = *in
-	if in.CriticalEvent != nil {
-		in, out := &in.CriticalEvent, &out.CriticalEvent
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DyingGasp != nil {
-		in, out := &in.DyingGasp, &out.DyingGasp
-		*out = new(bool)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification.
-func (in *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification) DeepCopy() *ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetIngress.
+func (in *ConfigurePortEthernetIngress) DeepCopy() *ConfigurePortEthernetIngress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamLinkMonitoringLocalSfActionInfoNotification)
+	out := new(ConfigurePortEthernetIngress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEfmOamPeerRdiRx) DeepCopyInto(out *ConfigurePortEthernetEfmOamPeerRdiRx) {
+func (in *ConfigurePortEthernetLldp) DeepCopyInto(out *ConfigurePortEthernetLldp) {
 	*out = *in
-	if in.CriticalEvent != nil {
-		in, out := &in.CriticalEvent, &out.CriticalEvent
-		*out = new(string)
-		**out = **in
-	}
-	if in.DyingGasp != nil {
-		in, out := &in.DyingGasp, &out.DyingGasp
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-	if in.EventNotification != nil {
-		in, out := &in.EventNotification, &out.EventNotification
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
-	if in.LinkFault != nil {
-		in, out := &in.LinkFault, &out.LinkFault
-		*out = new(string)
-		**out = **in
+	if in.DestMac != nil {
+		in, out := &in.DestMac, &out.DestMac
+		*out = make([]*ConfigurePortEthernetLldpDestMac, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetLldpDestMac)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEfmOamPeerRdiRx.
-func (in *ConfigurePortEthernetEfmOamPeerRdiRx) DeepCopy() *ConfigurePortEthernetEfmOamPeerRdiRx {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLldp.
+func (in *ConfigurePortEthernetLldp) DeepCopy() *ConfigurePortEthernetLldp {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEfmOamPeerRdiRx)
+	out := new(ConfigurePortEthernetLldp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgress) DeepCopyInto(out *ConfigurePortEthernetEgress) {
+func (in *ConfigurePortEthernetLldpDestMac) DeepCopyInto(out *ConfigurePortEthernetLldpDestMac) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -3606,87 +5438,71 @@ func (in *ConfigurePortEthernetEgress) DeepCopyInto(out *ConfigurePortEthernetEg
 		*out = new(string)
 		**out = **in
 	}
-	if in.EthBnRateChanges != nil {
-		in, out := &in.EthBnRateChanges, &out.EthBnRateChanges
-		*out = new(bool)
+	if in.MacType != nil {
+		in, out := &in.MacType, &out.MacType
+		*out = new(string)
 		**out = **in
 	}
-	if in.ExpandedSecondaryShaper != nil {
-		in, out := &in.ExpandedSecondaryShaper, &out.ExpandedSecondaryShaper
-		*out = make([]*ConfigurePortEthernetEgressExpandedSecondaryShaper, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetEgressExpandedSecondaryShaper)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = new(bool)
+		**out = **in
 	}
-	if in.HsPortPoolPolicy != nil {
-		in, out := &in.HsPortPoolPolicy, &out.HsPortPoolPolicy
+	if in.PortIdSubtype != nil {
+		in, out := &in.PortIdSubtype, &out.PortIdSubtype
 		*out = new(string)
 		**out = **in
 	}
-	if in.HsSchedulerPolicy != nil {
-		in, out := &in.HsSchedulerPolicy, &out.HsSchedulerPolicy
-		*out = new(ConfigurePortEthernetEgressHsSchedulerPolicy)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HsSecondaryShaper != nil {
-		in, out := &in.HsSecondaryShaper, &out.HsSecondaryShaper
-		*out = make([]*ConfigurePortEthernetEgressHsSecondaryShaper, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetEgressHsSecondaryShaper)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.HsmdaSchedulerPolicy != nil {
-		in, out := &in.HsmdaSchedulerPolicy, &out.HsmdaSchedulerPolicy
-		*out = new(string)
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MonitorPortScheduler != nil {
-		in, out := &in.MonitorPortScheduler, &out.MonitorPortScheduler
+	if in.Transmit != nil {
+		in, out := &in.Transmit, &out.Transmit
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PortQosPolicy != nil {
-		in, out := &in.PortQosPolicy, &out.PortQosPolicy
-		*out = new(ConfigurePortEthernetEgressPortQosPolicy)
-		(*in).DeepCopyInto(*out)
+	if in.TunnelNearestBridge != nil {
+		in, out := &in.TunnelNearestBridge, &out.TunnelNearestBridge
+		*out = new(bool)
+		**out = **in
 	}
-	if in.PortSchedulerPolicy != nil {
-		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicy)
-		(*in).DeepCopyInto(*out)
+	if in.TxMgmtAddress != nil {
+		in, out := &in.TxMgmtAddress, &out.TxMgmtAddress
+		*out = make([]*ConfigurePortEthernetLldpDestMacTxMgmtAddress, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetLldpDestMacTxMgmtAddress)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(int32)
-		**out = **in
+	if in.TxTlvs != nil {
+		in, out := &in.TxTlvs, &out.TxTlvs
+		*out = new(ConfigurePortEthernetLldpDestMacTxTlvs)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgress.
-func (in *ConfigurePortEthernetEgress) DeepCopy() *ConfigurePortEthernetEgress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLldpDestMac.
+func (in *ConfigurePortEthernetLldpDestMac) DeepCopy() *ConfigurePortEthernetLldpDestMac {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgress)
+	out := new(ConfigurePortEthernetLldpDestMac)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressExpandedSecondaryShaper) DeepCopyInto(out *ConfigurePortEthernetEgressExpandedSecondaryShaper) {
+func (in *ConfigurePortEthernetLldpDestMacTxMgmtAddress) DeepCopyInto(out *ConfigurePortEthernetLldpDestMacTxMgmtAddress) {
 	*out = *in
-	if in.AggregateBurst != nil {
-		in, out := &in.AggregateBurst, &out.AggregateBurst
-		*out = new(ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst)
-		(*in).DeepCopyInto(*out)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
 	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -3698,76 +5514,60 @@ func (in *ConfigurePortEthernetEgressExpandedSecondaryShaper) DeepCopyInto(out *
 		*out = new(string)
 		**out = **in
 	}
-	if in.Class != nil {
-		in, out := &in.Class, &out.Class
-		*out = make([]*ConfigurePortEthernetEgressExpandedSecondaryShaperClass, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetEgressExpandedSecondaryShaperClass)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.LowBurstMaxClass != nil {
-		in, out := &in.LowBurstMaxClass, &out.LowBurstMaxClass
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.MonitorThreshold != nil {
-		in, out := &in.MonitorThreshold, &out.MonitorThreshold
-		*out = new(string)
-		**out = **in
-	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
-		**out = **in
-	}
-	if in.SecondaryShaperName != nil {
-		in, out := &in.SecondaryShaperName, &out.SecondaryShaperName
+	if in.MgmtAddressSystemType != nil {
+		in, out := &in.MgmtAddressSystemType, &out.MgmtAddressSystemType
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressExpandedSecondaryShaper.
-func (in *ConfigurePortEthernetEgressExpandedSecondaryShaper) DeepCopy() *ConfigurePortEthernetEgressExpandedSecondaryShaper {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLldpDestMacTxMgmtAddress.
+func (in *ConfigurePortEthernetLldpDestMacTxMgmtAddress) DeepCopy() *ConfigurePortEthernetLldpDestMacTxMgmtAddress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressExpandedSecondaryShaper)
+	out := new(ConfigurePortEthernetLldpDestMacTxMgmtAddress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst) DeepCopyInto(out *ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst) {
+func (in *ConfigurePortEthernetLldpDestMacTxTlvs) DeepCopyInto(out *ConfigurePortEthernetLldpDestMacTxTlvs) {
 	*out = *in
-	if in.HighBurstIncrease != nil {
-		in, out := &in.HighBurstIncrease, &out.HighBurstIncrease
-		*out = new(int32)
+	if in.PortDesc != nil {
+		in, out := &in.PortDesc, &out.PortDesc
+		*out = new(bool)
 		**out = **in
 	}
-	if in.LowBurstLimit != nil {
-		in, out := &in.LowBurstLimit, &out.LowBurstLimit
-		*out = new(string)
+	if in.SysCap != nil {
+		in, out := &in.SysCap, &out.SysCap
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SysDesc != nil {
+		in, out := &in.SysDesc, &out.SysDesc
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SysName != nil {
+		in, out := &in.SysName, &out.SysName
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst.
-func (in *ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst) DeepCopy() *ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLldpDestMacTxTlvs.
+func (in *ConfigurePortEthernetLldpDestMacTxTlvs) DeepCopy() *ConfigurePortEthernetLldpDestMacTxTlvs {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressExpandedSecondaryShaperAggregateBurst)
+	out := new(ConfigurePortEthernetLldpDestMacTxTlvs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressExpandedSecondaryShaperClass) DeepCopyInto(out *ConfigurePortEthernetEgressExpandedSecondaryShaperClass) {
+func (in *ConfigurePortEthernetLoopback) DeepCopyInto(out *ConfigurePortEthernetLoopback) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -3779,198 +5579,153 @@ func (in *ConfigurePortEthernetEgressExpandedSecondaryShaperClass) DeepCopyInto(
 		*out = new(string)
 		**out = **in
 	}
-	if in.BurstLimit != nil {
-		in, out := &in.BurstLimit, &out.BurstLimit
-		*out = new(string)
-		**out = **in
-	}
-	if in.ClassNumber != nil {
-		in, out := &in.ClassNumber, &out.ClassNumber
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.MonitorThreshold != nil {
-		in, out := &in.MonitorThreshold, &out.MonitorThreshold
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
 		*out = new(string)
 		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
+	if in.SwapSrcDstMac != nil {
+		in, out := &in.SwapSrcDstMac, &out.SwapSrcDstMac
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressExpandedSecondaryShaperClass.
-func (in *ConfigurePortEthernetEgressExpandedSecondaryShaperClass) DeepCopy() *ConfigurePortEthernetEgressExpandedSecondaryShaperClass {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLoopback.
+func (in *ConfigurePortEthernetLoopback) DeepCopy() *ConfigurePortEthernetLoopback {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressExpandedSecondaryShaperClass)
+	out := new(ConfigurePortEthernetLoopback)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressHsSchedulerPolicy) DeepCopyInto(out *ConfigurePortEthernetEgressHsSchedulerPolicy) {
+func (in *ConfigurePortEthernetNetwork) DeepCopyInto(out *ConfigurePortEthernetNetwork) {
 	*out = *in
-	if in.Overrides != nil {
-		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortEthernetEgressHsSchedulerPolicyOverrides)
-		(*in).DeepCopyInto(*out)
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+		*out = new(string)
+		**out = **in
 	}
-	if in.PolicyName != nil {
-		in, out := &in.PolicyName, &out.PolicyName
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(ConfigurePortEthernetNetworkEgress)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSchedulerPolicy.
-func (in *ConfigurePortEthernetEgressHsSchedulerPolicy) DeepCopy() *ConfigurePortEthernetEgressHsSchedulerPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetwork.
+func (in *ConfigurePortEthernetNetwork) DeepCopy() *ConfigurePortEthernetNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressHsSchedulerPolicy)
+	out := new(ConfigurePortEthernetNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortEthernetEgressHsSchedulerPolicyOverrides) {
+func (in *ConfigurePortEthernetNetworkEgress) DeepCopyInto(out *ConfigurePortEthernetNetworkEgress) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
+	if in.PortQueues != nil {
+		in, out := &in.PortQueues, &out.PortQueues
+		*out = new(ConfigurePortEthernetNetworkEgressPortQueues)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = make([]*ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup, len(*in))
+	if in.QueueGroup != nil {
+		in, out := &in.QueueGroup, &out.QueueGroup
+		*out = make([]*ConfigurePortEthernetNetworkEgressQueueGroup, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup)
+				*out = new(ConfigurePortEthernetNetworkEgressQueueGroup)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.MaxRate != nil {
-		in, out := &in.MaxRate, &out.MaxRate
+	if in.QueuePolicy != nil {
+		in, out := &in.QueuePolicy, &out.QueuePolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.SchedulingClass != nil {
-		in, out := &in.SchedulingClass, &out.SchedulingClass
-		*out = make([]*ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSchedulerPolicyOverrides.
-func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverrides) DeepCopy() *ConfigurePortEthernetEgressHsSchedulerPolicyOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgress.
+func (in *ConfigurePortEthernetNetworkEgress) DeepCopy() *ConfigurePortEthernetNetworkEgress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressHsSchedulerPolicyOverrides)
+	out := new(ConfigurePortEthernetNetworkEgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup) DeepCopyInto(out *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup) {
+func (in *ConfigurePortEthernetNetworkEgressPortQueues) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressPortQueues) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupId != nil {
-		in, out := &in.GroupId, &out.GroupId
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
-		**out = **in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ConfigurePortEthernetNetworkEgressPortQueuesOverrides)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup.
-func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup) DeepCopy() *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressPortQueues.
+func (in *ConfigurePortEthernetNetworkEgressPortQueues) DeepCopy() *ConfigurePortEthernetNetworkEgressPortQueues {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressHsSchedulerPolicyOverridesGroup)
+	out := new(ConfigurePortEthernetNetworkEgressPortQueues)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass) DeepCopyInto(out *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass) {
-	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.ClassNumber != nil {
-		in, out := &in.ClassNumber, &out.ClassNumber
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
-		**out = **in
-	}
-	if in.Weight != nil {
-		in, out := &in.Weight, &out.Weight
-		*out = new(string)
-		**out = **in
+func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverrides) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressPortQueuesOverrides) {
+	*out = *in
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = make([]*ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass.
-func (in *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass) DeepCopy() *ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressPortQueuesOverrides.
+func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverrides) DeepCopy() *ConfigurePortEthernetNetworkEgressPortQueuesOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressHsSchedulerPolicyOverridesSchedulingClass)
+	out := new(ConfigurePortEthernetNetworkEgressPortQueuesOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressHsSecondaryShaper) DeepCopyInto(out *ConfigurePortEthernetEgressHsSecondaryShaper) {
+func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue) {
 	*out = *in
-	if in.Aggregate != nil {
-		in, out := &in.Aggregate, &out.Aggregate
-		*out = new(ConfigurePortEthernetEgressHsSecondaryShaperAggregate)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -3981,67 +5736,66 @@ func (in *ConfigurePortEthernetEgressHsSecondaryShaper) DeepCopyInto(out *Config
 		*out = new(string)
 		**out = **in
 	}
-	if in.Class != nil {
-		in, out := &in.Class, &out.Class
-		*out = make([]*ConfigurePortEthernetEgressHsSecondaryShaperClass, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetEgressHsSecondaryShaperClass)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
+	if in.MonitorQueueDepth != nil {
+		in, out := &in.MonitorQueueDepth, &out.MonitorQueueDepth
+		*out = new(ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.SecondaryShaperName != nil {
-		in, out := &in.SecondaryShaperName, &out.SecondaryShaperName
+	if in.QueueId != nil {
+		in, out := &in.QueueId, &out.QueueId
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSecondaryShaper.
-func (in *ConfigurePortEthernetEgressHsSecondaryShaper) DeepCopy() *ConfigurePortEthernetEgressHsSecondaryShaper {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue.
+func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue) DeepCopy() *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressHsSecondaryShaper)
+	out := new(ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressHsSecondaryShaperAggregate) DeepCopyInto(out *ConfigurePortEthernetEgressHsSecondaryShaperAggregate) {
+func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth) {
 	*out = *in
-	if in.LowBurstMaxClass != nil {
-		in, out := &in.LowBurstMaxClass, &out.LowBurstMaxClass
-		*out = new(uint32)
+	if in.FastPolling != nil {
+		in, out := &in.FastPolling, &out.FastPolling
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
+	if in.ViolationThreshold != nil {
+		in, out := &in.ViolationThreshold, &out.ViolationThreshold
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSecondaryShaperAggregate.
-func (in *ConfigurePortEthernetEgressHsSecondaryShaperAggregate) DeepCopy() *ConfigurePortEthernetEgressHsSecondaryShaperAggregate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth.
+func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth) DeepCopy() *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressHsSecondaryShaperAggregate)
+	out := new(ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressHsSecondaryShaperClass) DeepCopyInto(out *ConfigurePortEthernetEgressHsSecondaryShaperClass) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroup) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroup) {
 	*out = *in
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AggregateRate != nil {
+		in, out := &in.AggregateRate, &out.AggregateRate
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -4052,117 +5806,122 @@ func (in *ConfigurePortEthernetEgressHsSecondaryShaperClass) DeepCopyInto(out *C
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClassNumber != nil {
-		in, out := &in.ClassNumber, &out.ClassNumber
-		*out = new(uint32)
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressHsSecondaryShaperClass.
-func (in *ConfigurePortEthernetEgressHsSecondaryShaperClass) DeepCopy() *ConfigurePortEthernetEgressHsSecondaryShaperClass {
-	if in == nil {
-		return nil
+	if in.HsTurbo != nil {
+		in, out := &in.HsTurbo, &out.HsTurbo
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(ConfigurePortEthernetEgressHsSecondaryShaperClass)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortQosPolicy) DeepCopyInto(out *ConfigurePortEthernetEgressPortQosPolicy) {
-	*out = *in
-	if in.PolicyName != nil {
-		in, out := &in.PolicyName, &out.PolicyName
+	if in.InstanceId != nil {
+		in, out := &in.InstanceId, &out.InstanceId
+		*out = new(uint16)
+		**out = **in
+	}
+	if in.PolicerControlPolicy != nil {
+		in, out := &in.PolicerControlPolicy, &out.PolicerControlPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueGroupName != nil {
+		in, out := &in.QueueGroupName, &out.QueueGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueOverrides != nil {
+		in, out := &in.QueueOverrides, &out.QueueOverrides
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulerPolicy != nil {
+		in, out := &in.SchedulerPolicy, &out.SchedulerPolicy
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortQosPolicy.
-func (in *ConfigurePortEthernetEgressPortQosPolicy) DeepCopy() *ConfigurePortEthernetEgressPortQosPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroup.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroup) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortQosPolicy)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicy) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate) {
 	*out = *in
-	if in.Overrides != nil {
-		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverrides)
-		(*in).DeepCopyInto(*out)
+	if in.LimitUnusedBandwidth != nil {
+		in, out := &in.LimitUnusedBandwidth, &out.LimitUnusedBandwidth
+		*out = new(bool)
+		**out = **in
 	}
-	if in.PolicyName != nil {
-		in, out := &in.PolicyName, &out.PolicyName
+	if in.QueueFrameBasedAccounting != nil {
+		in, out := &in.QueueFrameBasedAccounting, &out.QueueFrameBasedAccounting
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicy.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicy) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicy)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverrides) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = make([]*ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel, len(*in))
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = make([]*ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel)
+				*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.MaxRate != nil {
-		in, out := &in.MaxRate, &out.MaxRate
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverrides.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverrides)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue) {
 	*out = *in
+	if in.AdaptationRule != nil {
+		in, out := &in.AdaptationRule, &out.AdaptationRule
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -4173,75 +5932,55 @@ func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel) DeepCopy
 		*out = new(string)
 		**out = **in
 	}
-	if in.PriorityLevel != nil {
-		in, out := &in.PriorityLevel, &out.PriorityLevel
-		*out = new(uint32)
+	if in.Cbs != nil {
+		in, out := &in.Cbs, &out.Cbs
+		*out = new(string)
 		**out = **in
 	}
-	if in.RateOrPercentRate != nil {
-		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+	if in.DropTail != nil {
+		in, out := &in.DropTail, &out.DropTail
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel {
-	if in == nil {
-		return nil
+	if in.Mbs != nil {
+		in, out := &in.Mbs, &out.Mbs
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevel)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
-	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.MonitorDepth != nil {
+		in, out := &in.MonitorDepth, &out.MonitorDepth
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	if in.MonitorQueueDepth != nil {
+		in, out := &in.MonitorQueueDepth, &out.MonitorQueueDepth
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
-	if in == nil {
-		return nil
+	if in.QueueId != nil {
+		in, out := &in.QueueId, &out.QueueId
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
-	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+	if in.QueueOverrideRate != nil {
+		in, out := &in.QueueOverrideRate, &out.QueueOverrideRate
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule) {
 	*out = *in
 	if in.Cir != nil {
 		in, out := &in.Cir, &out.Cir
@@ -4255,629 +5994,578 @@ func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPerc
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	if in.Low != nil {
+		in, out := &in.Low, &out.Low
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
-		*out = new(string)
-		**out = **in
-	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.PercentReductionFromMbs != nil {
+		in, out := &in.PercentReductionFromMbs, &out.PercentReductionFromMbs
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) {
 	*out = *in
-	if in.RateOrPercentRate != nil {
-		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.FastPolling != nil {
+		in, out := &in.FastPolling, &out.FastPolling
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ViolationThreshold != nil {
+		in, out := &in.ViolationThreshold, &out.ViolationThreshold
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRate)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate) {
 	*out = *in
 	if in.PercentRate != nil {
 		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Rate != nil {
 		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) {
 	*out = *in
 	if in.PercentRate != nil {
 		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
-	if in == nil {
-		return nil
-	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
-	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
-		**out = **in
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
-func (in *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetElmi) DeepCopyInto(out *ConfigurePortEthernetElmi) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
 		*out = new(string)
 		**out = **in
 	}
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
 		*out = new(string)
 		**out = **in
 	}
-	if in.N393 != nil {
-		in, out := &in.N393, &out.N393
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.T391 != nil {
-		in, out := &in.T391, &out.T391
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.T392 != nil {
-		in, out := &in.T392, &out.T392
-		*out = new(uint32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetElmi.
-func (in *ConfigurePortEthernetElmi) DeepCopy() *ConfigurePortEthernetElmi {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetElmi)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfm) DeepCopyInto(out *ConfigurePortEthernetEthCfm) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) {
 	*out = *in
-	if in.Mep != nil {
-		in, out := &in.Mep, &out.Mep
-		*out = make([]*ConfigurePortEthernetEthCfmMep, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetEthCfmMep)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfm.
-func (in *ConfigurePortEthernetEthCfm) DeepCopy() *ConfigurePortEthernetEthCfm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfm)
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMep) DeepCopyInto(out *ConfigurePortEthernetEthCfmMep) {
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ais != nil {
-		in, out := &in.Ais, &out.Ais
-		*out = new(ConfigurePortEthernetEthCfmMepAis)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AlarmNotification != nil {
-		in, out := &in.AlarmNotification, &out.AlarmNotification
-		*out = new(ConfigurePortEthernetEthCfmMepAlarmNotification)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ccm != nil {
-		in, out := &in.Ccm, &out.Ccm
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CcmLtmPriority != nil {
-		in, out := &in.CcmLtmPriority, &out.CcmLtmPriority
-		*out = new(string)
-		**out = **in
-	}
-	if in.CcmPaddingSize != nil {
-		in, out := &in.CcmPaddingSize, &out.CcmPaddingSize
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.CcmTlvIgnore != nil {
-		in, out := &in.CcmTlvIgnore, &out.CcmTlvIgnore
-		*out = new(string)
-		**out = **in
-	}
-	if in.CollectLmmStats != nil {
-		in, out := &in.CollectLmmStats, &out.CollectLmmStats
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Csf != nil {
-		in, out := &in.Csf, &out.Csf
-		*out = new(ConfigurePortEthernetEthCfmMepCsf)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
 		*out = new(string)
-		**out = **in
-	}
-	if in.EthBn != nil {
-		in, out := &in.EthBn, &out.EthBn
-		*out = new(ConfigurePortEthernetEthCfmMepEthBn)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EthTest != nil {
-		in, out := &in.EthTest, &out.EthTest
-		*out = new(ConfigurePortEthernetEthCfmMepEthTest)
-		(*in).DeepCopyInto(*out)
+		**out = **in
 	}
-	if in.FacilityFault != nil {
-		in, out := &in.FacilityFault, &out.FacilityFault
-		*out = new(bool)
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
 		**out = **in
 	}
-	if in.Grace != nil {
-		in, out := &in.Grace, &out.Grace
-		*out = new(ConfigurePortEthernetEthCfmMepGrace)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate.
+func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate {
+	if in == nil {
+		return nil
 	}
-	if in.InstallMep != nil {
-		in, out := &in.InstallMep, &out.InstallMep
+	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortEthernetReportAlarm) DeepCopyInto(out *ConfigurePortEthernetReportAlarm) {
+	*out = *in
+	if in.AlignmentMarkerNotLocked != nil {
+		in, out := &in.AlignmentMarkerNotLocked, &out.AlignmentMarkerNotLocked
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LowPriorityDefect != nil {
-		in, out := &in.LowPriorityDefect, &out.LowPriorityDefect
-		*out = new(string)
+	if in.BlockNotLocked != nil {
+		in, out := &in.BlockNotLocked, &out.BlockNotLocked
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MaAdminName != nil {
-		in, out := &in.MaAdminName, &out.MaAdminName
-		*out = new(string)
+	if in.DuplicateLane != nil {
+		in, out := &in.DuplicateLane, &out.DuplicateLane
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MacAddress != nil {
-		in, out := &in.MacAddress, &out.MacAddress
-		*out = new(string)
+	if in.FrameNotLocked != nil {
+		in, out := &in.FrameNotLocked, &out.FrameNotLocked
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MdAdminName != nil {
-		in, out := &in.MdAdminName, &out.MdAdminName
-		*out = new(string)
+	if in.HighBer != nil {
+		in, out := &in.HighBer, &out.HighBer
+		*out = new(bool)
 		**out = **in
 	}
-	if in.MepId != nil {
-		in, out := &in.MepId, &out.MepId
-		*out = new(string)
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(bool)
 		**out = **in
 	}
-	if in.OneWayDelayThreshold != nil {
-		in, out := &in.OneWayDelayThreshold, &out.OneWayDelayThreshold
-		*out = new(uint32)
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Vlan != nil {
-		in, out := &in.Vlan, &out.Vlan
-		*out = new(string)
+	if in.SignalFail != nil {
+		in, out := &in.SignalFail, &out.SignalFail
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMep.
-func (in *ConfigurePortEthernetEthCfmMep) DeepCopy() *ConfigurePortEthernetEthCfmMep {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetReportAlarm.
+func (in *ConfigurePortEthernetReportAlarm) DeepCopy() *ConfigurePortEthernetReportAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMep)
+	out := new(ConfigurePortEthernetReportAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMepAis) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepAis) {
+func (in *ConfigurePortEthernetSsm) DeepCopyInto(out *ConfigurePortEthernetSsm) {
 	*out = *in
-	if in.ClientMegLevel != nil {
-		in, out := &in.ClientMegLevel, &out.ClientMegLevel
-		*out = new(uint32)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-	if in.InterfaceSupport != nil {
-		in, out := &in.InterfaceSupport, &out.InterfaceSupport
-		*out = new(bool)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
 		**out = **in
 	}
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(uint32)
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
 		**out = **in
 	}
-	if in.LowPriorityDefect != nil {
-		in, out := &in.LowPriorityDefect, &out.LowPriorityDefect
+	if in.CodeType != nil {
+		in, out := &in.CodeType, &out.CodeType
 		*out = new(string)
 		**out = **in
 	}
-	if in.Priority != nil {
-		in, out := &in.Priority, &out.Priority
-		*out = new(string)
+	if in.EsmcTunnel != nil {
+		in, out := &in.EsmcTunnel, &out.EsmcTunnel
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TxDus != nil {
+		in, out := &in.TxDus, &out.TxDus
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepAis.
-func (in *ConfigurePortEthernetEthCfmMepAis) DeepCopy() *ConfigurePortEthernetEthCfmMepAis {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetSsm.
+func (in *ConfigurePortEthernetSsm) DeepCopy() *ConfigurePortEthernetSsm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMepAis)
+	out := new(ConfigurePortEthernetSsm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMepAlarmNotification) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepAlarmNotification) {
+func (in *ConfigurePortEthernetSymbolMonitor) DeepCopyInto(out *ConfigurePortEthernetSymbolMonitor) {
 	*out = *in
-	if in.FngAlarmTime != nil {
-		in, out := &in.FngAlarmTime, &out.FngAlarmTime
-		*out = new(int32)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
 		**out = **in
 	}
-	if in.FngResetTime != nil {
-		in, out := &in.FngResetTime, &out.FngResetTime
-		*out = new(int32)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.SignalDegrade != nil {
+		in, out := &in.SignalDegrade, &out.SignalDegrade
+		*out = new(ConfigurePortEthernetSymbolMonitorSignalDegrade)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SignalFailure != nil {
+		in, out := &in.SignalFailure, &out.SignalFailure
+		*out = new(ConfigurePortEthernetSymbolMonitorSignalFailure)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WindowSize != nil {
+		in, out := &in.WindowSize, &out.WindowSize
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepAlarmNotification.
-func (in *ConfigurePortEthernetEthCfmMepAlarmNotification) DeepCopy() *ConfigurePortEthernetEthCfmMepAlarmNotification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetSymbolMonitor.
+func (in *ConfigurePortEthernetSymbolMonitor) DeepCopy() *ConfigurePortEthernetSymbolMonitor {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMepAlarmNotification)
+	out := new(ConfigurePortEthernetSymbolMonitor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMepCsf) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepCsf) {
+func (in *ConfigurePortEthernetSymbolMonitorSignalDegrade) DeepCopyInto(out *ConfigurePortEthernetSymbolMonitorSignalDegrade) {
 	*out = *in
 	if in.Multiplier != nil {
 		in, out := &in.Multiplier, &out.Multiplier
-		*out = new(string)
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepCsf.
-func (in *ConfigurePortEthernetEthCfmMepCsf) DeepCopy() *ConfigurePortEthernetEthCfmMepCsf {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetSymbolMonitorSignalDegrade.
+func (in *ConfigurePortEthernetSymbolMonitorSignalDegrade) DeepCopy() *ConfigurePortEthernetSymbolMonitorSignalDegrade {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMepCsf)
+	out := new(ConfigurePortEthernetSymbolMonitorSignalDegrade)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMepEthBn) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepEthBn) {
+func (in *ConfigurePortEthernetSymbolMonitorSignalFailure) DeepCopyInto(out *ConfigurePortEthernetSymbolMonitorSignalFailure) {
 	*out = *in
-	if in.Receive != nil {
-		in, out := &in.Receive, &out.Receive
-		*out = new(bool)
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.RxUpdatePacing != nil {
-		in, out := &in.RxUpdatePacing, &out.RxUpdatePacing
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepEthBn.
-func (in *ConfigurePortEthernetEthCfmMepEthBn) DeepCopy() *ConfigurePortEthernetEthCfmMepEthBn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetSymbolMonitorSignalFailure.
+func (in *ConfigurePortEthernetSymbolMonitorSignalFailure) DeepCopy() *ConfigurePortEthernetSymbolMonitorSignalFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMepEthBn)
+	out := new(ConfigurePortEthernetSymbolMonitorSignalFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMepEthTest) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepEthTest) {
+func (in *ConfigurePortGnss) DeepCopyInto(out *ConfigurePortGnss) {
 	*out = *in
-	if in.BitErrorThreshold != nil {
-		in, out := &in.BitErrorThreshold, &out.BitErrorThreshold
+	if in.AntennaCableDelay != nil {
+		in, out := &in.AntennaCableDelay, &out.AntennaCableDelay
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.TestPattern != nil {
-		in, out := &in.TestPattern, &out.TestPattern
-		*out = new(ConfigurePortEthernetEthCfmMepEthTestTestPattern)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Constellation != nil {
+		in, out := &in.Constellation, &out.Constellation
+		*out = new(ConfigurePortGnssConstellation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ElevationMaskAngle != nil {
+		in, out := &in.ElevationMaskAngle, &out.ElevationMaskAngle
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepEthTest.
-func (in *ConfigurePortEthernetEthCfmMepEthTest) DeepCopy() *ConfigurePortEthernetEthCfmMepEthTest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortGnss.
+func (in *ConfigurePortGnss) DeepCopy() *ConfigurePortGnss {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMepEthTest)
+	out := new(ConfigurePortGnss)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMepEthTestTestPattern) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepEthTestTestPattern) {
+func (in *ConfigurePortGnssConstellation) DeepCopyInto(out *ConfigurePortGnssConstellation) {
 	*out = *in
-	if in.CrcTlv != nil {
-		in, out := &in.CrcTlv, &out.CrcTlv
+	if in.Glonass != nil {
+		in, out := &in.Glonass, &out.Glonass
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Pattern != nil {
-		in, out := &in.Pattern, &out.Pattern
-		*out = new(string)
+	if in.Gps != nil {
+		in, out := &in.Gps, &out.Gps
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepEthTestTestPattern.
-func (in *ConfigurePortEthernetEthCfmMepEthTestTestPattern) DeepCopy() *ConfigurePortEthernetEthCfmMepEthTestTestPattern {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortGnssConstellation.
+func (in *ConfigurePortGnssConstellation) DeepCopy() *ConfigurePortGnssConstellation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMepEthTestTestPattern)
+	out := new(ConfigurePortGnssConstellation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMepGrace) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepGrace) {
+func (in *ConfigurePortHybridBufferAllocation) DeepCopyInto(out *ConfigurePortHybridBufferAllocation) {
 	*out = *in
-	if in.EthEd != nil {
-		in, out := &in.EthEd, &out.EthEd
-		*out = new(ConfigurePortEthernetEthCfmMepGraceEthEd)
+	if in.EgressWeight != nil {
+		in, out := &in.EgressWeight, &out.EgressWeight
+		*out = new(ConfigurePortHybridBufferAllocationEgressWeight)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.EthVsmGrace != nil {
-		in, out := &in.EthVsmGrace, &out.EthVsmGrace
-		*out = new(ConfigurePortEthernetEthCfmMepGraceEthVsmGrace)
+	if in.IngressWeight != nil {
+		in, out := &in.IngressWeight, &out.IngressWeight
+		*out = new(ConfigurePortHybridBufferAllocationIngressWeight)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepGrace.
-func (in *ConfigurePortEthernetEthCfmMepGrace) DeepCopy() *ConfigurePortEthernetEthCfmMepGrace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortHybridBufferAllocation.
+func (in *ConfigurePortHybridBufferAllocation) DeepCopy() *ConfigurePortHybridBufferAllocation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMepGrace)
+	out := new(ConfigurePortHybridBufferAllocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMepGraceEthEd) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepGraceEthEd) {
+func (in *ConfigurePortHybridBufferAllocationEgressWeight) DeepCopyInto(out *ConfigurePortHybridBufferAllocationEgressWeight) {
 	*out = *in
-	if in.MaxRxDefectWindow != nil {
-		in, out := &in.MaxRxDefectWindow, &out.MaxRxDefectWindow
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Priority != nil {
-		in, out := &in.Priority, &out.Priority
-		*out = new(int32)
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.RxEthEd != nil {
-		in, out := &in.RxEthEd, &out.RxEthEd
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortHybridBufferAllocationEgressWeight.
+func (in *ConfigurePortHybridBufferAllocationEgressWeight) DeepCopy() *ConfigurePortHybridBufferAllocationEgressWeight {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortHybridBufferAllocationEgressWeight)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortHybridBufferAllocationIngressWeight) DeepCopyInto(out *ConfigurePortHybridBufferAllocationIngressWeight) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.TxEthEd != nil {
-		in, out := &in.TxEthEd, &out.TxEthEd
-		*out = new(bool)
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepGraceEthEd.
-func (in *ConfigurePortEthernetEthCfmMepGraceEthEd) DeepCopy() *ConfigurePortEthernetEthCfmMepGraceEthEd {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortHybridBufferAllocationIngressWeight.
+func (in *ConfigurePortHybridBufferAllocationIngressWeight) DeepCopy() *ConfigurePortHybridBufferAllocationIngressWeight {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMepGraceEthEd)
+	out := new(ConfigurePortHybridBufferAllocationIngressWeight)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetEthCfmMepGraceEthVsmGrace) DeepCopyInto(out *ConfigurePortEthernetEthCfmMepGraceEthVsmGrace) {
+func (in *ConfigurePortModifyBufferAllocation) DeepCopyInto(out *ConfigurePortModifyBufferAllocation) {
 	*out = *in
-	if in.RxEthVsmGrace != nil {
-		in, out := &in.RxEthVsmGrace, &out.RxEthVsmGrace
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TxEthVsmGrace != nil {
-		in, out := &in.TxEthVsmGrace, &out.TxEthVsmGrace
-		*out = new(bool)
-		**out = **in
+	if in.PercentageOfRate != nil {
+		in, out := &in.PercentageOfRate, &out.PercentageOfRate
+		*out = new(ConfigurePortModifyBufferAllocationPercentageOfRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetEthCfmMepGraceEthVsmGrace.
-func (in *ConfigurePortEthernetEthCfmMepGraceEthVsmGrace) DeepCopy() *ConfigurePortEthernetEthCfmMepGraceEthVsmGrace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortModifyBufferAllocation.
+func (in *ConfigurePortModifyBufferAllocation) DeepCopy() *ConfigurePortModifyBufferAllocation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetEthCfmMepGraceEthVsmGrace)
+	out := new(ConfigurePortModifyBufferAllocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetHoldTime) DeepCopyInto(out *ConfigurePortEthernetHoldTime) {
+func (in *ConfigurePortModifyBufferAllocationPercentageOfRate) DeepCopyInto(out *ConfigurePortModifyBufferAllocationPercentageOfRate) {
 	*out = *in
-	if in.Down != nil {
-		in, out := &in.Down, &out.Down
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Units != nil {
-		in, out := &in.Units, &out.Units
-		*out = new(string)
-		**out = **in
-	}
-	if in.Up != nil {
-		in, out := &in.Up, &out.Up
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetHoldTime.
-func (in *ConfigurePortEthernetHoldTime) DeepCopy() *ConfigurePortEthernetHoldTime {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortModifyBufferAllocationPercentageOfRate.
+func (in *ConfigurePortModifyBufferAllocationPercentageOfRate) DeepCopy() *ConfigurePortModifyBufferAllocationPercentageOfRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetHoldTime)
+	out := new(ConfigurePortModifyBufferAllocationPercentageOfRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetHsmdaSchedulerOverrides) DeepCopyInto(out *ConfigurePortEthernetHsmdaSchedulerOverrides) {
+func (in *ConfigurePortNetwork) DeepCopyInto(out *ConfigurePortNetwork) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -4889,48 +6577,57 @@ func (in *ConfigurePortEthernetHsmdaSchedulerOverrides) DeepCopyInto(out *Config
 		*out = new(string)
 		**out = **in
 	}
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = make([]*ConfigurePortEthernetHsmdaSchedulerOverridesGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetHsmdaSchedulerOverridesGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(ConfigurePortNetworkEgress)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MaxRate != nil {
-		in, out := &in.MaxRate, &out.MaxRate
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetwork.
+func (in *ConfigurePortNetwork) DeepCopy() *ConfigurePortNetwork {
+	if in == nil {
+		return nil
 	}
-	if in.SchedulingClass != nil {
-		in, out := &in.SchedulingClass, &out.SchedulingClass
-		*out = make([]*ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass, len(*in))
+	out := new(ConfigurePortNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortNetworkEgress) DeepCopyInto(out *ConfigurePortNetworkEgress) {
+	*out = *in
+	if in.Pool != nil {
+		in, out := &in.Pool, &out.Pool
+		*out = make([]*ConfigurePortNetworkEgressPool, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass)
+				*out = new(ConfigurePortNetworkEgressPool)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetHsmdaSchedulerOverrides.
-func (in *ConfigurePortEthernetHsmdaSchedulerOverrides) DeepCopy() *ConfigurePortEthernetHsmdaSchedulerOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetworkEgress.
+func (in *ConfigurePortNetworkEgress) DeepCopy() *ConfigurePortNetworkEgress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetHsmdaSchedulerOverrides)
+	out := new(ConfigurePortNetworkEgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetHsmdaSchedulerOverridesGroup) DeepCopyInto(out *ConfigurePortEthernetHsmdaSchedulerOverridesGroup) {
+func (in *ConfigurePortNetworkEgressPool) DeepCopyInto(out *ConfigurePortNetworkEgressPool) {
 	*out = *in
+	if in.AmberAlarmThreshold != nil {
+		in, out := &in.AmberAlarmThreshold, &out.AmberAlarmThreshold
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -4941,203 +6638,232 @@ func (in *ConfigurePortEthernetHsmdaSchedulerOverridesGroup) DeepCopyInto(out *C
 		*out = new(string)
 		**out = **in
 	}
-	if in.GroupId != nil {
-		in, out := &in.GroupId, &out.GroupId
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RedAlarmThreshold != nil {
+		in, out := &in.RedAlarmThreshold, &out.RedAlarmThreshold
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
+	if in.ResvCbs != nil {
+		in, out := &in.ResvCbs, &out.ResvCbs
+		*out = new(ConfigurePortNetworkEgressPoolResvCbs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SlopePolicy != nil {
+		in, out := &in.SlopePolicy, &out.SlopePolicy
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetHsmdaSchedulerOverridesGroup.
-func (in *ConfigurePortEthernetHsmdaSchedulerOverridesGroup) DeepCopy() *ConfigurePortEthernetHsmdaSchedulerOverridesGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetworkEgressPool.
+func (in *ConfigurePortNetworkEgressPool) DeepCopy() *ConfigurePortNetworkEgressPool {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetHsmdaSchedulerOverridesGroup)
+	out := new(ConfigurePortNetworkEgressPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass) DeepCopyInto(out *ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass) {
+func (in *ConfigurePortNetworkEgressPoolResvCbs) DeepCopyInto(out *ConfigurePortNetworkEgressPoolResvCbs) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
+	if in.AmberAlarmAction != nil {
+		in, out := &in.AmberAlarmAction, &out.AmberAlarmAction
+		*out = new(ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cbs != nil {
+		in, out := &in.Cbs, &out.Cbs
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetworkEgressPoolResvCbs.
+func (in *ConfigurePortNetworkEgressPoolResvCbs) DeepCopy() *ConfigurePortNetworkEgressPoolResvCbs {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortNetworkEgressPoolResvCbs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction) DeepCopyInto(out *ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction) {
+	*out = *in
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction.
+func (in *ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction) DeepCopy() *ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortObservation) DeepCopyInto(out *ConfigurePortObservation) {
+	*out = *in
+	if in.OperState != nil {
+		in, out := &in.OperState, &out.OperState
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClassNumber != nil {
-		in, out := &in.ClassNumber, &out.ClassNumber
+	if in.OperSpeed != nil {
+		in, out := &in.OperSpeed, &out.OperSpeed
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
+	if in.OperDuplex != nil {
+		in, out := &in.OperDuplex, &out.OperDuplex
 		*out = new(string)
 		**out = **in
 	}
-	if in.WeightInGroup != nil {
-		in, out := &in.WeightInGroup, &out.WeightInGroup
-		*out = new(int32)
+	if in.OperMtu != nil {
+		in, out := &in.OperMtu, &out.OperMtu
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.LastOperChange != nil {
+		in, out := &in.LastOperChange, &out.LastOperChange
+		*out = new(string)
 		**out = **in
 	}
+	if in.Transceiver != nil {
+		in, out := &in.Transceiver, &out.Transceiver
+		*out = new(ConfigurePortObservationTransceiver)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass.
-func (in *ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass) DeepCopy() *ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortObservation.
+func (in *ConfigurePortObservation) DeepCopy() *ConfigurePortObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetHsmdaSchedulerOverridesSchedulingClass)
+	out := new(ConfigurePortObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetIngress) DeepCopyInto(out *ConfigurePortEthernetIngress) {
+func (in *ConfigurePortObservationTransceiver) DeepCopyInto(out *ConfigurePortObservationTransceiver) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(int32)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
 		**out = **in
 	}
+	if in.DigitalDiagnosticMonitoring != nil {
+		in, out := &in.DigitalDiagnosticMonitoring, &out.DigitalDiagnosticMonitoring
+		*out = new(ConfigurePortObservationTransceiverDdm)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetIngress.
-func (in *ConfigurePortEthernetIngress) DeepCopy() *ConfigurePortEthernetIngress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortObservationTransceiver.
+func (in *ConfigurePortObservationTransceiver) DeepCopy() *ConfigurePortObservationTransceiver {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetIngress)
+	out := new(ConfigurePortObservationTransceiver)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetLldp) DeepCopyInto(out *ConfigurePortEthernetLldp) {
+func (in *ConfigurePortObservationTransceiverDdm) DeepCopyInto(out *ConfigurePortObservationTransceiverDdm) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
+	if in.Temperature != nil {
+		in, out := &in.Temperature, &out.Temperature
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.SupplyVoltage != nil {
+		in, out := &in.SupplyVoltage, &out.SupplyVoltage
 		*out = new(string)
 		**out = **in
 	}
-	if in.DestMac != nil {
-		in, out := &in.DestMac, &out.DestMac
-		*out = make([]*ConfigurePortEthernetLldpDestMac, len(*in))
+	if in.Lane != nil {
+		in, out := &in.Lane, &out.Lane
+		*out = make([]*ConfigurePortObservationTransceiverDdmLane, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetLldpDestMac)
+				*out = new(ConfigurePortObservationTransceiverDdmLane)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLldp.
-func (in *ConfigurePortEthernetLldp) DeepCopy() *ConfigurePortEthernetLldp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortObservationTransceiverDdm.
+func (in *ConfigurePortObservationTransceiverDdm) DeepCopy() *ConfigurePortObservationTransceiverDdm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetLldp)
+	out := new(ConfigurePortObservationTransceiverDdm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetLldpDestMac) DeepCopyInto(out *ConfigurePortEthernetLldpDestMac) {
+func (in *ConfigurePortObservationTransceiverDdmLane) DeepCopyInto(out *ConfigurePortObservationTransceiverDdmLane) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
+	if in.LaneId != nil {
+		in, out := &in.LaneId, &out.LaneId
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.TransmitBiasCurrent != nil {
+		in, out := &in.TransmitBiasCurrent, &out.TransmitBiasCurrent
 		*out = new(string)
 		**out = **in
 	}
-	if in.MacType != nil {
-		in, out := &in.MacType, &out.MacType
+	if in.TransmitOutputPower != nil {
+		in, out := &in.TransmitOutputPower, &out.TransmitOutputPower
 		*out = new(string)
 		**out = **in
 	}
-	if in.Notification != nil {
-		in, out := &in.Notification, &out.Notification
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PortIdSubtype != nil {
-		in, out := &in.PortIdSubtype, &out.PortIdSubtype
+	if in.ReceiveOpticalPower != nil {
+		in, out := &in.ReceiveOpticalPower, &out.ReceiveOpticalPower
 		*out = new(string)
 		**out = **in
 	}
-	if in.Receive != nil {
-		in, out := &in.Receive, &out.Receive
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Transmit != nil {
-		in, out := &in.Transmit, &out.Transmit
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TunnelNearestBridge != nil {
-		in, out := &in.TunnelNearestBridge, &out.TunnelNearestBridge
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TxMgmtAddress != nil {
-		in, out := &in.TxMgmtAddress, &out.TxMgmtAddress
-		*out = make([]*ConfigurePortEthernetLldpDestMacTxMgmtAddress, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetLldpDestMacTxMgmtAddress)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.TxTlvs != nil {
-		in, out := &in.TxTlvs, &out.TxTlvs
-		*out = new(ConfigurePortEthernetLldpDestMacTxTlvs)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLldpDestMac.
-func (in *ConfigurePortEthernetLldpDestMac) DeepCopy() *ConfigurePortEthernetLldpDestMac {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortObservationTransceiverDdmLane.
+func (in *ConfigurePortObservationTransceiverDdmLane) DeepCopy() *ConfigurePortObservationTransceiverDdmLane {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetLldpDestMac)
+	out := new(ConfigurePortObservationTransceiverDdmLane)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetLldpDestMacTxMgmtAddress) DeepCopyInto(out *ConfigurePortEthernetLldpDestMacTxMgmtAddress) {
+func (in *ConfigurePortOtu) DeepCopyInto(out *ConfigurePortOtu) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -5148,1120 +6874,981 @@ func (in *ConfigurePortEthernetLldpDestMacTxMgmtAddress) DeepCopyInto(out *Confi
 		*out = new(string)
 		**out = **in
 	}
-	if in.MgmtAddressSystemType != nil {
-		in, out := &in.MgmtAddressSystemType, &out.MgmtAddressSystemType
+	if in.AsyncMapping != nil {
+		in, out := &in.AsyncMapping, &out.AsyncMapping
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Fec != nil {
+		in, out := &in.Fec, &out.Fec
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLldpDestMacTxMgmtAddress.
-func (in *ConfigurePortEthernetLldpDestMacTxMgmtAddress) DeepCopy() *ConfigurePortEthernetLldpDestMacTxMgmtAddress {
-	if in == nil {
-		return nil
+	if in.FineGranularityBer != nil {
+		in, out := &in.FineGranularityBer, &out.FineGranularityBer
+		*out = new(ConfigurePortOtuFineGranularityBer)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ConfigurePortEthernetLldpDestMacTxMgmtAddress)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetLldpDestMacTxTlvs) DeepCopyInto(out *ConfigurePortEthernetLldpDestMacTxTlvs) {
-	*out = *in
-	if in.PortDesc != nil {
-		in, out := &in.PortDesc, &out.PortDesc
-		*out = new(bool)
+	if in.Otu2LanDataRate != nil {
+		in, out := &in.Otu2LanDataRate, &out.Otu2LanDataRate
+		*out = new(string)
 		**out = **in
 	}
-	if in.SysCap != nil {
-		in, out := &in.SysCap, &out.SysCap
-		*out = new(bool)
+	if in.PathMonitoring != nil {
+		in, out := &in.PathMonitoring, &out.PathMonitoring
+		*out = new(ConfigurePortOtuPathMonitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PayloadStructureIdentifier != nil {
+		in, out := &in.PayloadStructureIdentifier, &out.PayloadStructureIdentifier
+		*out = new(ConfigurePortOtuPayloadStructureIdentifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReportAlarm != nil {
+		in, out := &in.ReportAlarm, &out.ReportAlarm
+		*out = new(ConfigurePortOtuReportAlarm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SdThreshold != nil {
+		in, out := &in.SdThreshold, &out.SdThreshold
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.SysDesc != nil {
-		in, out := &in.SysDesc, &out.SysDesc
-		*out = new(bool)
+	if in.SectionMonitoring != nil {
+		in, out := &in.SectionMonitoring, &out.SectionMonitoring
+		*out = new(ConfigurePortOtuSectionMonitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SfSdMethod != nil {
+		in, out := &in.SfSdMethod, &out.SfSdMethod
+		*out = new(string)
 		**out = **in
 	}
-	if in.SysName != nil {
-		in, out := &in.SysName, &out.SysName
-		*out = new(bool)
+	if in.SfThreshold != nil {
+		in, out := &in.SfThreshold, &out.SfThreshold
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLldpDestMacTxTlvs.
-func (in *ConfigurePortEthernetLldpDestMacTxTlvs) DeepCopy() *ConfigurePortEthernetLldpDestMacTxTlvs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtu.
+func (in *ConfigurePortOtu) DeepCopy() *ConfigurePortOtu {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetLldpDestMacTxTlvs)
+	out := new(ConfigurePortOtu)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetLoopback) DeepCopyInto(out *ConfigurePortEthernetLoopback) {
+func (in *ConfigurePortOtuFineGranularityBer) DeepCopyInto(out *ConfigurePortOtuFineGranularityBer) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.Direction != nil {
-		in, out := &in.Direction, &out.Direction
-		*out = new(string)
-		**out = **in
+	if in.SignalDegrade != nil {
+		in, out := &in.SignalDegrade, &out.SignalDegrade
+		*out = new(ConfigurePortOtuFineGranularityBerSignalDegrade)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.SwapSrcDstMac != nil {
-		in, out := &in.SwapSrcDstMac, &out.SwapSrcDstMac
-		*out = new(bool)
-		**out = **in
+	if in.SignalFailure != nil {
+		in, out := &in.SignalFailure, &out.SignalFailure
+		*out = new(ConfigurePortOtuFineGranularityBerSignalFailure)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetLoopback.
-func (in *ConfigurePortEthernetLoopback) DeepCopy() *ConfigurePortEthernetLoopback {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBer.
+func (in *ConfigurePortOtuFineGranularityBer) DeepCopy() *ConfigurePortOtuFineGranularityBer {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetLoopback)
+	out := new(ConfigurePortOtuFineGranularityBer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetwork) DeepCopyInto(out *ConfigurePortEthernetNetwork) {
+func (in *ConfigurePortOtuFineGranularityBerSignalDegrade) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalDegrade) {
 	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
+	if in.Clear != nil {
+		in, out := &in.Clear, &out.Clear
+		*out = new(ConfigurePortOtuFineGranularityBerSignalDegradeClear)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortEthernetNetworkEgress)
+	if in.Raise != nil {
+		in, out := &in.Raise, &out.Raise
+		*out = new(ConfigurePortOtuFineGranularityBerSignalDegradeRaise)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetwork.
-func (in *ConfigurePortEthernetNetwork) DeepCopy() *ConfigurePortEthernetNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalDegrade.
+func (in *ConfigurePortOtuFineGranularityBerSignalDegrade) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalDegrade {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetwork)
+	out := new(ConfigurePortOtuFineGranularityBerSignalDegrade)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgress) DeepCopyInto(out *ConfigurePortEthernetNetworkEgress) {
+func (in *ConfigurePortOtuFineGranularityBerSignalDegradeClear) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalDegradeClear) {
 	*out = *in
-	if in.PortQueues != nil {
-		in, out := &in.PortQueues, &out.PortQueues
-		*out = new(ConfigurePortEthernetNetworkEgressPortQueues)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.QueueGroup != nil {
-		in, out := &in.QueueGroup, &out.QueueGroup
-		*out = make([]*ConfigurePortEthernetNetworkEgressQueueGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetNetworkEgressQueueGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.QueuePolicy != nil {
-		in, out := &in.QueuePolicy, &out.QueuePolicy
-		*out = new(string)
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgress.
-func (in *ConfigurePortEthernetNetworkEgress) DeepCopy() *ConfigurePortEthernetNetworkEgress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalDegradeClear.
+func (in *ConfigurePortOtuFineGranularityBerSignalDegradeClear) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalDegradeClear {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgress)
+	out := new(ConfigurePortOtuFineGranularityBerSignalDegradeClear)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressPortQueues) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressPortQueues) {
+func (in *ConfigurePortOtuFineGranularityBerSignalDegradeRaise) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalDegradeRaise) {
 	*out = *in
-	if in.Overrides != nil {
-		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortEthernetNetworkEgressPortQueuesOverrides)
-		(*in).DeepCopyInto(*out)
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(uint32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressPortQueues.
-func (in *ConfigurePortEthernetNetworkEgressPortQueues) DeepCopy() *ConfigurePortEthernetNetworkEgressPortQueues {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalDegradeRaise.
+func (in *ConfigurePortOtuFineGranularityBerSignalDegradeRaise) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalDegradeRaise {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressPortQueues)
+	out := new(ConfigurePortOtuFineGranularityBerSignalDegradeRaise)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverrides) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressPortQueuesOverrides) {
+func (in *ConfigurePortOtuFineGranularityBerSignalFailure) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalFailure) {
 	*out = *in
-	if in.Queue != nil {
-		in, out := &in.Queue, &out.Queue
-		*out = make([]*ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Clear != nil {
+		in, out := &in.Clear, &out.Clear
+		*out = new(ConfigurePortOtuFineGranularityBerSignalFailureClear)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Raise != nil {
+		in, out := &in.Raise, &out.Raise
+		*out = new(ConfigurePortOtuFineGranularityBerSignalFailureRaise)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressPortQueuesOverrides.
-func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverrides) DeepCopy() *ConfigurePortEthernetNetworkEgressPortQueuesOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalFailure.
+func (in *ConfigurePortOtuFineGranularityBerSignalFailure) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressPortQueuesOverrides)
+	out := new(ConfigurePortOtuFineGranularityBerSignalFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue) {
+func (in *ConfigurePortOtuFineGranularityBerSignalFailureClear) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalFailureClear) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.MonitorQueueDepth != nil {
-		in, out := &in.MonitorQueueDepth, &out.MonitorQueueDepth
-		*out = new(ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.QueueId != nil {
-		in, out := &in.QueueId, &out.QueueId
-		*out = new(string)
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue.
-func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue) DeepCopy() *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalFailureClear.
+func (in *ConfigurePortOtuFineGranularityBerSignalFailureClear) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalFailureClear {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueue)
+	out := new(ConfigurePortOtuFineGranularityBerSignalFailureClear)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth) {
+func (in *ConfigurePortOtuFineGranularityBerSignalFailureRaise) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalFailureRaise) {
 	*out = *in
-	if in.FastPolling != nil {
-		in, out := &in.FastPolling, &out.FastPolling
-		*out = new(bool)
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.ViolationThreshold != nil {
-		in, out := &in.ViolationThreshold, &out.ViolationThreshold
-		*out = new(string)
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth.
-func (in *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth) DeepCopy() *ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalFailureRaise.
+func (in *ConfigurePortOtuFineGranularityBerSignalFailureRaise) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalFailureRaise {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressPortQueuesOverridesQueueMonitorQueueDepth)
+	out := new(ConfigurePortOtuFineGranularityBerSignalFailureRaise)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroup) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroup) {
+func (in *ConfigurePortOtuPathMonitoring) DeepCopyInto(out *ConfigurePortOtuPathMonitoring) {
 	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.AggregateRate != nil {
-		in, out := &in.AggregateRate, &out.AggregateRate
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.HsTurbo != nil {
-		in, out := &in.HsTurbo, &out.HsTurbo
-		*out = new(bool)
-		**out = **in
-	}
-	if in.InstanceId != nil {
-		in, out := &in.InstanceId, &out.InstanceId
-		*out = new(uint16)
-		**out = **in
-	}
-	if in.PolicerControlPolicy != nil {
-		in, out := &in.PolicerControlPolicy, &out.PolicerControlPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.QueueGroupName != nil {
-		in, out := &in.QueueGroupName, &out.QueueGroupName
-		*out = new(string)
-		**out = **in
-	}
-	if in.QueueOverrides != nil {
-		in, out := &in.QueueOverrides, &out.QueueOverrides
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides)
+	if in.TrailTraceIdentifier != nil {
+		in, out := &in.TrailTraceIdentifier, &out.TrailTraceIdentifier
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifier)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SchedulerPolicy != nil {
-		in, out := &in.SchedulerPolicy, &out.SchedulerPolicy
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroup.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroup) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoring.
+func (in *ConfigurePortOtuPathMonitoring) DeepCopy() *ConfigurePortOtuPathMonitoring {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroup)
+	out := new(ConfigurePortOtuPathMonitoring)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifier) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifier) {
 	*out = *in
-	if in.LimitUnusedBandwidth != nil {
-		in, out := &in.LimitUnusedBandwidth, &out.LimitUnusedBandwidth
-		*out = new(bool)
-		**out = **in
-	}
-	if in.QueueFrameBasedAccounting != nil {
-		in, out := &in.QueueFrameBasedAccounting, &out.QueueFrameBasedAccounting
-		*out = new(bool)
-		**out = **in
+	if in.Expected != nil {
+		in, out := &in.Expected, &out.Expected
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
+	if in.MismatchReaction != nil {
+		in, out := &in.MismatchReaction, &out.MismatchReaction
 		*out = new(string)
 		**out = **in
 	}
+	if in.Transmit != nil {
+		in, out := &in.Transmit, &out.Transmit
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifier.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifier) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifier {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupAggregateRate)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifier)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected) {
 	*out = *in
-	if in.Queue != nil {
-		in, out := &in.Queue, &out.Queue
-		*out = make([]*ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Expected != nil {
+		in, out := &in.Expected, &out.Expected
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverrides)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected) {
 	*out = *in
-	if in.AdaptationRule != nil {
-		in, out := &in.AdaptationRule, &out.AdaptationRule
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.Cbs != nil {
-		in, out := &in.Cbs, &out.Cbs
-		*out = new(string)
-		**out = **in
-	}
-	if in.DropTail != nil {
-		in, out := &in.DropTail, &out.DropTail
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail)
+	if in.AutoGenerated != nil {
+		in, out := &in.AutoGenerated, &out.AutoGenerated
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Mbs != nil {
-		in, out := &in.Mbs, &out.Mbs
-		*out = new(string)
-		**out = **in
-	}
-	if in.MonitorDepth != nil {
-		in, out := &in.MonitorDepth, &out.MonitorDepth
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MonitorQueueDepth != nil {
-		in, out := &in.MonitorQueueDepth, &out.MonitorQueueDepth
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth)
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.QueueId != nil {
-		in, out := &in.QueueId, &out.QueueId
-		*out = new(string)
-		**out = **in
-	}
-	if in.QueueOverrideRate != nil {
-		in, out := &in.QueueOverrideRate, &out.QueueOverrideRate
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate)
+	if in.String != nil {
+		in, out := &in.String, &out.String
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueue)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
-		*out = new(string)
-		**out = **in
-	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.AutoGenerated != nil {
+		in, out := &in.AutoGenerated, &out.AutoGenerated
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueAdaptationRule)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes) {
 	*out = *in
-	if in.Low != nil {
-		in, out := &in.Low, &out.Low
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow)
-		(*in).DeepCopyInto(*out)
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTail)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString) {
 	*out = *in
-	if in.PercentReductionFromMbs != nil {
-		in, out := &in.PercentReductionFromMbs, &out.PercentReductionFromMbs
+	if in.String != nil {
+		in, out := &in.String, &out.String
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueDropTailLow)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit) {
 	*out = *in
-	if in.FastPolling != nil {
-		in, out := &in.FastPolling, &out.FastPolling
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ViolationThreshold != nil {
-		in, out := &in.ViolationThreshold, &out.ViolationThreshold
-		*out = new(string)
-		**out = **in
+	if in.Transmit != nil {
+		in, out := &in.Transmit, &out.Transmit
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueMonitorQueueDepth)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate)
+	if in.AutoGenerated != nil {
+		in, out := &in.AutoGenerated, &out.AutoGenerated
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate)
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.String != nil {
+		in, out := &in.String, &out.String
+		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRate)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.AutoGenerated != nil {
+		in, out := &in.AutoGenerated, &out.AutoGenerated
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRate)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) {
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString) {
+	*out = *in
+	if in.String != nil {
+		in, out := &in.String, &out.String
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString.
+func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRatePercentRatePercentRate)
+	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) {
+func (in *ConfigurePortOtuPayloadStructureIdentifier) DeepCopyInto(out *ConfigurePortOtuPayloadStructureIdentifier) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate)
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = new(ConfigurePortOtuPayloadStructureIdentifierPayload)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPayloadStructureIdentifier.
+func (in *ConfigurePortOtuPayloadStructureIdentifier) DeepCopy() *ConfigurePortOtuPayloadStructureIdentifier {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRate)
+	out := new(ConfigurePortOtuPayloadStructureIdentifier)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) DeepCopyInto(out *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) {
+func (in *ConfigurePortOtuPayloadStructureIdentifierPayload) DeepCopyInto(out *ConfigurePortOtuPayloadStructureIdentifierPayload) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.Expected != nil {
+		in, out := &in.Expected, &out.Expected
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.MismatchReaction != nil {
+		in, out := &in.MismatchReaction, &out.MismatchReaction
+		*out = new(string)
+		**out = **in
+	}
+	if in.Transmit != nil {
+		in, out := &in.Transmit, &out.Transmit
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate.
-func (in *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate) DeepCopy() *ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPayloadStructureIdentifierPayload.
+func (in *ConfigurePortOtuPayloadStructureIdentifierPayload) DeepCopy() *ConfigurePortOtuPayloadStructureIdentifierPayload {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetNetworkEgressQueueGroupQueueOverridesQueueQueueOverrideRateRateRate)
+	out := new(ConfigurePortOtuPayloadStructureIdentifierPayload)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetReportAlarm) DeepCopyInto(out *ConfigurePortEthernetReportAlarm) {
+func (in *ConfigurePortOtuReportAlarm) DeepCopyInto(out *ConfigurePortOtuReportAlarm) {
 	*out = *in
-	if in.AlignmentMarkerNotLocked != nil {
-		in, out := &in.AlignmentMarkerNotLocked, &out.AlignmentMarkerNotLocked
+	if in.FecFail != nil {
+		in, out := &in.FecFail, &out.FecFail
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FecSd != nil {
+		in, out := &in.FecSd, &out.FecSd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FecSf != nil {
+		in, out := &in.FecSf, &out.FecSf
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FecUncorr != nil {
+		in, out := &in.FecUncorr, &out.FecUncorr
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Loc != nil {
+		in, out := &in.Loc, &out.Loc
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Lof != nil {
+		in, out := &in.Lof, &out.Lof
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Lom != nil {
+		in, out := &in.Lom, &out.Lom
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Los != nil {
+		in, out := &in.Los, &out.Los
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OduAis != nil {
+		in, out := &in.OduAis, &out.OduAis
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OduBdi != nil {
+		in, out := &in.OduBdi, &out.OduBdi
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OduLck != nil {
+		in, out := &in.OduLck, &out.OduLck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OduOci != nil {
+		in, out := &in.OduOci, &out.OduOci
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OduTim != nil {
+		in, out := &in.OduTim, &out.OduTim
 		*out = new(bool)
 		**out = **in
 	}
-	if in.BlockNotLocked != nil {
-		in, out := &in.BlockNotLocked, &out.BlockNotLocked
+	if in.OpuPlm != nil {
+		in, out := &in.OpuPlm, &out.OpuPlm
 		*out = new(bool)
 		**out = **in
 	}
-	if in.DuplicateLane != nil {
-		in, out := &in.DuplicateLane, &out.DuplicateLane
+	if in.OtuAis != nil {
+		in, out := &in.OtuAis, &out.OtuAis
 		*out = new(bool)
 		**out = **in
 	}
-	if in.FrameNotLocked != nil {
-		in, out := &in.FrameNotLocked, &out.FrameNotLocked
+	if in.OtuBdi != nil {
+		in, out := &in.OtuBdi, &out.OtuBdi
 		*out = new(bool)
 		**out = **in
 	}
-	if in.HighBer != nil {
-		in, out := &in.HighBer, &out.HighBer
+	if in.OtuBerSd != nil {
+		in, out := &in.OtuBerSd, &out.OtuBerSd
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Local != nil {
-		in, out := &in.Local, &out.Local
+	if in.OtuBerSf != nil {
+		in, out := &in.OtuBerSf, &out.OtuBerSf
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Remote != nil {
-		in, out := &in.Remote, &out.Remote
+	if in.OtuBiae != nil {
+		in, out := &in.OtuBiae, &out.OtuBiae
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SignalFail != nil {
-		in, out := &in.SignalFail, &out.SignalFail
+	if in.OtuIae != nil {
+		in, out := &in.OtuIae, &out.OtuIae
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OtuTim != nil {
+		in, out := &in.OtuTim, &out.OtuTim
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetReportAlarm.
-func (in *ConfigurePortEthernetReportAlarm) DeepCopy() *ConfigurePortEthernetReportAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuReportAlarm.
+func (in *ConfigurePortOtuReportAlarm) DeepCopy() *ConfigurePortOtuReportAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetReportAlarm)
+	out := new(ConfigurePortOtuReportAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetSsm) DeepCopyInto(out *ConfigurePortEthernetSsm) {
+func (in *ConfigurePortOtuSectionMonitoring) DeepCopyInto(out *ConfigurePortOtuSectionMonitoring) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.CodeType != nil {
-		in, out := &in.CodeType, &out.CodeType
-		*out = new(string)
-		**out = **in
-	}
-	if in.EsmcTunnel != nil {
-		in, out := &in.EsmcTunnel, &out.EsmcTunnel
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TxDus != nil {
-		in, out := &in.TxDus, &out.TxDus
-		*out = new(bool)
-		**out = **in
+	if in.TrailTraceIdentifier != nil {
+		in, out := &in.TrailTraceIdentifier, &out.TrailTraceIdentifier
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifier)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetSsm.
-func (in *ConfigurePortEthernetSsm) DeepCopy() *ConfigurePortEthernetSsm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoring.
+func (in *ConfigurePortOtuSectionMonitoring) DeepCopy() *ConfigurePortOtuSectionMonitoring {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetSsm)
+	out := new(ConfigurePortOtuSectionMonitoring)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetSymbolMonitor) DeepCopyInto(out *ConfigurePortEthernetSymbolMonitor) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifier) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifier) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
+	if in.Expected != nil {
+		in, out := &in.Expected, &out.Expected
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.MismatchReaction != nil {
+		in, out := &in.MismatchReaction, &out.MismatchReaction
 		*out = new(string)
 		**out = **in
 	}
-	if in.SignalDegrade != nil {
-		in, out := &in.SignalDegrade, &out.SignalDegrade
-		*out = new(ConfigurePortEthernetSymbolMonitorSignalDegrade)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SignalFailure != nil {
-		in, out := &in.SignalFailure, &out.SignalFailure
-		*out = new(ConfigurePortEthernetSymbolMonitorSignalFailure)
+	if in.Transmit != nil {
+		in, out := &in.Transmit, &out.Transmit
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.WindowSize != nil {
-		in, out := &in.WindowSize, &out.WindowSize
-		*out = new(uint32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetSymbolMonitor.
-func (in *ConfigurePortEthernetSymbolMonitor) DeepCopy() *ConfigurePortEthernetSymbolMonitor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifier.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifier) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifier {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetSymbolMonitor)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifier)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetSymbolMonitorSignalDegrade) DeepCopyInto(out *ConfigurePortEthernetSymbolMonitorSignalDegrade) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected) {
 	*out = *in
-	if in.Multiplier != nil {
-		in, out := &in.Multiplier, &out.Multiplier
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Threshold != nil {
-		in, out := &in.Threshold, &out.Threshold
-		*out = new(uint32)
-		**out = **in
+	if in.Expected != nil {
+		in, out := &in.Expected, &out.Expected
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetSymbolMonitorSignalDegrade.
-func (in *ConfigurePortEthernetSymbolMonitorSignalDegrade) DeepCopy() *ConfigurePortEthernetSymbolMonitorSignalDegrade {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetSymbolMonitorSignalDegrade)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortEthernetSymbolMonitorSignalFailure) DeepCopyInto(out *ConfigurePortEthernetSymbolMonitorSignalFailure) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected) {
 	*out = *in
-	if in.Multiplier != nil {
-		in, out := &in.Multiplier, &out.Multiplier
-		*out = new(uint32)
-		**out = **in
+	if in.AutoGenerated != nil {
+		in, out := &in.AutoGenerated, &out.AutoGenerated
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Threshold != nil {
-		in, out := &in.Threshold, &out.Threshold
-		*out = new(uint32)
-		**out = **in
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.String != nil {
+		in, out := &in.String, &out.String
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortEthernetSymbolMonitorSignalFailure.
-func (in *ConfigurePortEthernetSymbolMonitorSignalFailure) DeepCopy() *ConfigurePortEthernetSymbolMonitorSignalFailure {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortEthernetSymbolMonitorSignalFailure)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortGnss) DeepCopyInto(out *ConfigurePortGnss) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) {
 	*out = *in
-	if in.AntennaCableDelay != nil {
-		in, out := &in.AntennaCableDelay, &out.AntennaCableDelay
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.AutoGenerated != nil {
+		in, out := &in.AutoGenerated, &out.AutoGenerated
 		*out = new(string)
 		**out = **in
 	}
-	if in.Constellation != nil {
-		in, out := &in.Constellation, &out.Constellation
-		*out = new(ConfigurePortGnssConstellation)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ElevationMaskAngle != nil {
-		in, out := &in.ElevationMaskAngle, &out.ElevationMaskAngle
-		*out = new(uint32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortGnss.
-func (in *ConfigurePortGnss) DeepCopy() *ConfigurePortGnss {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortGnss)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortGnssConstellation) DeepCopyInto(out *ConfigurePortGnssConstellation) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes) {
 	*out = *in
-	if in.Glonass != nil {
-		in, out := &in.Glonass, &out.Glonass
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Gps != nil {
-		in, out := &in.Gps, &out.Gps
-		*out = new(bool)
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortGnssConstellation.
-func (in *ConfigurePortGnssConstellation) DeepCopy() *ConfigurePortGnssConstellation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortGnssConstellation)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortHybridBufferAllocation) DeepCopyInto(out *ConfigurePortHybridBufferAllocation) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString) {
 	*out = *in
-	if in.EgressWeight != nil {
-		in, out := &in.EgressWeight, &out.EgressWeight
-		*out = new(ConfigurePortHybridBufferAllocationEgressWeight)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IngressWeight != nil {
-		in, out := &in.IngressWeight, &out.IngressWeight
-		*out = new(ConfigurePortHybridBufferAllocationIngressWeight)
-		(*in).DeepCopyInto(*out)
+	if in.String != nil {
+		in, out := &in.String, &out.String
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortHybridBufferAllocation.
-func (in *ConfigurePortHybridBufferAllocation) DeepCopy() *ConfigurePortHybridBufferAllocation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortHybridBufferAllocation)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortHybridBufferAllocationEgressWeight) DeepCopyInto(out *ConfigurePortHybridBufferAllocationEgressWeight) {
-	*out = *in
-	if in.Access != nil {
-		in, out := &in.Access, &out.Access
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(uint32)
-		**out = **in
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit) {
+	*out = *in
+	if in.Transmit != nil {
+		in, out := &in.Transmit, &out.Transmit
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortHybridBufferAllocationEgressWeight.
-func (in *ConfigurePortHybridBufferAllocationEgressWeight) DeepCopy() *ConfigurePortHybridBufferAllocationEgressWeight {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortHybridBufferAllocationEgressWeight)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortHybridBufferAllocationIngressWeight) DeepCopyInto(out *ConfigurePortHybridBufferAllocationIngressWeight) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit) {
 	*out = *in
-	if in.Access != nil {
-		in, out := &in.Access, &out.Access
-		*out = new(uint32)
-		**out = **in
+	if in.AutoGenerated != nil {
+		in, out := &in.AutoGenerated, &out.AutoGenerated
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(uint32)
-		**out = **in
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.String != nil {
+		in, out := &in.String, &out.String
+		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortHybridBufferAllocationIngressWeight.
-func (in *ConfigurePortHybridBufferAllocationIngressWeight) DeepCopy() *ConfigurePortHybridBufferAllocationIngressWeight {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortHybridBufferAllocationIngressWeight)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortModifyBufferAllocation) DeepCopyInto(out *ConfigurePortModifyBufferAllocation) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) {
 	*out = *in
-	if in.PercentageOfRate != nil {
-		in, out := &in.PercentageOfRate, &out.PercentageOfRate
-		*out = new(ConfigurePortModifyBufferAllocationPercentageOfRate)
-		(*in).DeepCopyInto(*out)
+	if in.AutoGenerated != nil {
+		in, out := &in.AutoGenerated, &out.AutoGenerated
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortModifyBufferAllocation.
-func (in *ConfigurePortModifyBufferAllocation) DeepCopy() *ConfigurePortModifyBufferAllocation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortModifyBufferAllocation)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortModifyBufferAllocationPercentageOfRate) DeepCopyInto(out *ConfigurePortModifyBufferAllocationPercentageOfRate) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes) {
 	*out = *in
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(uint32)
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortModifyBufferAllocationPercentageOfRate.
-func (in *ConfigurePortModifyBufferAllocationPercentageOfRate) DeepCopy() *ConfigurePortModifyBufferAllocationPercentageOfRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortModifyBufferAllocationPercentageOfRate)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortNetwork) DeepCopyInto(out *ConfigurePortNetwork) {
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.String != nil {
+		in, out := &in.String, &out.String
 		*out = new(string)
 		**out = **in
 	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortNetworkEgress)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetwork.
-func (in *ConfigurePortNetwork) DeepCopy() *ConfigurePortNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString.
+func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortNetwork)
+	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortNetworkEgress) DeepCopyInto(out *ConfigurePortNetworkEgress) {
+func (in *ConfigurePortParameters) DeepCopyInto(out *ConfigurePortParameters) {
 	*out = *in
-	if in.Pool != nil {
-		in, out := &in.Pool, &out.Pool
-		*out = make([]*ConfigurePortNetworkEgressPool, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortNetworkEgressPool)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.SrosConfigurePort != nil {
+		in, out := &in.SrosConfigurePort, &out.SrosConfigurePort
+		*out = new(ConfigurePort)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetworkEgress.
-func (in *ConfigurePortNetworkEgress) DeepCopy() *ConfigurePortNetworkEgress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortParameters.
+func (in *ConfigurePortParameters) DeepCopy() *ConfigurePortParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortNetworkEgress)
+	out := new(ConfigurePortParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortNetworkEgressPool) DeepCopyInto(out *ConfigurePortNetworkEgressPool) {
+func (in *ConfigurePortPolicy) DeepCopyInto(out *ConfigurePortPolicy) {
 	*out = *in
-	if in.AmberAlarmThreshold != nil {
-		in, out := &in.AmberAlarmThreshold, &out.AmberAlarmThreshold
-		*out = new(uint32)
-		**out = **in
-	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -6272,105 +7859,104 @@ func (in *ConfigurePortNetworkEgressPool) DeepCopyInto(out *ConfigurePortNetwork
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.RedAlarmThreshold != nil {
-		in, out := &in.RedAlarmThreshold, &out.RedAlarmThreshold
-		*out = new(uint32)
+	if in.EgressPortSchedulerPolicy != nil {
+		in, out := &in.EgressPortSchedulerPolicy, &out.EgressPortSchedulerPolicy
+		*out = new(string)
 		**out = **in
 	}
-	if in.ResvCbs != nil {
-		in, out := &in.ResvCbs, &out.ResvCbs
-		*out = new(ConfigurePortNetworkEgressPoolResvCbs)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SlopePolicy != nil {
-		in, out := &in.SlopePolicy, &out.SlopePolicy
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetworkEgressPool.
-func (in *ConfigurePortNetworkEgressPool) DeepCopy() *ConfigurePortNetworkEgressPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortPolicy.
+func (in *ConfigurePortPolicy) DeepCopy() *ConfigurePortPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortNetworkEgressPool)
+	out := new(ConfigurePortPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortNetworkEgressPoolResvCbs) DeepCopyInto(out *ConfigurePortNetworkEgressPoolResvCbs) {
+func (in *ConfigurePortPolicyObservation) DeepCopyInto(out *ConfigurePortPolicyObservation) {
 	*out = *in
-	if in.AmberAlarmAction != nil {
-		in, out := &in.AmberAlarmAction, &out.AmberAlarmAction
-		*out = new(ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Cbs != nil {
-		in, out := &in.Cbs, &out.Cbs
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetworkEgressPoolResvCbs.
-func (in *ConfigurePortNetworkEgressPoolResvCbs) DeepCopy() *ConfigurePortNetworkEgressPoolResvCbs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortPolicyObservation.
+func (in *ConfigurePortPolicyObservation) DeepCopy() *ConfigurePortPolicyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortNetworkEgressPoolResvCbs)
+	out := new(ConfigurePortPolicyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction) DeepCopyInto(out *ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction) {
+func (in *ConfigurePortPolicyParameters) DeepCopyInto(out *ConfigurePortPolicyParameters) {
 	*out = *in
-	if in.Max != nil {
-		in, out := &in.Max, &out.Max
-		*out = new(uint32)
-		**out = **in
+	if in.SrosConfigurePortPolicy != nil {
+		in, out := &in.SrosConfigurePortPolicy, &out.SrosConfigurePortPolicy
+		*out = new(ConfigurePortPolicy)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Step != nil {
-		in, out := &in.Step, &out.Step
-		*out = new(uint32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortPolicyParameters.
+func (in *ConfigurePortPolicyParameters) DeepCopy() *ConfigurePortPolicyParameters {
+	if in == nil {
+		return nil
 	}
+	out := new(ConfigurePortPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction.
-func (in *ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction) DeepCopy() *ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortPolicySpec) DeepCopyInto(out *ConfigurePortPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortPolicySpec.
+func (in *ConfigurePortPolicySpec) DeepCopy() *ConfigurePortPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortNetworkEgressPoolResvCbsAmberAlarmAction)
+	out := new(ConfigurePortPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortObservation) DeepCopyInto(out *ConfigurePortObservation) {
+func (in *ConfigurePortPolicyStatus) DeepCopyInto(out *ConfigurePortPolicyStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortObservation.
-func (in *ConfigurePortObservation) DeepCopy() *ConfigurePortObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortPolicyStatus.
+func (in *ConfigurePortPolicyStatus) DeepCopy() *ConfigurePortPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortObservation)
+	out := new(ConfigurePortPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtu) DeepCopyInto(out *ConfigurePortOtu) {
+func (in *ConfigurePortSonetSdh) DeepCopyInto(out *ConfigurePortSonetSdh) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -6382,940 +7968,893 @@ func (in *ConfigurePortOtu) DeepCopyInto(out *ConfigurePortOtu) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.AsyncMapping != nil {
-		in, out := &in.AsyncMapping, &out.AsyncMapping
-		*out = new(bool)
+	if in.ClockSource != nil {
+		in, out := &in.ClockSource, &out.ClockSource
+		*out = new(string)
 		**out = **in
 	}
-	if in.Fec != nil {
-		in, out := &in.Fec, &out.Fec
+	if in.Framing != nil {
+		in, out := &in.Framing, &out.Framing
 		*out = new(string)
 		**out = **in
 	}
-	if in.FineGranularityBer != nil {
-		in, out := &in.FineGranularityBer, &out.FineGranularityBer
-		*out = new(ConfigurePortOtuFineGranularityBer)
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]*ConfigurePortSonetSdhGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortSonetSdhGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.HoldTime != nil {
+		in, out := &in.HoldTime, &out.HoldTime
+		*out = new(ConfigurePortSonetSdhHoldTime)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Otu2LanDataRate != nil {
-		in, out := &in.Otu2LanDataRate, &out.Otu2LanDataRate
+	if in.Loopback != nil {
+		in, out := &in.Loopback, &out.Loopback
 		*out = new(string)
 		**out = **in
 	}
-	if in.PathMonitoring != nil {
-		in, out := &in.PathMonitoring, &out.PathMonitoring
-		*out = new(ConfigurePortOtuPathMonitoring)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PayloadStructureIdentifier != nil {
-		in, out := &in.PayloadStructureIdentifier, &out.PayloadStructureIdentifier
-		*out = new(ConfigurePortOtuPayloadStructureIdentifier)
-		(*in).DeepCopyInto(*out)
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = make([]*ConfigurePortSonetSdhPath, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortSonetSdhPath)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 	if in.ReportAlarm != nil {
 		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortOtuReportAlarm)
+		*out = new(ConfigurePortSonetSdhReportAlarm)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResetPortOnPathDown != nil {
+		in, out := &in.ResetPortOnPathDown, &out.ResetPortOnPathDown
+		*out = new(bool)
+		**out = **in
+	}
 	if in.SdThreshold != nil {
 		in, out := &in.SdThreshold, &out.SdThreshold
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.SectionMonitoring != nil {
-		in, out := &in.SectionMonitoring, &out.SectionMonitoring
-		*out = new(ConfigurePortOtuSectionMonitoring)
+	if in.SectionTrace != nil {
+		in, out := &in.SectionTrace, &out.SectionTrace
+		*out = new(ConfigurePortSonetSdhSectionTrace)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SfSdMethod != nil {
-		in, out := &in.SfSdMethod, &out.SfSdMethod
-		*out = new(string)
-		**out = **in
-	}
 	if in.SfThreshold != nil {
 		in, out := &in.SfThreshold, &out.SfThreshold
 		*out = new(uint32)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtu.
-func (in *ConfigurePortOtu) DeepCopy() *ConfigurePortOtu {
-	if in == nil {
-		return nil
+	if in.SingleFiber != nil {
+		in, out := &in.SingleFiber, &out.SingleFiber
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(ConfigurePortOtu)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuFineGranularityBer) DeepCopyInto(out *ConfigurePortOtuFineGranularityBer) {
-	*out = *in
-	if in.SignalDegrade != nil {
-		in, out := &in.SignalDegrade, &out.SignalDegrade
-		*out = new(ConfigurePortOtuFineGranularityBerSignalDegrade)
-		(*in).DeepCopyInto(*out)
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(string)
+		**out = **in
 	}
-	if in.SignalFailure != nil {
-		in, out := &in.SignalFailure, &out.SignalFailure
-		*out = new(ConfigurePortOtuFineGranularityBerSignalFailure)
-		(*in).DeepCopyInto(*out)
+	if in.SuppressLowOrderAlarms != nil {
+		in, out := &in.SuppressLowOrderAlarms, &out.SuppressLowOrderAlarms
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TxDus != nil {
+		in, out := &in.TxDus, &out.TxDus
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBer.
-func (in *ConfigurePortOtuFineGranularityBer) DeepCopy() *ConfigurePortOtuFineGranularityBer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdh.
+func (in *ConfigurePortSonetSdh) DeepCopy() *ConfigurePortSonetSdh {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuFineGranularityBer)
+	out := new(ConfigurePortSonetSdh)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuFineGranularityBerSignalDegrade) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalDegrade) {
+func (in *ConfigurePortSonetSdhGroup) DeepCopyInto(out *ConfigurePortSonetSdhGroup) {
 	*out = *in
-	if in.Clear != nil {
-		in, out := &in.Clear, &out.Clear
-		*out = new(ConfigurePortOtuFineGranularityBerSignalDegradeClear)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Raise != nil {
-		in, out := &in.Raise, &out.Raise
-		*out = new(ConfigurePortOtuFineGranularityBerSignalDegradeRaise)
-		(*in).DeepCopyInto(*out)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalDegrade.
-func (in *ConfigurePortOtuFineGranularityBerSignalDegrade) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalDegrade {
-	if in == nil {
-		return nil
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortOtuFineGranularityBerSignalDegrade)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuFineGranularityBerSignalDegradeClear) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalDegradeClear) {
-	*out = *in
-	if in.Multiplier != nil {
-		in, out := &in.Multiplier, &out.Multiplier
-		*out = new(uint32)
+	if in.GroupIndex != nil {
+		in, out := &in.GroupIndex, &out.GroupIndex
+		*out = new(string)
 		**out = **in
 	}
-	if in.Threshold != nil {
-		in, out := &in.Threshold, &out.Threshold
-		*out = new(uint32)
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalDegradeClear.
-func (in *ConfigurePortOtuFineGranularityBerSignalDegradeClear) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalDegradeClear {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhGroup.
+func (in *ConfigurePortSonetSdhGroup) DeepCopy() *ConfigurePortSonetSdhGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuFineGranularityBerSignalDegradeClear)
+	out := new(ConfigurePortSonetSdhGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuFineGranularityBerSignalDegradeRaise) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalDegradeRaise) {
+func (in *ConfigurePortSonetSdhHoldTime) DeepCopyInto(out *ConfigurePortSonetSdhHoldTime) {
 	*out = *in
-	if in.Multiplier != nil {
-		in, out := &in.Multiplier, &out.Multiplier
+	if in.Down != nil {
+		in, out := &in.Down, &out.Down
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Threshold != nil {
-		in, out := &in.Threshold, &out.Threshold
+	if in.Up != nil {
+		in, out := &in.Up, &out.Up
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalDegradeRaise.
-func (in *ConfigurePortOtuFineGranularityBerSignalDegradeRaise) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalDegradeRaise {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhHoldTime.
+func (in *ConfigurePortSonetSdhHoldTime) DeepCopy() *ConfigurePortSonetSdhHoldTime {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuFineGranularityBerSignalDegradeRaise)
+	out := new(ConfigurePortSonetSdhHoldTime)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuFineGranularityBerSignalFailure) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalFailure) {
+func (in *ConfigurePortSonetSdhPath) DeepCopyInto(out *ConfigurePortSonetSdhPath) {
 	*out = *in
-	if in.Clear != nil {
-		in, out := &in.Clear, &out.Clear
-		*out = new(ConfigurePortOtuFineGranularityBerSignalFailureClear)
-		(*in).DeepCopyInto(*out)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
 	}
-	if in.Raise != nil {
-		in, out := &in.Raise, &out.Raise
-		*out = new(ConfigurePortOtuFineGranularityBerSignalFailureRaise)
-		(*in).DeepCopyInto(*out)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalFailure.
-func (in *ConfigurePortOtuFineGranularityBerSignalFailure) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalFailure {
-	if in == nil {
-		return nil
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortOtuFineGranularityBerSignalFailure)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuFineGranularityBerSignalFailureClear) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalFailureClear) {
-	*out = *in
-	if in.Multiplier != nil {
-		in, out := &in.Multiplier, &out.Multiplier
+	if in.Crc != nil {
+		in, out := &in.Crc, &out.Crc
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Threshold != nil {
-		in, out := &in.Threshold, &out.Threshold
-		*out = new(uint32)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalFailureClear.
-func (in *ConfigurePortOtuFineGranularityBerSignalFailureClear) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalFailureClear {
-	if in == nil {
-		return nil
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(ConfigurePortSonetSdhPathEgress)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ConfigurePortOtuFineGranularityBerSignalFailureClear)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuFineGranularityBerSignalFailureRaise) DeepCopyInto(out *ConfigurePortOtuFineGranularityBerSignalFailureRaise) {
-	*out = *in
-	if in.Multiplier != nil {
-		in, out := &in.Multiplier, &out.Multiplier
-		*out = new(uint32)
+	if in.EncapType != nil {
+		in, out := &in.EncapType, &out.EncapType
+		*out = new(string)
 		**out = **in
 	}
-	if in.Threshold != nil {
-		in, out := &in.Threshold, &out.Threshold
-		*out = new(uint32)
+	if in.LoadBalancingAlgorithm != nil {
+		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
+		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuFineGranularityBerSignalFailureRaise.
-func (in *ConfigurePortOtuFineGranularityBerSignalFailureRaise) DeepCopy() *ConfigurePortOtuFineGranularityBerSignalFailureRaise {
-	if in == nil {
-		return nil
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortOtuFineGranularityBerSignalFailureRaise)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoring) DeepCopyInto(out *ConfigurePortOtuPathMonitoring) {
-	*out = *in
-	if in.TrailTraceIdentifier != nil {
-		in, out := &in.TrailTraceIdentifier, &out.TrailTraceIdentifier
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifier)
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mtu != nil {
+		in, out := &in.Mtu, &out.Mtu
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ConfigurePortSonetSdhPathNetwork)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoring.
-func (in *ConfigurePortOtuPathMonitoring) DeepCopy() *ConfigurePortOtuPathMonitoring {
-	if in == nil {
-		return nil
+	if in.PathIndex != nil {
+		in, out := &in.PathIndex, &out.PathIndex
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortOtuPathMonitoring)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifier) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifier) {
-	*out = *in
-	if in.Expected != nil {
-		in, out := &in.Expected, &out.Expected
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected)
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ppp != nil {
+		in, out := &in.Ppp, &out.Ppp
+		*out = new(ConfigurePortSonetSdhPathPpp)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.MismatchReaction != nil {
-		in, out := &in.MismatchReaction, &out.MismatchReaction
+	if in.ReportAlarm != nil {
+		in, out := &in.ReportAlarm, &out.ReportAlarm
+		*out = new(ConfigurePortSonetSdhPathReportAlarm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scramble != nil {
+		in, out := &in.Scramble, &out.Scramble
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SignalLabel != nil {
+		in, out := &in.SignalLabel, &out.SignalLabel
 		*out = new(string)
 		**out = **in
 	}
-	if in.Transmit != nil {
-		in, out := &in.Transmit, &out.Transmit
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit)
-		(*in).DeepCopyInto(*out)
+	if in.TraceString != nil {
+		in, out := &in.TraceString, &out.TraceString
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifier.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifier) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPath.
+func (in *ConfigurePortSonetSdhPath) DeepCopy() *ConfigurePortSonetSdhPath {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifier)
+	out := new(ConfigurePortSonetSdhPath)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected) {
+func (in *ConfigurePortSonetSdhPathEgress) DeepCopyInto(out *ConfigurePortSonetSdhPathEgress) {
 	*out = *in
-	if in.Expected != nil {
-		in, out := &in.Expected, &out.Expected
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected)
+	if in.PortSchedulerPolicy != nil {
+		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicy)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgress.
+func (in *ConfigurePortSonetSdhPathEgress) DeepCopy() *ConfigurePortSonetSdhPathEgress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpected)
+	out := new(ConfigurePortSonetSdhPathEgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicy) {
 	*out = *in
-	if in.AutoGenerated != nil {
-		in, out := &in.AutoGenerated, &out.AutoGenerated
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Bytes != nil {
-		in, out := &in.Bytes, &out.Bytes
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.String != nil {
-		in, out := &in.String, &out.String
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString)
-		(*in).DeepCopyInto(*out)
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicy.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicy) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpected)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides) {
 	*out = *in
-	if in.AutoGenerated != nil {
-		in, out := &in.AutoGenerated, &out.AutoGenerated
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated {
-	if in == nil {
-		return nil
-	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes) {
-	*out = *in
-	if in.Bytes != nil {
-		in, out := &in.Bytes, &out.Bytes
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = make([]*ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MaxRate != nil {
+		in, out := &in.MaxRate, &out.MaxRate
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedBytes)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel) {
 	*out = *in
-	if in.String != nil {
-		in, out := &in.String, &out.String
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString {
-	if in == nil {
-		return nil
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierExpectedExpectedString)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit) {
-	*out = *in
-	if in.Transmit != nil {
-		in, out := &in.Transmit, &out.Transmit
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit)
+	if in.PriorityLevel != nil {
+		in, out := &in.PriorityLevel, &out.PriorityLevel
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RateOrPercentRate != nil {
+		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmit)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
 	*out = *in
-	if in.AutoGenerated != nil {
-		in, out := &in.AutoGenerated, &out.AutoGenerated
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Bytes != nil {
-		in, out := &in.Bytes, &out.Bytes
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes)
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.String != nil {
-		in, out := &in.String, &out.String
-		*out = new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmit)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
 	*out = *in
-	if in.AutoGenerated != nil {
-		in, out := &in.AutoGenerated, &out.AutoGenerated
-		*out = new(string)
-		**out = **in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
 	*out = *in
-	if in.Bytes != nil {
-		in, out := &in.Bytes, &out.Bytes
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitBytes)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString) DeepCopyInto(out *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
 	*out = *in
-	if in.String != nil {
-		in, out := &in.String, &out.String
-		*out = new(string)
-		**out = **in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString.
-func (in *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString) DeepCopy() *ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPathMonitoringTrailTraceIdentifierTransmitTransmitString)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPayloadStructureIdentifier) DeepCopyInto(out *ConfigurePortOtuPayloadStructureIdentifier) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
 	*out = *in
-	if in.Payload != nil {
-		in, out := &in.Payload, &out.Payload
-		*out = new(ConfigurePortOtuPayloadStructureIdentifierPayload)
-		(*in).DeepCopyInto(*out)
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPayloadStructureIdentifier.
-func (in *ConfigurePortOtuPayloadStructureIdentifier) DeepCopy() *ConfigurePortOtuPayloadStructureIdentifier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPayloadStructureIdentifier)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuPayloadStructureIdentifierPayload) DeepCopyInto(out *ConfigurePortOtuPayloadStructureIdentifierPayload) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate) {
 	*out = *in
-	if in.Expected != nil {
-		in, out := &in.Expected, &out.Expected
-		*out = new(string)
-		**out = **in
-	}
-	if in.MismatchReaction != nil {
-		in, out := &in.MismatchReaction, &out.MismatchReaction
-		*out = new(string)
-		**out = **in
-	}
-	if in.Transmit != nil {
-		in, out := &in.Transmit, &out.Transmit
-		*out = new(string)
-		**out = **in
+	if in.RateOrPercentRate != nil {
+		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuPayloadStructureIdentifierPayload.
-func (in *ConfigurePortOtuPayloadStructureIdentifierPayload) DeepCopy() *ConfigurePortOtuPayloadStructureIdentifierPayload {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuPayloadStructureIdentifierPayload)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuReportAlarm) DeepCopyInto(out *ConfigurePortOtuReportAlarm) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
 	*out = *in
-	if in.FecFail != nil {
-		in, out := &in.FecFail, &out.FecFail
-		*out = new(bool)
-		**out = **in
-	}
-	if in.FecSd != nil {
-		in, out := &in.FecSd, &out.FecSd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.FecSf != nil {
-		in, out := &in.FecSf, &out.FecSf
-		*out = new(bool)
-		**out = **in
-	}
-	if in.FecUncorr != nil {
-		in, out := &in.FecUncorr, &out.FecUncorr
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Loc != nil {
-		in, out := &in.Loc, &out.Loc
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Lof != nil {
-		in, out := &in.Lof, &out.Lof
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Lom != nil {
-		in, out := &in.Lom, &out.Lom
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Los != nil {
-		in, out := &in.Los, &out.Los
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OduAis != nil {
-		in, out := &in.OduAis, &out.OduAis
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OduBdi != nil {
-		in, out := &in.OduBdi, &out.OduBdi
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OduLck != nil {
-		in, out := &in.OduLck, &out.OduLck
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OduOci != nil {
-		in, out := &in.OduOci, &out.OduOci
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OduTim != nil {
-		in, out := &in.OduTim, &out.OduTim
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OpuPlm != nil {
-		in, out := &in.OpuPlm, &out.OpuPlm
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OtuAis != nil {
-		in, out := &in.OtuAis, &out.OtuAis
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OtuBdi != nil {
-		in, out := &in.OtuBdi, &out.OtuBdi
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OtuBerSd != nil {
-		in, out := &in.OtuBerSd, &out.OtuBerSd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OtuBerSf != nil {
-		in, out := &in.OtuBerSf, &out.OtuBerSf
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OtuBiae != nil {
-		in, out := &in.OtuBiae, &out.OtuBiae
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OtuIae != nil {
-		in, out := &in.OtuIae, &out.OtuIae
-		*out = new(bool)
-		**out = **in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OtuTim != nil {
-		in, out := &in.OtuTim, &out.OtuTim
-		*out = new(bool)
-		**out = **in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuReportAlarm.
-func (in *ConfigurePortOtuReportAlarm) DeepCopy() *ConfigurePortOtuReportAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuReportAlarm)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoring) DeepCopyInto(out *ConfigurePortOtuSectionMonitoring) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
 	*out = *in
-	if in.TrailTraceIdentifier != nil {
-		in, out := &in.TrailTraceIdentifier, &out.TrailTraceIdentifier
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifier)
-		(*in).DeepCopyInto(*out)
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoring.
-func (in *ConfigurePortOtuSectionMonitoring) DeepCopy() *ConfigurePortOtuSectionMonitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoring)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifier) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifier) {
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
 	*out = *in
-	if in.Expected != nil {
-		in, out := &in.Expected, &out.Expected
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MismatchReaction != nil {
-		in, out := &in.MismatchReaction, &out.MismatchReaction
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
 		*out = new(string)
 		**out = **in
 	}
-	if in.Transmit != nil {
-		in, out := &in.Transmit, &out.Transmit
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifier.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifier) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
+func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifier)
+	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected) {
+func (in *ConfigurePortSonetSdhPathNetwork) DeepCopyInto(out *ConfigurePortSonetSdhPathNetwork) {
 	*out = *in
-	if in.Expected != nil {
-		in, out := &in.Expected, &out.Expected
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected)
-		(*in).DeepCopyInto(*out)
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QueuePolicy != nil {
+		in, out := &in.QueuePolicy, &out.QueuePolicy
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathNetwork.
+func (in *ConfigurePortSonetSdhPathNetwork) DeepCopy() *ConfigurePortSonetSdhPathNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpected)
+	out := new(ConfigurePortSonetSdhPathNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected) {
+func (in *ConfigurePortSonetSdhPathPpp) DeepCopyInto(out *ConfigurePortSonetSdhPathPpp) {
 	*out = *in
-	if in.AutoGenerated != nil {
-		in, out := &in.AutoGenerated, &out.AutoGenerated
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated)
-		(*in).DeepCopyInto(*out)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
 	}
-	if in.Bytes != nil {
-		in, out := &in.Bytes, &out.Bytes
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes)
-		(*in).DeepCopyInto(*out)
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
 	}
-	if in.String != nil {
-		in, out := &in.String, &out.String
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString)
+	if in.Keepalive != nil {
+		in, out := &in.Keepalive, &out.Keepalive
+		*out = new(ConfigurePortSonetSdhPathPppKeepalive)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathPpp.
+func (in *ConfigurePortSonetSdhPathPpp) DeepCopy() *ConfigurePortSonetSdhPathPpp {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpected)
+	out := new(ConfigurePortSonetSdhPathPpp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) {
+func (in *ConfigurePortSonetSdhPathPppKeepalive) DeepCopyInto(out *ConfigurePortSonetSdhPathPppKeepalive) {
 	*out = *in
-	if in.AutoGenerated != nil {
-		in, out := &in.AutoGenerated, &out.AutoGenerated
+	if in.DropCount != nil {
+		in, out := &in.DropCount, &out.DropCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathPppKeepalive.
+func (in *ConfigurePortSonetSdhPathPppKeepalive) DeepCopy() *ConfigurePortSonetSdhPathPppKeepalive {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedAutoGenerated)
+	out := new(ConfigurePortSonetSdhPathPppKeepalive)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes) {
+func (in *ConfigurePortSonetSdhPathReportAlarm) DeepCopyInto(out *ConfigurePortSonetSdhPathReportAlarm) {
 	*out = *in
-	if in.Bytes != nil {
-		in, out := &in.Bytes, &out.Bytes
-		*out = new(string)
+	if in.Pais != nil {
+		in, out := &in.Pais, &out.Pais
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Plcd != nil {
+		in, out := &in.Plcd, &out.Plcd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Plop != nil {
+		in, out := &in.Plop, &out.Plop
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Pplm != nil {
+		in, out := &in.Pplm, &out.Pplm
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Prdi != nil {
+		in, out := &in.Prdi, &out.Prdi
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Prei != nil {
+		in, out := &in.Prei, &out.Prei
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Puneq != nil {
+		in, out := &in.Puneq, &out.Puneq
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathReportAlarm.
+func (in *ConfigurePortSonetSdhPathReportAlarm) DeepCopy() *ConfigurePortSonetSdhPathReportAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedBytes)
+	out := new(ConfigurePortSonetSdhPathReportAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString) {
+func (in *ConfigurePortSonetSdhReportAlarm) DeepCopyInto(out *ConfigurePortSonetSdhReportAlarm) {
 	*out = *in
-	if in.String != nil {
-		in, out := &in.String, &out.String
-		*out = new(string)
+	if in.Lais != nil {
+		in, out := &in.Lais, &out.Lais
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Lb2erSd != nil {
+		in, out := &in.Lb2erSd, &out.Lb2erSd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Lb2erSf != nil {
+		in, out := &in.Lb2erSf, &out.Lb2erSf
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Loc != nil {
+		in, out := &in.Loc, &out.Loc
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Lrdi != nil {
+		in, out := &in.Lrdi, &out.Lrdi
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Lrei != nil {
+		in, out := &in.Lrei, &out.Lrei
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Slof != nil {
+		in, out := &in.Slof, &out.Slof
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Slos != nil {
+		in, out := &in.Slos, &out.Slos
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Ss1f != nil {
+		in, out := &in.Ss1f, &out.Ss1f
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhReportAlarm.
+func (in *ConfigurePortSonetSdhReportAlarm) DeepCopy() *ConfigurePortSonetSdhReportAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierExpectedExpectedString)
+	out := new(ConfigurePortSonetSdhReportAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit) {
+func (in *ConfigurePortSonetSdhSectionTrace) DeepCopyInto(out *ConfigurePortSonetSdhSectionTrace) {
 	*out = *in
-	if in.Transmit != nil {
-		in, out := &in.Transmit, &out.Transmit
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit)
+	if in.SectionTrace != nil {
+		in, out := &in.SectionTrace, &out.SectionTrace
+		*out = new(ConfigurePortSonetSdhSectionTraceSectionTrace)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTrace.
+func (in *ConfigurePortSonetSdhSectionTrace) DeepCopy() *ConfigurePortSonetSdhSectionTrace {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmit)
+	out := new(ConfigurePortSonetSdhSectionTrace)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit) {
+func (in *ConfigurePortSonetSdhSectionTraceSectionTrace) DeepCopyInto(out *ConfigurePortSonetSdhSectionTraceSectionTrace) {
 	*out = *in
-	if in.AutoGenerated != nil {
-		in, out := &in.AutoGenerated, &out.AutoGenerated
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated)
+	if in.Byte != nil {
+		in, out := &in.Byte, &out.Byte
+		*out = new(ConfigurePortSonetSdhSectionTraceSectionTraceByte)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Bytes != nil {
-		in, out := &in.Bytes, &out.Bytes
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes)
+	if in.IncrementZ0 != nil {
+		in, out := &in.IncrementZ0, &out.IncrementZ0
+		*out = new(ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.String != nil {
 		in, out := &in.String, &out.String
-		*out = new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString)
+		*out = new(ConfigurePortSonetSdhSectionTraceSectionTraceString)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTraceSectionTrace.
+func (in *ConfigurePortSonetSdhSectionTraceSectionTrace) DeepCopy() *ConfigurePortSonetSdhSectionTraceSectionTrace {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmit)
+	out := new(ConfigurePortSonetSdhSectionTraceSectionTrace)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) {
+func (in *ConfigurePortSonetSdhSectionTraceSectionTraceByte) DeepCopyInto(out *ConfigurePortSonetSdhSectionTraceSectionTraceByte) {
 	*out = *in
-	if in.AutoGenerated != nil {
-		in, out := &in.AutoGenerated, &out.AutoGenerated
+	if in.Byte != nil {
+		in, out := &in.Byte, &out.Byte
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTraceSectionTraceByte.
+func (in *ConfigurePortSonetSdhSectionTraceSectionTraceByte) DeepCopy() *ConfigurePortSonetSdhSectionTraceSectionTraceByte {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitAutoGenerated)
+	out := new(ConfigurePortSonetSdhSectionTraceSectionTraceByte)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes) {
+func (in *ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0) DeepCopyInto(out *ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0) {
 	*out = *in
-	if in.Bytes != nil {
-		in, out := &in.Bytes, &out.Bytes
+	if in.IncrementZ0 != nil {
+		in, out := &in.IncrementZ0, &out.IncrementZ0
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0.
+func (in *ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0) DeepCopy() *ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0 {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitBytes)
+	out := new(ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString) DeepCopyInto(out *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString) {
+func (in *ConfigurePortSonetSdhSectionTraceSectionTraceString) DeepCopyInto(out *ConfigurePortSonetSdhSectionTraceSectionTraceString) {
 	*out = *in
 	if in.String != nil {
 		in, out := &in.String, &out.String
@@ -7324,38 +8863,52 @@ func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitS
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString.
-func (in *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString) DeepCopy() *ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTraceSectionTraceString.
+func (in *ConfigurePortSonetSdhSectionTraceSectionTraceString) DeepCopy() *ConfigurePortSonetSdhSectionTraceSectionTraceString {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortOtuSectionMonitoringTrailTraceIdentifierTransmitTransmitString)
+	out := new(ConfigurePortSonetSdhSectionTraceSectionTraceString)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortParameters) DeepCopyInto(out *ConfigurePortParameters) {
+func (in *ConfigurePortSpec) DeepCopyInto(out *ConfigurePortSpec) {
 	*out = *in
-	if in.SrosConfigurePort != nil {
-		in, out := &in.SrosConfigurePort, &out.SrosConfigurePort
-		*out = new(ConfigurePort)
-		(*in).DeepCopyInto(*out)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSpec.
+func (in *ConfigurePortSpec) DeepCopy() *ConfigurePortSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(ConfigurePortSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortParameters.
-func (in *ConfigurePortParameters) DeepCopy() *ConfigurePortParameters {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortStatus) DeepCopyInto(out *ConfigurePortStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtNetworkNode.DeepCopyInto(&out.AtNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortStatus.
+func (in *ConfigurePortStatus) DeepCopy() *ConfigurePortStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortParameters)
+	out := new(ConfigurePortStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortPolicy) DeepCopyInto(out *ConfigurePortPolicy) {
+func (in *ConfigurePortTdm) DeepCopyInto(out *ConfigurePortTdm) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -7367,36 +8920,90 @@ func (in *ConfigurePortPolicy) DeepCopyInto(out *ConfigurePortPolicy) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.Buildout != nil {
+		in, out := &in.Buildout, &out.Buildout
 		*out = new(string)
 		**out = **in
 	}
-	if in.EgressPortSchedulerPolicy != nil {
-		in, out := &in.EgressPortSchedulerPolicy, &out.EgressPortSchedulerPolicy
+	if in.Ds1 != nil {
+		in, out := &in.Ds1, &out.Ds1
+		*out = make([]*ConfigurePortTdmDs1, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortTdmDs1)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Ds3 != nil {
+		in, out := &in.Ds3, &out.Ds3
+		*out = make([]*ConfigurePortTdmDs3, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortTdmDs3)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.E1 != nil {
+		in, out := &in.E1, &out.E1
+		*out = make([]*ConfigurePortTdmE1, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortTdmE1)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.E3 != nil {
+		in, out := &in.E3, &out.E3
+		*out = make([]*ConfigurePortTdmE3, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortTdmE3)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.HoldTime != nil {
+		in, out := &in.HoldTime, &out.HoldTime
+		*out = new(ConfigurePortTdmHoldTime)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LineImpedance != nil {
+		in, out := &in.LineImpedance, &out.LineImpedance
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortPolicy.
-func (in *ConfigurePortPolicy) DeepCopy() *ConfigurePortPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdm.
+func (in *ConfigurePortTdm) DeepCopy() *ConfigurePortTdm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortPolicy)
+	out := new(ConfigurePortTdm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdh) DeepCopyInto(out *ConfigurePortSonetSdh) {
+func (in *ConfigurePortTdmDs1) DeepCopyInto(out *ConfigurePortTdmDs1) {
 	*out = *in
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -7407,167 +9014,101 @@ func (in *ConfigurePortSonetSdh) DeepCopyInto(out *ConfigurePortSonetSdh) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClockSource != nil {
-		in, out := &in.ClockSource, &out.ClockSource
-		*out = new(string)
-		**out = **in
-	}
-	if in.Framing != nil {
-		in, out := &in.Framing, &out.Framing
-		*out = new(string)
-		**out = **in
+	if in.BerThreshold != nil {
+		in, out := &in.BerThreshold, &out.BerThreshold
+		*out = new(ConfigurePortTdmDs1BerThreshold)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = make([]*ConfigurePortSonetSdhGroup, len(*in))
+	if in.ChannelGroup != nil {
+		in, out := &in.ChannelGroup, &out.ChannelGroup
+		*out = make([]*ConfigurePortTdmDs1ChannelGroup, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortSonetSdhGroup)
+				*out = new(ConfigurePortTdmDs1ChannelGroup)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.HoldTime != nil {
-		in, out := &in.HoldTime, &out.HoldTime
-		*out = new(ConfigurePortSonetSdhHoldTime)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Loopback != nil {
-		in, out := &in.Loopback, &out.Loopback
+	if in.ClockSource != nil {
+		in, out := &in.ClockSource, &out.ClockSource
 		*out = new(string)
 		**out = **in
 	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = make([]*ConfigurePortSonetSdhPath, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortSonetSdhPath)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.ReportAlarm != nil {
-		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortSonetSdhReportAlarm)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ResetPortOnPathDown != nil {
-		in, out := &in.ResetPortOnPathDown, &out.ResetPortOnPathDown
-		*out = new(bool)
+	if in.Ds1Index != nil {
+		in, out := &in.Ds1Index, &out.Ds1Index
+		*out = new(string)
 		**out = **in
 	}
-	if in.SdThreshold != nil {
-		in, out := &in.SdThreshold, &out.SdThreshold
-		*out = new(uint32)
+	if in.Framing != nil {
+		in, out := &in.Framing, &out.Framing
+		*out = new(string)
 		**out = **in
 	}
-	if in.SectionTrace != nil {
-		in, out := &in.SectionTrace, &out.SectionTrace
-		*out = new(ConfigurePortSonetSdhSectionTrace)
+	if in.HoldTime != nil {
+		in, out := &in.HoldTime, &out.HoldTime
+		*out = new(ConfigurePortTdmDs1HoldTime)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SfThreshold != nil {
-		in, out := &in.SfThreshold, &out.SfThreshold
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.SingleFiber != nil {
-		in, out := &in.SingleFiber, &out.SingleFiber
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Speed != nil {
-		in, out := &in.Speed, &out.Speed
+	if in.Loopback != nil {
+		in, out := &in.Loopback, &out.Loopback
 		*out = new(string)
 		**out = **in
 	}
-	if in.SuppressLowOrderAlarms != nil {
-		in, out := &in.SuppressLowOrderAlarms, &out.SuppressLowOrderAlarms
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TxDus != nil {
-		in, out := &in.TxDus, &out.TxDus
+	if in.RemoteLoopRespond != nil {
+		in, out := &in.RemoteLoopRespond, &out.RemoteLoopRespond
 		*out = new(bool)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdh.
-func (in *ConfigurePortSonetSdh) DeepCopy() *ConfigurePortSonetSdh {
-	if in == nil {
-		return nil
-	}
-	out := new(ConfigurePortSonetSdh)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhGroup) DeepCopyInto(out *ConfigurePortSonetSdhGroup) {
-	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupIndex != nil {
-		in, out := &in.GroupIndex, &out.GroupIndex
-		*out = new(string)
-		**out = **in
+	if in.ReportAlarm != nil {
+		in, out := &in.ReportAlarm, &out.ReportAlarm
+		*out = new(ConfigurePortTdmDs1ReportAlarm)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Payload != nil {
-		in, out := &in.Payload, &out.Payload
+	if in.SignalMode != nil {
+		in, out := &in.SignalMode, &out.SignalMode
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhGroup.
-func (in *ConfigurePortSonetSdhGroup) DeepCopy() *ConfigurePortSonetSdhGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1.
+func (in *ConfigurePortTdmDs1) DeepCopy() *ConfigurePortTdmDs1 {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhGroup)
+	out := new(ConfigurePortTdmDs1)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhHoldTime) DeepCopyInto(out *ConfigurePortSonetSdhHoldTime) {
+func (in *ConfigurePortTdmDs1BerThreshold) DeepCopyInto(out *ConfigurePortTdmDs1BerThreshold) {
 	*out = *in
-	if in.Down != nil {
-		in, out := &in.Down, &out.Down
+	if in.SignalDegrade != nil {
+		in, out := &in.SignalDegrade, &out.SignalDegrade
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Up != nil {
-		in, out := &in.Up, &out.Up
+	if in.SignalFailure != nil {
+		in, out := &in.SignalFailure, &out.SignalFailure
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhHoldTime.
-func (in *ConfigurePortSonetSdhHoldTime) DeepCopy() *ConfigurePortSonetSdhHoldTime {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1BerThreshold.
+func (in *ConfigurePortTdmDs1BerThreshold) DeepCopy() *ConfigurePortTdmDs1BerThreshold {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhHoldTime)
+	out := new(ConfigurePortTdmDs1BerThreshold)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPath) DeepCopyInto(out *ConfigurePortSonetSdhPath) {
+func (in *ConfigurePortTdmDs1ChannelGroup) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroup) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
@@ -7594,9 +9135,14 @@ func (in *ConfigurePortSonetSdhPath) DeepCopyInto(out *ConfigurePortSonetSdhPath
 		*out = new(string)
 		**out = **in
 	}
+	if in.Ds0Index != nil {
+		in, out := &in.Ds0Index, &out.Ds0Index
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.Egress != nil {
 		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortSonetSdhPathEgress)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgress)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.EncapType != nil {
@@ -7604,6 +9150,21 @@ func (in *ConfigurePortSonetSdhPath) DeepCopyInto(out *ConfigurePortSonetSdhPath
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdleCycleFlag != nil {
+		in, out := &in.IdleCycleFlag, &out.IdleCycleFlag
+		*out = new(string)
+		**out = **in
+	}
+	if in.IdlePayloadFill != nil {
+		in, out := &in.IdlePayloadFill, &out.IdlePayloadFill
+		*out = new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFill)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdleSignalFill != nil {
+		in, out := &in.IdleSignalFill, &out.IdleSignalFill
+		*out = new(ConfigurePortTdmDs1ChannelGroupIdleSignalFill)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LoadBalancingAlgorithm != nil {
 		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
 		*out = new(string)
@@ -7626,82 +9187,62 @@ func (in *ConfigurePortSonetSdhPath) DeepCopyInto(out *ConfigurePortSonetSdhPath
 	}
 	if in.Network != nil {
 		in, out := &in.Network, &out.Network
-		*out = new(ConfigurePortSonetSdhPathNetwork)
+		*out = new(ConfigurePortTdmDs1ChannelGroupNetwork)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PathIndex != nil {
-		in, out := &in.PathIndex, &out.PathIndex
-		*out = new(string)
-		**out = **in
-	}
-	if in.Payload != nil {
-		in, out := &in.Payload, &out.Payload
-		*out = new(string)
-		**out = **in
-	}
 	if in.Ppp != nil {
 		in, out := &in.Ppp, &out.Ppp
-		*out = new(ConfigurePortSonetSdhPathPpp)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ReportAlarm != nil {
-		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortSonetSdhPathReportAlarm)
+		*out = new(ConfigurePortTdmDs1ChannelGroupPpp)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Scramble != nil {
-		in, out := &in.Scramble, &out.Scramble
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SignalLabel != nil {
-		in, out := &in.SignalLabel, &out.SignalLabel
-		*out = new(string)
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.TraceString != nil {
-		in, out := &in.TraceString, &out.TraceString
-		*out = new(string)
+	if in.Timeslot != nil {
+		in, out := &in.Timeslot, &out.Timeslot
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPath.
-func (in *ConfigurePortSonetSdhPath) DeepCopy() *ConfigurePortSonetSdhPath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroup.
+func (in *ConfigurePortTdmDs1ChannelGroup) DeepCopy() *ConfigurePortTdmDs1ChannelGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPath)
+	out := new(ConfigurePortTdmDs1ChannelGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgress) DeepCopyInto(out *ConfigurePortSonetSdhPathEgress) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgress) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgress) {
 	*out = *in
 	if in.PortSchedulerPolicy != nil {
 		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicy)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgress.
-func (in *ConfigurePortSonetSdhPathEgress) DeepCopy() *ConfigurePortSonetSdhPathEgress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgress.
+func (in *ConfigurePortTdmDs1ChannelGroupEgress) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgress)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicy) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy) {
 	*out = *in
 	if in.Overrides != nil {
 		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PolicyName != nil {
@@ -7711,18 +9252,18 @@ func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicy) DeepCopyInto(out *
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicy.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicy) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicy)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -7736,34 +9277,34 @@ func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides) DeepCopyI
 	}
 	if in.Level != nil {
 		in, out := &in.Level, &out.Level
-		*out = make([]*ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel, len(*in))
+		*out = make([]*ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel)
+				*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 	if in.MaxRate != nil {
 		in, out := &in.MaxRate, &out.MaxRate
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverrides)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -7782,68 +9323,68 @@ func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel) Deep
 	}
 	if in.RateOrPercentRate != nil {
 		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevel)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
 	*out = *in
 	if in.PercentRate != nil {
 		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Rate != nil {
 		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
 	*out = *in
 	if in.PercentRate != nil {
 		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
 	*out = *in
 	if in.Cir != nil {
 		in, out := &in.Cir, &out.Cir
@@ -7857,38 +9398,38 @@ func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOr
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
 	*out = *in
 	if in.Rate != nil {
 		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
 	*out = *in
 	if in.Cir != nil {
 		in, out := &in.Cir, &out.Cir
@@ -7902,63 +9443,63 @@ func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOr
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) {
 	*out = *in
 	if in.RateOrPercentRate != nil {
 		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRate)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
 	*out = *in
 	if in.PercentRate != nil {
 		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Rate != nil {
 		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
 	*out = *in
 	if in.PercentRate != nil {
 		in, out := &in.PercentRate, &out.PercentRate
@@ -7967,388 +9508,214 @@ func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRate
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
 	*out = *in
 	if in.Rate != nil {
 		in, out := &in.Rate, &out.Rate
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
-func (in *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
-	if in == nil {
-		return nil
-	}
-	out := new(ConfigurePortSonetSdhPathEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathNetwork) DeepCopyInto(out *ConfigurePortSonetSdhPathNetwork) {
-	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
-	}
-	if in.QueuePolicy != nil {
-		in, out := &in.QueuePolicy, &out.QueuePolicy
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathNetwork.
-func (in *ConfigurePortSonetSdhPathNetwork) DeepCopy() *ConfigurePortSonetSdhPathNetwork {
-	if in == nil {
-		return nil
-	}
-	out := new(ConfigurePortSonetSdhPathNetwork)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathPpp) DeepCopyInto(out *ConfigurePortSonetSdhPathPpp) {
-	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.Keepalive != nil {
-		in, out := &in.Keepalive, &out.Keepalive
-		*out = new(ConfigurePortSonetSdhPathPppKeepalive)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathPpp.
-func (in *ConfigurePortSonetSdhPathPpp) DeepCopy() *ConfigurePortSonetSdhPathPpp {
-	if in == nil {
-		return nil
-	}
-	out := new(ConfigurePortSonetSdhPathPpp)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathPppKeepalive) DeepCopyInto(out *ConfigurePortSonetSdhPathPppKeepalive) {
-	*out = *in
-	if in.DropCount != nil {
-		in, out := &in.DropCount, &out.DropCount
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathPppKeepalive.
-func (in *ConfigurePortSonetSdhPathPppKeepalive) DeepCopy() *ConfigurePortSonetSdhPathPppKeepalive {
-	if in == nil {
-		return nil
-	}
-	out := new(ConfigurePortSonetSdhPathPppKeepalive)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhPathReportAlarm) DeepCopyInto(out *ConfigurePortSonetSdhPathReportAlarm) {
-	*out = *in
-	if in.Pais != nil {
-		in, out := &in.Pais, &out.Pais
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Plcd != nil {
-		in, out := &in.Plcd, &out.Plcd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Plop != nil {
-		in, out := &in.Plop, &out.Plop
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Pplm != nil {
-		in, out := &in.Pplm, &out.Pplm
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Prdi != nil {
-		in, out := &in.Prdi, &out.Prdi
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Prei != nil {
-		in, out := &in.Prei, &out.Prei
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Puneq != nil {
-		in, out := &in.Puneq, &out.Puneq
-		*out = new(bool)
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhPathReportAlarm.
-func (in *ConfigurePortSonetSdhPathReportAlarm) DeepCopy() *ConfigurePortSonetSdhPathReportAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
+func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhPathReportAlarm)
+	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhReportAlarm) DeepCopyInto(out *ConfigurePortSonetSdhReportAlarm) {
+func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFill) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdlePayloadFill) {
 	*out = *in
-	if in.Lais != nil {
-		in, out := &in.Lais, &out.Lais
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Lb2erSd != nil {
-		in, out := &in.Lb2erSd, &out.Lb2erSd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Lb2erSf != nil {
-		in, out := &in.Lb2erSf, &out.Lb2erSf
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Loc != nil {
-		in, out := &in.Loc, &out.Loc
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Lrdi != nil {
-		in, out := &in.Lrdi, &out.Lrdi
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Lrei != nil {
-		in, out := &in.Lrei, &out.Lrei
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Slof != nil {
-		in, out := &in.Slof, &out.Slof
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Slos != nil {
-		in, out := &in.Slos, &out.Slos
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Ss1f != nil {
-		in, out := &in.Ss1f, &out.Ss1f
-		*out = new(bool)
-		**out = **in
+	if in.IdlePayloadFillChoice != nil {
+		in, out := &in.IdlePayloadFillChoice, &out.IdlePayloadFillChoice
+		*out = new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhReportAlarm.
-func (in *ConfigurePortSonetSdhReportAlarm) DeepCopy() *ConfigurePortSonetSdhReportAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdlePayloadFill.
+func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFill) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdlePayloadFill {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhReportAlarm)
+	out := new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFill)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhSectionTrace) DeepCopyInto(out *ConfigurePortSonetSdhSectionTrace) {
+func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) {
 	*out = *in
-	if in.SectionTrace != nil {
-		in, out := &in.SectionTrace, &out.SectionTrace
-		*out = new(ConfigurePortSonetSdhSectionTraceSectionTrace)
+	if in.AllOnes != nil {
+		in, out := &in.AllOnes, &out.AllOnes
+		*out = new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTrace.
-func (in *ConfigurePortSonetSdhSectionTrace) DeepCopy() *ConfigurePortSonetSdhSectionTrace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice.
+func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhSectionTrace)
+	out := new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhSectionTraceSectionTrace) DeepCopyInto(out *ConfigurePortSonetSdhSectionTraceSectionTrace) {
+func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) {
 	*out = *in
-	if in.Byte != nil {
-		in, out := &in.Byte, &out.Byte
-		*out = new(ConfigurePortSonetSdhSectionTraceSectionTraceByte)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IncrementZ0 != nil {
-		in, out := &in.IncrementZ0, &out.IncrementZ0
-		*out = new(ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.String != nil {
-		in, out := &in.String, &out.String
-		*out = new(ConfigurePortSonetSdhSectionTraceSectionTraceString)
-		(*in).DeepCopyInto(*out)
+	if in.AllOnes != nil {
+		in, out := &in.AllOnes, &out.AllOnes
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTraceSectionTrace.
-func (in *ConfigurePortSonetSdhSectionTraceSectionTrace) DeepCopy() *ConfigurePortSonetSdhSectionTraceSectionTrace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes.
+func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhSectionTraceSectionTrace)
+	out := new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhSectionTraceSectionTraceByte) DeepCopyInto(out *ConfigurePortSonetSdhSectionTraceSectionTraceByte) {
+func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) {
 	*out = *in
-	if in.Byte != nil {
-		in, out := &in.Byte, &out.Byte
-		*out = new(string)
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTraceSectionTraceByte.
-func (in *ConfigurePortSonetSdhSectionTraceSectionTraceByte) DeepCopy() *ConfigurePortSonetSdhSectionTraceSectionTraceByte {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern.
+func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhSectionTraceSectionTraceByte)
+	out := new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0) DeepCopyInto(out *ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0) {
+func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFill) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdleSignalFill) {
 	*out = *in
-	if in.IncrementZ0 != nil {
-		in, out := &in.IncrementZ0, &out.IncrementZ0
-		*out = new(string)
-		**out = **in
+	if in.IdleSignalFillChoice != nil {
+		in, out := &in.IdleSignalFillChoice, &out.IdleSignalFillChoice
+		*out = new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0.
-func (in *ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0) DeepCopy() *ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdleSignalFill.
+func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFill) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdleSignalFill {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhSectionTraceSectionTraceIncrementZ0)
+	out := new(ConfigurePortTdmDs1ChannelGroupIdleSignalFill)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSonetSdhSectionTraceSectionTraceString) DeepCopyInto(out *ConfigurePortSonetSdhSectionTraceSectionTraceString) {
+func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice) {
 	*out = *in
-	if in.String != nil {
-		in, out := &in.String, &out.String
-		*out = new(string)
-		**out = **in
+	if in.AllOnes != nil {
+		in, out := &in.AllOnes, &out.AllOnes
+		*out = new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSonetSdhSectionTraceSectionTraceString.
-func (in *ConfigurePortSonetSdhSectionTraceSectionTraceString) DeepCopy() *ConfigurePortSonetSdhSectionTraceSectionTraceString {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice.
+func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSonetSdhSectionTraceSectionTraceString)
+	out := new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortSpec) DeepCopyInto(out *ConfigurePortSpec) {
+func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	if in.AllOnes != nil {
+		in, out := &in.AllOnes, &out.AllOnes
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortSpec.
-func (in *ConfigurePortSpec) DeepCopy() *ConfigurePortSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes.
+func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortSpec)
+	out := new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortStatus) DeepCopyInto(out *ConfigurePortStatus) {
+func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortStatus.
-func (in *ConfigurePortStatus) DeepCopy() *ConfigurePortStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern.
+func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortStatus)
+	out := new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdm) DeepCopyInto(out *ConfigurePortTdm) {
+func (in *ConfigurePortTdmDs1ChannelGroupNetwork) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupNetwork) {
 	*out = *in
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -8359,90 +9726,31 @@ func (in *ConfigurePortTdm) DeepCopyInto(out *ConfigurePortTdm) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Buildout != nil {
-		in, out := &in.Buildout, &out.Buildout
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ds1 != nil {
-		in, out := &in.Ds1, &out.Ds1
-		*out = make([]*ConfigurePortTdmDs1, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmDs1)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Ds3 != nil {
-		in, out := &in.Ds3, &out.Ds3
-		*out = make([]*ConfigurePortTdmDs3, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmDs3)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.E1 != nil {
-		in, out := &in.E1, &out.E1
-		*out = make([]*ConfigurePortTdmE1, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmE1)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.E3 != nil {
-		in, out := &in.E3, &out.E3
-		*out = make([]*ConfigurePortTdmE3, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmE3)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Encoding != nil {
-		in, out := &in.Encoding, &out.Encoding
-		*out = new(string)
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
 		**out = **in
 	}
-	if in.HoldTime != nil {
-		in, out := &in.HoldTime, &out.HoldTime
-		*out = new(ConfigurePortTdmHoldTime)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LineImpedance != nil {
-		in, out := &in.LineImpedance, &out.LineImpedance
-		*out = new(uint32)
+	if in.QueuePolicy != nil {
+		in, out := &in.QueuePolicy, &out.QueuePolicy
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdm.
-func (in *ConfigurePortTdm) DeepCopy() *ConfigurePortTdm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupNetwork.
+func (in *ConfigurePortTdmDs1ChannelGroupNetwork) DeepCopy() *ConfigurePortTdmDs1ChannelGroupNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdm)
+	out := new(ConfigurePortTdmDs1ChannelGroupNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1) DeepCopyInto(out *ConfigurePortTdmDs1) {
+func (in *ConfigurePortTdmDs1ChannelGroupPpp) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupPpp) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -8453,101 +9761,160 @@ func (in *ConfigurePortTdmDs1) DeepCopyInto(out *ConfigurePortTdmDs1) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.BerThreshold != nil {
-		in, out := &in.BerThreshold, &out.BerThreshold
-		*out = new(ConfigurePortTdmDs1BerThreshold)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ChannelGroup != nil {
-		in, out := &in.ChannelGroup, &out.ChannelGroup
-		*out = make([]*ConfigurePortTdmDs1ChannelGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmDs1ChannelGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.ClockSource != nil {
-		in, out := &in.ClockSource, &out.ClockSource
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ds1Index != nil {
-		in, out := &in.Ds1Index, &out.Ds1Index
-		*out = new(string)
+	if in.BerSfLinkDown != nil {
+		in, out := &in.BerSfLinkDown, &out.BerSfLinkDown
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Framing != nil {
-		in, out := &in.Framing, &out.Framing
-		*out = new(string)
-		**out = **in
+	if in.Compress != nil {
+		in, out := &in.Compress, &out.Compress
+		*out = new(ConfigurePortTdmDs1ChannelGroupPppCompress)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.HoldTime != nil {
-		in, out := &in.HoldTime, &out.HoldTime
-		*out = new(ConfigurePortTdmDs1HoldTime)
+	if in.Keepalive != nil {
+		in, out := &in.Keepalive, &out.Keepalive
+		*out = new(ConfigurePortTdmDs1ChannelGroupPppKeepalive)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Loopback != nil {
-		in, out := &in.Loopback, &out.Loopback
-		*out = new(string)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupPpp.
+func (in *ConfigurePortTdmDs1ChannelGroupPpp) DeepCopy() *ConfigurePortTdmDs1ChannelGroupPpp {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmDs1ChannelGroupPpp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs1ChannelGroupPppCompress) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupPppCompress) {
+	*out = *in
+	if in.Acfc != nil {
+		in, out := &in.Acfc, &out.Acfc
+		*out = new(bool)
 		**out = **in
 	}
-	if in.RemoteLoopRespond != nil {
-		in, out := &in.RemoteLoopRespond, &out.RemoteLoopRespond
+	if in.Pfc != nil {
+		in, out := &in.Pfc, &out.Pfc
 		*out = new(bool)
 		**out = **in
 	}
-	if in.ReportAlarm != nil {
-		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortTdmDs1ReportAlarm)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupPppCompress.
+func (in *ConfigurePortTdmDs1ChannelGroupPppCompress) DeepCopy() *ConfigurePortTdmDs1ChannelGroupPppCompress {
+	if in == nil {
+		return nil
 	}
-	if in.SignalMode != nil {
-		in, out := &in.SignalMode, &out.SignalMode
+	out := new(ConfigurePortTdmDs1ChannelGroupPppCompress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs1ChannelGroupPppKeepalive) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupPppKeepalive) {
+	*out = *in
+	if in.DropCount != nil {
+		in, out := &in.DropCount, &out.DropCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1.
-func (in *ConfigurePortTdmDs1) DeepCopy() *ConfigurePortTdmDs1 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupPppKeepalive.
+func (in *ConfigurePortTdmDs1ChannelGroupPppKeepalive) DeepCopy() *ConfigurePortTdmDs1ChannelGroupPppKeepalive {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1)
+	out := new(ConfigurePortTdmDs1ChannelGroupPppKeepalive)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1BerThreshold) DeepCopyInto(out *ConfigurePortTdmDs1BerThreshold) {
+func (in *ConfigurePortTdmDs1HoldTime) DeepCopyInto(out *ConfigurePortTdmDs1HoldTime) {
 	*out = *in
-	if in.SignalDegrade != nil {
-		in, out := &in.SignalDegrade, &out.SignalDegrade
+	if in.Down != nil {
+		in, out := &in.Down, &out.Down
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.SignalFailure != nil {
-		in, out := &in.SignalFailure, &out.SignalFailure
+	if in.Up != nil {
+		in, out := &in.Up, &out.Up
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1BerThreshold.
-func (in *ConfigurePortTdmDs1BerThreshold) DeepCopy() *ConfigurePortTdmDs1BerThreshold {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1HoldTime.
+func (in *ConfigurePortTdmDs1HoldTime) DeepCopy() *ConfigurePortTdmDs1HoldTime {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1BerThreshold)
+	out := new(ConfigurePortTdmDs1HoldTime)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroup) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroup) {
+func (in *ConfigurePortTdmDs1ReportAlarm) DeepCopyInto(out *ConfigurePortTdmDs1ReportAlarm) {
+	*out = *in
+	if in.Ais != nil {
+		in, out := &in.Ais, &out.Ais
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BerSd != nil {
+		in, out := &in.BerSd, &out.BerSd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BerSf != nil {
+		in, out := &in.BerSf, &out.BerSf
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Looped != nil {
+		in, out := &in.Looped, &out.Looped
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Los != nil {
+		in, out := &in.Los, &out.Los
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Oof != nil {
+		in, out := &in.Oof, &out.Oof
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Rai != nil {
+		in, out := &in.Rai, &out.Rai
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ReportAlarm.
+func (in *ConfigurePortTdmDs1ReportAlarm) DeepCopy() *ConfigurePortTdmDs1ReportAlarm {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmDs1ReportAlarm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs3) DeepCopyInto(out *ConfigurePortTdmDs3) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
@@ -8564,6 +9931,16 @@ func (in *ConfigurePortTdmDs1ChannelGroup) DeepCopyInto(out *ConfigurePortTdmDs1
 		*out = new(string)
 		**out = **in
 	}
+	if in.Channelized != nil {
+		in, out := &in.Channelized, &out.Channelized
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClockSource != nil {
+		in, out := &in.ClockSource, &out.ClockSource
+		*out = new(string)
+		**out = **in
+	}
 	if in.Crc != nil {
 		in, out := &in.Crc, &out.Crc
 		*out = new(uint32)
@@ -8574,14 +9951,14 @@ func (in *ConfigurePortTdmDs1ChannelGroup) DeepCopyInto(out *ConfigurePortTdmDs1
 		*out = new(string)
 		**out = **in
 	}
-	if in.Ds0Index != nil {
-		in, out := &in.Ds0Index, &out.Ds0Index
-		*out = new(uint32)
+	if in.Ds3Index != nil {
+		in, out := &in.Ds3Index, &out.Ds3Index
+		*out = new(string)
 		**out = **in
 	}
 	if in.Egress != nil {
 		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgress)
+		*out = new(ConfigurePortTdmDs3Egress)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.EncapType != nil {
@@ -8589,31 +9966,41 @@ func (in *ConfigurePortTdmDs1ChannelGroup) DeepCopyInto(out *ConfigurePortTdmDs1
 		*out = new(string)
 		**out = **in
 	}
+	if in.FeacLoopRespond != nil {
+		in, out := &in.FeacLoopRespond, &out.FeacLoopRespond
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Framing != nil {
+		in, out := &in.Framing, &out.Framing
+		*out = new(string)
+		**out = **in
+	}
 	if in.IdleCycleFlag != nil {
 		in, out := &in.IdleCycleFlag, &out.IdleCycleFlag
 		*out = new(string)
 		**out = **in
 	}
-	if in.IdlePayloadFill != nil {
-		in, out := &in.IdlePayloadFill, &out.IdlePayloadFill
-		*out = new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFill)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IdleSignalFill != nil {
-		in, out := &in.IdleSignalFill, &out.IdleSignalFill
-		*out = new(ConfigurePortTdmDs1ChannelGroupIdleSignalFill)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.LoadBalancingAlgorithm != nil {
 		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
 		*out = new(string)
 		**out = **in
 	}
+	if in.Loopback != nil {
+		in, out := &in.Loopback, &out.Loopback
+		*out = new(string)
+		**out = **in
+	}
 	if in.MacAddress != nil {
 		in, out := &in.MacAddress, &out.MacAddress
 		*out = new(string)
 		**out = **in
 	}
+	if in.MaintenanceDataLink != nil {
+		in, out := &in.MaintenanceDataLink, &out.MaintenanceDataLink
+		*out = new(ConfigurePortTdmDs3MaintenanceDataLink)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Mode != nil {
 		in, out := &in.Mode, &out.Mode
 		*out = new(string)
@@ -8626,62 +10013,67 @@ func (in *ConfigurePortTdmDs1ChannelGroup) DeepCopyInto(out *ConfigurePortTdmDs1
 	}
 	if in.Network != nil {
 		in, out := &in.Network, &out.Network
-		*out = new(ConfigurePortTdmDs1ChannelGroupNetwork)
+		*out = new(ConfigurePortTdmDs3Network)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Ppp != nil {
 		in, out := &in.Ppp, &out.Ppp
-		*out = new(ConfigurePortTdmDs1ChannelGroupPpp)
+		*out = new(ConfigurePortTdmDs3Ppp)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Speed != nil {
-		in, out := &in.Speed, &out.Speed
-		*out = new(uint32)
-		**out = **in
+	if in.ReportAlarm != nil {
+		in, out := &in.ReportAlarm, &out.ReportAlarm
+		*out = new(ConfigurePortTdmDs3ReportAlarm)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Timeslot != nil {
-		in, out := &in.Timeslot, &out.Timeslot
-		*out = new(uint32)
+	if in.Scramble != nil {
+		in, out := &in.Scramble, &out.Scramble
+		*out = new(bool)
 		**out = **in
 	}
+	if in.Subrate != nil {
+		in, out := &in.Subrate, &out.Subrate
+		*out = new(ConfigurePortTdmDs3Subrate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroup.
-func (in *ConfigurePortTdmDs1ChannelGroup) DeepCopy() *ConfigurePortTdmDs1ChannelGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3.
+func (in *ConfigurePortTdmDs3) DeepCopy() *ConfigurePortTdmDs3 {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroup)
+	out := new(ConfigurePortTdmDs3)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgress) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgress) {
+func (in *ConfigurePortTdmDs3Egress) DeepCopyInto(out *ConfigurePortTdmDs3Egress) {
 	*out = *in
 	if in.PortSchedulerPolicy != nil {
 		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy)
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicy)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgress.
-func (in *ConfigurePortTdmDs1ChannelGroupEgress) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3Egress.
+func (in *ConfigurePortTdmDs3Egress) DeepCopy() *ConfigurePortTdmDs3Egress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgress)
+	out := new(ConfigurePortTdmDs3Egress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy) {
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicy) {
 	*out = *in
 	if in.Overrides != nil {
 		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides)
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PolicyName != nil {
@@ -8691,18 +10083,18 @@ func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy) DeepCopyInto
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicy.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicy) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicy)
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides) {
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -8716,34 +10108,34 @@ func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides) Dee
 	}
 	if in.Level != nil {
 		in, out := &in.Level, &out.Level
-		*out = make([]*ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel, len(*in))
+		*out = make([]*ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel)
+				*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 	if in.MaxRate != nil {
 		in, out := &in.MaxRate, &out.MaxRate
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate)
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverrides)
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) {
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -8762,399 +10154,735 @@ func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel
 	}
 	if in.RateOrPercentRate != nil {
 		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevel)
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
 	*out = *in
 	if in.PercentRate != nil {
 		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Rate != nil {
 		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
 	*out = *in
 	if in.PercentRate != nil {
 		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
+	*out = *in
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
+	*out = *in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
 	*out = *in
 	if in.Cir != nil {
 		in, out := &in.Cir, &out.Cir
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate) {
+	*out = *in
+	if in.RateOrPercentRate != nil {
+		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
+	*out = *in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
+	*out = *in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
+	*out = *in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
+func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmDs3MaintenanceDataLink) DeepCopyInto(out *ConfigurePortTdmDs3MaintenanceDataLink) {
+	*out = *in
+	if in.EquipmentIdCode != nil {
+		in, out := &in.EquipmentIdCode, &out.EquipmentIdCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.FacilityIdCode != nil {
+		in, out := &in.FacilityIdCode, &out.FacilityIdCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.FrameIdCode != nil {
+		in, out := &in.FrameIdCode, &out.FrameIdCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.GeneratorString != nil {
+		in, out := &in.GeneratorString, &out.GeneratorString
+		*out = new(string)
+		**out = **in
+	}
+	if in.LocationIdCode != nil {
+		in, out := &in.LocationIdCode, &out.LocationIdCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.PortString != nil {
+		in, out := &in.PortString, &out.PortString
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransmitMessageType != nil {
+		in, out := &in.TransmitMessageType, &out.TransmitMessageType
+		*out = new(ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnitIdCode != nil {
+		in, out := &in.UnitIdCode, &out.UnitIdCode
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3MaintenanceDataLink.
+func (in *ConfigurePortTdmDs3MaintenanceDataLink) DeepCopy() *ConfigurePortTdmDs3MaintenanceDataLink {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+	out := new(ConfigurePortTdmDs3MaintenanceDataLink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
+func (in *ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType) DeepCopyInto(out *ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
-		(*in).DeepCopyInto(*out)
+	if in.IdleSignal != nil {
+		in, out := &in.IdleSignal, &out.IdleSignal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TestSignal != nil {
+		in, out := &in.TestSignal, &out.TestSignal
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType.
+func (in *ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType) DeepCopy() *ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	out := new(ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
+func (in *ConfigurePortTdmDs3Network) DeepCopyInto(out *ConfigurePortTdmDs3Network) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
-	if in == nil {
-		return nil
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) {
-	*out = *in
-	if in.RateOrPercentRate != nil {
-		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QueuePolicy != nil {
+		in, out := &in.QueuePolicy, &out.QueuePolicy
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3Network.
+func (in *ConfigurePortTdmDs3Network) DeepCopy() *ConfigurePortTdmDs3Network {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate)
+	out := new(ConfigurePortTdmDs3Network)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
+func (in *ConfigurePortTdmDs3Ppp) DeepCopyInto(out *ConfigurePortTdmDs3Ppp) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Keepalive != nil {
+		in, out := &in.Keepalive, &out.Keepalive
+		*out = new(ConfigurePortTdmDs3PppKeepalive)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3Ppp.
+func (in *ConfigurePortTdmDs3Ppp) DeepCopy() *ConfigurePortTdmDs3Ppp {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+	out := new(ConfigurePortTdmDs3Ppp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
+func (in *ConfigurePortTdmDs3PppKeepalive) DeepCopyInto(out *ConfigurePortTdmDs3PppKeepalive) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
+	if in.DropCount != nil {
+		in, out := &in.DropCount, &out.DropCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3PppKeepalive.
+func (in *ConfigurePortTdmDs3PppKeepalive) DeepCopy() *ConfigurePortTdmDs3PppKeepalive {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
+	out := new(ConfigurePortTdmDs3PppKeepalive)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
+func (in *ConfigurePortTdmDs3ReportAlarm) DeepCopyInto(out *ConfigurePortTdmDs3ReportAlarm) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
+	if in.Ais != nil {
+		in, out := &in.Ais, &out.Ais
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Looped != nil {
+		in, out := &in.Looped, &out.Looped
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Los != nil {
+		in, out := &in.Los, &out.Los
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Oof != nil {
+		in, out := &in.Oof, &out.Oof
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Rai != nil {
+		in, out := &in.Rai, &out.Rai
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
-func (in *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3ReportAlarm.
+func (in *ConfigurePortTdmDs3ReportAlarm) DeepCopy() *ConfigurePortTdmDs3ReportAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+	out := new(ConfigurePortTdmDs3ReportAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFill) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdlePayloadFill) {
+func (in *ConfigurePortTdmDs3Subrate) DeepCopyInto(out *ConfigurePortTdmDs3Subrate) {
 	*out = *in
-	if in.IdlePayloadFillChoice != nil {
-		in, out := &in.IdlePayloadFillChoice, &out.IdlePayloadFillChoice
-		*out = new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice)
-		(*in).DeepCopyInto(*out)
+	if in.CsuMode != nil {
+		in, out := &in.CsuMode, &out.CsuMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.RateStep != nil {
+		in, out := &in.RateStep, &out.RateStep
+		*out = new(uint32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdlePayloadFill.
-func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFill) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdlePayloadFill {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3Subrate.
+func (in *ConfigurePortTdmDs3Subrate) DeepCopy() *ConfigurePortTdmDs3Subrate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFill)
+	out := new(ConfigurePortTdmDs3Subrate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) {
+func (in *ConfigurePortTdmE1) DeepCopyInto(out *ConfigurePortTdmE1) {
 	*out = *in
-	if in.AllOnes != nil {
-		in, out := &in.AllOnes, &out.AllOnes
-		*out = new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.BerThreshold != nil {
+		in, out := &in.BerThreshold, &out.BerThreshold
+		*out = new(ConfigurePortTdmE1BerThreshold)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Pattern != nil {
-		in, out := &in.Pattern, &out.Pattern
-		*out = new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern)
+	if in.ChannelGroup != nil {
+		in, out := &in.ChannelGroup, &out.ChannelGroup
+		*out = make([]*ConfigurePortTdmE1ChannelGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortTdmE1ChannelGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ClockSource != nil {
+		in, out := &in.ClockSource, &out.ClockSource
+		*out = new(string)
+		**out = **in
+	}
+	if in.E1Index != nil {
+		in, out := &in.E1Index, &out.E1Index
+		*out = new(string)
+		**out = **in
+	}
+	if in.Framing != nil {
+		in, out := &in.Framing, &out.Framing
+		*out = new(string)
+		**out = **in
+	}
+	if in.HoldTime != nil {
+		in, out := &in.HoldTime, &out.HoldTime
+		*out = new(ConfigurePortTdmE1HoldTime)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice.
-func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice {
-	if in == nil {
-		return nil
+	if in.Loopback != nil {
+		in, out := &in.Loopback, &out.Loopback
+		*out = new(string)
+		**out = **in
+	}
+	if in.NationalBits != nil {
+		in, out := &in.NationalBits, &out.NationalBits
+		*out = new(ConfigurePortTdmE1NationalBits)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoice)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) {
-	*out = *in
-	if in.AllOnes != nil {
-		in, out := &in.AllOnes, &out.AllOnes
+	if in.ReportAlarm != nil {
+		in, out := &in.ReportAlarm, &out.ReportAlarm
+		*out = new(ConfigurePortTdmE1ReportAlarm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SignalMode != nil {
+		in, out := &in.SignalMode, &out.SignalMode
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes.
-func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1.
+func (in *ConfigurePortTdmE1) DeepCopy() *ConfigurePortTdmE1 {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes)
+	out := new(ConfigurePortTdmE1)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) {
+func (in *ConfigurePortTdmE1BerThreshold) DeepCopyInto(out *ConfigurePortTdmE1BerThreshold) {
 	*out = *in
-	if in.Pattern != nil {
-		in, out := &in.Pattern, &out.Pattern
+	if in.SignalDegrade != nil {
+		in, out := &in.SignalDegrade, &out.SignalDegrade
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SignalFailure != nil {
+		in, out := &in.SignalFailure, &out.SignalFailure
 		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern.
-func (in *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1BerThreshold.
+func (in *ConfigurePortTdmE1BerThreshold) DeepCopy() *ConfigurePortTdmE1BerThreshold {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern)
+	out := new(ConfigurePortTdmE1BerThreshold)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFill) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdleSignalFill) {
+func (in *ConfigurePortTdmE1ChannelGroup) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroup) {
 	*out = *in
-	if in.IdleSignalFillChoice != nil {
-		in, out := &in.IdleSignalFillChoice, &out.IdleSignalFillChoice
-		*out = new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice)
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.Crc != nil {
+		in, out := &in.Crc, &out.Crc
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ds0Index != nil {
+		in, out := &in.Ds0Index, &out.Ds0Index
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(ConfigurePortTdmE1ChannelGroupEgress)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdleSignalFill.
-func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFill) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdleSignalFill {
-	if in == nil {
-		return nil
+	if in.EncapType != nil {
+		in, out := &in.EncapType, &out.EncapType
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupIdleSignalFill)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice) {
-	*out = *in
-	if in.AllOnes != nil {
-		in, out := &in.AllOnes, &out.AllOnes
-		*out = new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes)
+	if in.IdleCycleFlag != nil {
+		in, out := &in.IdleCycleFlag, &out.IdleCycleFlag
+		*out = new(string)
+		**out = **in
+	}
+	if in.IdlePayloadFill != nil {
+		in, out := &in.IdlePayloadFill, &out.IdlePayloadFill
+		*out = new(ConfigurePortTdmE1ChannelGroupIdlePayloadFill)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Pattern != nil {
-		in, out := &in.Pattern, &out.Pattern
-		*out = new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern)
+	if in.IdleSignalFill != nil {
+		in, out := &in.IdleSignalFill, &out.IdleSignalFill
+		*out = new(ConfigurePortTdmE1ChannelGroupIdleSignalFill)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancingAlgorithm != nil {
+		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mtu != nil {
+		in, out := &in.Mtu, &out.Mtu
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ConfigurePortTdmE1ChannelGroupNetwork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ppp != nil {
+		in, out := &in.Ppp, &out.Ppp
+		*out = new(ConfigurePortTdmE1ChannelGroupPpp)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Timeslot != nil {
+		in, out := &in.Timeslot, &out.Timeslot
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice.
-func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroup.
+func (in *ConfigurePortTdmE1ChannelGroup) DeepCopy() *ConfigurePortTdmE1ChannelGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoice)
+	out := new(ConfigurePortTdmE1ChannelGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) {
+func (in *ConfigurePortTdmE1ChannelGroupEgress) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgress) {
 	*out = *in
-	if in.AllOnes != nil {
-		in, out := &in.AllOnes, &out.AllOnes
-		*out = new(string)
-		**out = **in
+	if in.PortSchedulerPolicy != nil {
+		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes.
-func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgress.
+func (in *ConfigurePortTdmE1ChannelGroupEgress) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes)
+	out := new(ConfigurePortTdmE1ChannelGroupEgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy) {
 	*out = *in
-	if in.Pattern != nil {
-		in, out := &in.Pattern, &out.Pattern
-		*out = new(uint32)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern.
-func (in *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) DeepCopy() *ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupNetwork) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupNetwork) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides) {
 	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
-		*out = new(string)
-		**out = **in
-	}
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -9165,30 +10893,36 @@ func (in *ConfigurePortTdmDs1ChannelGroupNetwork) DeepCopyInto(out *ConfigurePor
 		*out = new(string)
 		**out = **in
 	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = make([]*ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.QueuePolicy != nil {
-		in, out := &in.QueuePolicy, &out.QueuePolicy
-		*out = new(string)
-		**out = **in
+	if in.MaxRate != nil {
+		in, out := &in.MaxRate, &out.MaxRate
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupNetwork.
-func (in *ConfigurePortTdmDs1ChannelGroupNetwork) DeepCopy() *ConfigurePortTdmDs1ChannelGroupNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupNetwork)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupPpp) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupPpp) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -9200,707 +10934,806 @@ func (in *ConfigurePortTdmDs1ChannelGroupPpp) DeepCopyInto(out *ConfigurePortTdm
 		*out = new(string)
 		**out = **in
 	}
-	if in.BerSfLinkDown != nil {
-		in, out := &in.BerSfLinkDown, &out.BerSfLinkDown
-		*out = new(bool)
+	if in.PriorityLevel != nil {
+		in, out := &in.PriorityLevel, &out.PriorityLevel
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.Compress != nil {
-		in, out := &in.Compress, &out.Compress
-		*out = new(ConfigurePortTdmDs1ChannelGroupPppCompress)
+	if in.RateOrPercentRate != nil {
+		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
+	*out = *in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Keepalive != nil {
-		in, out := &in.Keepalive, &out.Keepalive
-		*out = new(ConfigurePortTdmDs1ChannelGroupPppKeepalive)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupPpp.
-func (in *ConfigurePortTdmDs1ChannelGroupPpp) DeepCopy() *ConfigurePortTdmDs1ChannelGroupPpp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupPpp)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupPppCompress) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupPppCompress) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
 	*out = *in
-	if in.Acfc != nil {
-		in, out := &in.Acfc, &out.Acfc
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Pfc != nil {
-		in, out := &in.Pfc, &out.Pfc
-		*out = new(bool)
-		**out = **in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupPppCompress.
-func (in *ConfigurePortTdmDs1ChannelGroupPppCompress) DeepCopy() *ConfigurePortTdmDs1ChannelGroupPppCompress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupPppCompress)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ChannelGroupPppKeepalive) DeepCopyInto(out *ConfigurePortTdmDs1ChannelGroupPppKeepalive) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
 	*out = *in
-	if in.DropCount != nil {
-		in, out := &in.DropCount, &out.DropCount
-		*out = new(uint32)
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
 		**out = **in
 	}
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ChannelGroupPppKeepalive.
-func (in *ConfigurePortTdmDs1ChannelGroupPppKeepalive) DeepCopy() *ConfigurePortTdmDs1ChannelGroupPppKeepalive {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ChannelGroupPppKeepalive)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1HoldTime) DeepCopyInto(out *ConfigurePortTdmDs1HoldTime) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
 	*out = *in
-	if in.Down != nil {
-		in, out := &in.Down, &out.Down
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Up != nil {
-		in, out := &in.Up, &out.Up
-		*out = new(uint32)
-		**out = **in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1HoldTime.
-func (in *ConfigurePortTdmDs1HoldTime) DeepCopy() *ConfigurePortTdmDs1HoldTime {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1HoldTime)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs1ReportAlarm) DeepCopyInto(out *ConfigurePortTdmDs1ReportAlarm) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
 	*out = *in
-	if in.Ais != nil {
-		in, out := &in.Ais, &out.Ais
-		*out = new(bool)
-		**out = **in
-	}
-	if in.BerSd != nil {
-		in, out := &in.BerSd, &out.BerSd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.BerSf != nil {
-		in, out := &in.BerSf, &out.BerSf
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Looped != nil {
-		in, out := &in.Looped, &out.Looped
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Los != nil {
-		in, out := &in.Los, &out.Los
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Oof != nil {
-		in, out := &in.Oof, &out.Oof
-		*out = new(bool)
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
+		*out = new(string)
 		**out = **in
 	}
-	if in.Rai != nil {
-		in, out := &in.Rai, &out.Rai
-		*out = new(bool)
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs1ReportAlarm.
-func (in *ConfigurePortTdmDs1ReportAlarm) DeepCopy() *ConfigurePortTdmDs1ReportAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs1ReportAlarm)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3) DeepCopyInto(out *ConfigurePortTdmDs3) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.Channelized != nil {
-		in, out := &in.Channelized, &out.Channelized
-		*out = new(string)
-		**out = **in
-	}
-	if in.ClockSource != nil {
-		in, out := &in.ClockSource, &out.ClockSource
-		*out = new(string)
-		**out = **in
-	}
-	if in.Crc != nil {
-		in, out := &in.Crc, &out.Crc
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ds3Index != nil {
-		in, out := &in.Ds3Index, &out.Ds3Index
-		*out = new(string)
-		**out = **in
-	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortTdmDs3Egress)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EncapType != nil {
-		in, out := &in.EncapType, &out.EncapType
-		*out = new(string)
-		**out = **in
-	}
-	if in.FeacLoopRespond != nil {
-		in, out := &in.FeacLoopRespond, &out.FeacLoopRespond
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Framing != nil {
-		in, out := &in.Framing, &out.Framing
-		*out = new(string)
-		**out = **in
-	}
-	if in.IdleCycleFlag != nil {
-		in, out := &in.IdleCycleFlag, &out.IdleCycleFlag
-		*out = new(string)
-		**out = **in
-	}
-	if in.LoadBalancingAlgorithm != nil {
-		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
-		*out = new(string)
-		**out = **in
-	}
-	if in.Loopback != nil {
-		in, out := &in.Loopback, &out.Loopback
-		*out = new(string)
-		**out = **in
-	}
-	if in.MacAddress != nil {
-		in, out := &in.MacAddress, &out.MacAddress
-		*out = new(string)
-		**out = **in
-	}
-	if in.MaintenanceDataLink != nil {
-		in, out := &in.MaintenanceDataLink, &out.MaintenanceDataLink
-		*out = new(ConfigurePortTdmDs3MaintenanceDataLink)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
-		*out = new(string)
-		**out = **in
-	}
-	if in.Mtu != nil {
-		in, out := &in.Mtu, &out.Mtu
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(ConfigurePortTdmDs3Network)
+	if in.RateOrPercentRate != nil {
+		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Ppp != nil {
-		in, out := &in.Ppp, &out.Ppp
-		*out = new(ConfigurePortTdmDs3Ppp)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate {
+	if in == nil {
+		return nil
 	}
-	if in.ReportAlarm != nil {
-		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortTdmDs3ReportAlarm)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
+	*out = *in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Scramble != nil {
-		in, out := &in.Scramble, &out.Scramble
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Subrate != nil {
-		in, out := &in.Subrate, &out.Subrate
-		*out = new(ConfigurePortTdmDs3Subrate)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3.
-func (in *ConfigurePortTdmDs3) DeepCopy() *ConfigurePortTdmDs3 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3Egress) DeepCopyInto(out *ConfigurePortTdmDs3Egress) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
 	*out = *in
-	if in.PortSchedulerPolicy != nil {
-		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicy)
-		(*in).DeepCopyInto(*out)
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3Egress.
-func (in *ConfigurePortTdmDs3Egress) DeepCopy() *ConfigurePortTdmDs3Egress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3Egress)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicy) {
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
 	*out = *in
-	if in.Overrides != nil {
-		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PolicyName != nil {
-		in, out := &in.PolicyName, &out.PolicyName
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicy.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicy) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
+func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicy)
+	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides) {
+func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFill) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdlePayloadFill) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
+	if in.IdlePayloadFillChoice != nil {
+		in, out := &in.IdlePayloadFillChoice, &out.IdlePayloadFillChoice
+		*out = new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdlePayloadFill.
+func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFill) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdlePayloadFill {
+	if in == nil {
+		return nil
 	}
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = make([]*ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	out := new(ConfigurePortTdmE1ChannelGroupIdlePayloadFill)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) {
+	*out = *in
+	if in.AllOnes != nil {
+		in, out := &in.AllOnes, &out.AllOnes
+		*out = new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MaxRate != nil {
-		in, out := &in.MaxRate, &out.MaxRate
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate)
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice.
+func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverrides)
+	out := new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel) {
+func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
+	if in.AllOnes != nil {
+		in, out := &in.AllOnes, &out.AllOnes
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes.
+func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes {
+	if in == nil {
+		return nil
 	}
-	if in.PriorityLevel != nil {
-		in, out := &in.PriorityLevel, &out.PriorityLevel
+	out := new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) {
+	*out = *in
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.RateOrPercentRate != nil {
-		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern.
+func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFill) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdleSignalFill) {
+	*out = *in
+	if in.IdleSignalFillChoice != nil {
+		in, out := &in.IdleSignalFillChoice, &out.IdleSignalFillChoice
+		*out = new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdleSignalFill.
+func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFill) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdleSignalFill {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevel)
+	out := new(ConfigurePortTdmE1ChannelGroupIdleSignalFill)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
+func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+	if in.AllOnes != nil {
+		in, out := &in.AllOnes, &out.AllOnes
+		*out = new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice.
+func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+	out := new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
+func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.AllOnes != nil {
+		in, out := &in.AllOnes, &out.AllOnes
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes.
+func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+	out := new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
+func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern.
+func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE1ChannelGroupNetwork) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupNetwork) {
+	*out = *in
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QueuePolicy != nil {
+		in, out := &in.QueuePolicy, &out.QueuePolicy
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupNetwork.
+func (in *ConfigurePortTdmE1ChannelGroupNetwork) DeepCopy() *ConfigurePortTdmE1ChannelGroupNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+	out := new(ConfigurePortTdmE1ChannelGroupNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
+func (in *ConfigurePortTdmE1ChannelGroupPpp) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupPpp) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
+	}
+	if in.BerSfLinkDown != nil {
+		in, out := &in.BerSfLinkDown, &out.BerSfLinkDown
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Compress != nil {
+		in, out := &in.Compress, &out.Compress
+		*out = new(ConfigurePortTdmE1ChannelGroupPppCompress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Keepalive != nil {
+		in, out := &in.Keepalive, &out.Keepalive
+		*out = new(ConfigurePortTdmE1ChannelGroupPppKeepalive)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupPpp.
+func (in *ConfigurePortTdmE1ChannelGroupPpp) DeepCopy() *ConfigurePortTdmE1ChannelGroupPpp {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	out := new(ConfigurePortTdmE1ChannelGroupPpp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
+func (in *ConfigurePortTdmE1ChannelGroupPppCompress) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupPppCompress) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
-		*out = new(string)
+	if in.Acfc != nil {
+		in, out := &in.Acfc, &out.Acfc
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
-		*out = new(string)
+	if in.Pfc != nil {
+		in, out := &in.Pfc, &out.Pfc
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupPppCompress.
+func (in *ConfigurePortTdmE1ChannelGroupPppCompress) DeepCopy() *ConfigurePortTdmE1ChannelGroupPppCompress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	out := new(ConfigurePortTdmE1ChannelGroupPppCompress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate) {
+func (in *ConfigurePortTdmE1ChannelGroupPppKeepalive) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupPppKeepalive) {
 	*out = *in
-	if in.RateOrPercentRate != nil {
-		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.DropCount != nil {
+		in, out := &in.DropCount, &out.DropCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupPppKeepalive.
+func (in *ConfigurePortTdmE1ChannelGroupPppKeepalive) DeepCopy() *ConfigurePortTdmE1ChannelGroupPppKeepalive {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRate)
+	out := new(ConfigurePortTdmE1ChannelGroupPppKeepalive)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
+func (in *ConfigurePortTdmE1HoldTime) DeepCopyInto(out *ConfigurePortTdmE1HoldTime) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.Down != nil {
+		in, out := &in.Down, &out.Down
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
-		(*in).DeepCopyInto(*out)
+	if in.Up != nil {
+		in, out := &in.Up, &out.Up
+		*out = new(uint32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1HoldTime.
+func (in *ConfigurePortTdmE1HoldTime) DeepCopy() *ConfigurePortTdmE1HoldTime {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+	out := new(ConfigurePortTdmE1HoldTime)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
+func (in *ConfigurePortTdmE1NationalBits) DeepCopyInto(out *ConfigurePortTdmE1NationalBits) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(string)
+	if in.Sa4 != nil {
+		in, out := &in.Sa4, &out.Sa4
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sa5 != nil {
+		in, out := &in.Sa5, &out.Sa5
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sa6 != nil {
+		in, out := &in.Sa6, &out.Sa6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sa7 != nil {
+		in, out := &in.Sa7, &out.Sa7
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sa8 != nil {
+		in, out := &in.Sa8, &out.Sa8
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1NationalBits.
+func (in *ConfigurePortTdmE1NationalBits) DeepCopy() *ConfigurePortTdmE1NationalBits {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
+	out := new(ConfigurePortTdmE1NationalBits)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
+func (in *ConfigurePortTdmE1ReportAlarm) DeepCopyInto(out *ConfigurePortTdmE1ReportAlarm) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
+	if in.Ais != nil {
+		in, out := &in.Ais, &out.Ais
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BerSd != nil {
+		in, out := &in.BerSd, &out.BerSd
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BerSf != nil {
+		in, out := &in.BerSf, &out.BerSf
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Looped != nil {
+		in, out := &in.Looped, &out.Looped
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Los != nil {
+		in, out := &in.Los, &out.Los
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Oof != nil {
+		in, out := &in.Oof, &out.Oof
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Rai != nil {
+		in, out := &in.Rai, &out.Rai
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
-func (in *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ReportAlarm.
+func (in *ConfigurePortTdmE1ReportAlarm) DeepCopy() *ConfigurePortTdmE1ReportAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+	out := new(ConfigurePortTdmE1ReportAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3MaintenanceDataLink) DeepCopyInto(out *ConfigurePortTdmDs3MaintenanceDataLink) {
+func (in *ConfigurePortTdmE3) DeepCopyInto(out *ConfigurePortTdmE3) {
 	*out = *in
-	if in.EquipmentIdCode != nil {
-		in, out := &in.EquipmentIdCode, &out.EquipmentIdCode
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.FacilityIdCode != nil {
-		in, out := &in.FacilityIdCode, &out.FacilityIdCode
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-	if in.FrameIdCode != nil {
-		in, out := &in.FrameIdCode, &out.FrameIdCode
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
-	if in.GeneratorString != nil {
-		in, out := &in.GeneratorString, &out.GeneratorString
+	if in.ClockSource != nil {
+		in, out := &in.ClockSource, &out.ClockSource
 		*out = new(string)
 		**out = **in
 	}
-	if in.LocationIdCode != nil {
-		in, out := &in.LocationIdCode, &out.LocationIdCode
+	if in.Crc != nil {
+		in, out := &in.Crc, &out.Crc
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.PortString != nil {
-		in, out := &in.PortString, &out.PortString
+	if in.E3Index != nil {
+		in, out := &in.E3Index, &out.E3Index
 		*out = new(string)
 		**out = **in
 	}
-	if in.TransmitMessageType != nil {
-		in, out := &in.TransmitMessageType, &out.TransmitMessageType
-		*out = new(ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType)
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(ConfigurePortTdmE3Egress)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.UnitIdCode != nil {
-		in, out := &in.UnitIdCode, &out.UnitIdCode
+	if in.EncapType != nil {
+		in, out := &in.EncapType, &out.EncapType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Framing != nil {
+		in, out := &in.Framing, &out.Framing
+		*out = new(string)
+		**out = **in
+	}
+	if in.IdleCycleFlag != nil {
+		in, out := &in.IdleCycleFlag, &out.IdleCycleFlag
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoadBalancingAlgorithm != nil {
+		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.Loopback != nil {
+		in, out := &in.Loopback, &out.Loopback
+		*out = new(string)
+		**out = **in
+	}
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
 		*out = new(string)
 		**out = **in
 	}
+	if in.Mtu != nil {
+		in, out := &in.Mtu, &out.Mtu
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ConfigurePortTdmE3Network)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ppp != nil {
+		in, out := &in.Ppp, &out.Ppp
+		*out = new(ConfigurePortTdmE3Ppp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReportAlarm != nil {
+		in, out := &in.ReportAlarm, &out.ReportAlarm
+		*out = new(ConfigurePortTdmE3ReportAlarm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scramble != nil {
+		in, out := &in.Scramble, &out.Scramble
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3MaintenanceDataLink.
-func (in *ConfigurePortTdmDs3MaintenanceDataLink) DeepCopy() *ConfigurePortTdmDs3MaintenanceDataLink {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3.
+func (in *ConfigurePortTdmE3) DeepCopy() *ConfigurePortTdmE3 {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3MaintenanceDataLink)
+	out := new(ConfigurePortTdmE3)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType) DeepCopyInto(out *ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType) {
+func (in *ConfigurePortTdmE3Egress) DeepCopyInto(out *ConfigurePortTdmE3Egress) {
 	*out = *in
-	if in.IdleSignal != nil {
-		in, out := &in.IdleSignal, &out.IdleSignal
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TestSignal != nil {
-		in, out := &in.TestSignal, &out.TestSignal
-		*out = new(bool)
-		**out = **in
+	if in.PortSchedulerPolicy != nil {
+		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType.
-func (in *ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType) DeepCopy() *ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3Egress.
+func (in *ConfigurePortTdmE3Egress) DeepCopy() *ConfigurePortTdmE3Egress {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3MaintenanceDataLinkTransmitMessageType)
+	out := new(ConfigurePortTdmE3Egress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3Network) DeepCopyInto(out *ConfigurePortTdmDs3Network) {
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicy) {
 	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
 		*out = new(string)
 		**out = **in
 	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicy.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicy) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides) {
+	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
@@ -9911,30 +11744,36 @@ func (in *ConfigurePortTdmDs3Network) DeepCopyInto(out *ConfigurePortTdmDs3Netwo
 		*out = new(string)
 		**out = **in
 	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = make([]*ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.QueuePolicy != nil {
-		in, out := &in.QueuePolicy, &out.QueuePolicy
-		*out = new(string)
-		**out = **in
+	if in.MaxRate != nil {
+		in, out := &in.MaxRate, &out.MaxRate
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3Network.
-func (in *ConfigurePortTdmDs3Network) DeepCopy() *ConfigurePortTdmDs3Network {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3Network)
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3Ppp) DeepCopyInto(out *ConfigurePortTdmDs3Ppp) {
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -9946,229 +11785,233 @@ func (in *ConfigurePortTdmDs3Ppp) DeepCopyInto(out *ConfigurePortTdmDs3Ppp) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Keepalive != nil {
-		in, out := &in.Keepalive, &out.Keepalive
-		*out = new(ConfigurePortTdmDs3PppKeepalive)
+	if in.PriorityLevel != nil {
+		in, out := &in.PriorityLevel, &out.PriorityLevel
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RateOrPercentRate != nil {
+		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3Ppp.
-func (in *ConfigurePortTdmDs3Ppp) DeepCopy() *ConfigurePortTdmDs3Ppp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3Ppp)
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3PppKeepalive) DeepCopyInto(out *ConfigurePortTdmDs3PppKeepalive) {
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
 	*out = *in
-	if in.DropCount != nil {
-		in, out := &in.DropCount, &out.DropCount
-		*out = new(uint32)
-		**out = **in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(string)
-		**out = **in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3PppKeepalive.
-func (in *ConfigurePortTdmDs3PppKeepalive) DeepCopy() *ConfigurePortTdmDs3PppKeepalive {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3PppKeepalive)
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3ReportAlarm) DeepCopyInto(out *ConfigurePortTdmDs3ReportAlarm) {
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
 	*out = *in
-	if in.Ais != nil {
-		in, out := &in.Ais, &out.Ais
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Looped != nil {
-		in, out := &in.Looped, &out.Looped
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Los != nil {
-		in, out := &in.Los, &out.Los
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Oof != nil {
-		in, out := &in.Oof, &out.Oof
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Rai != nil {
-		in, out := &in.Rai, &out.Rai
-		*out = new(bool)
-		**out = **in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3ReportAlarm.
-func (in *ConfigurePortTdmDs3ReportAlarm) DeepCopy() *ConfigurePortTdmDs3ReportAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3ReportAlarm)
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmDs3Subrate) DeepCopyInto(out *ConfigurePortTdmDs3Subrate) {
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
 	*out = *in
-	if in.CsuMode != nil {
-		in, out := &in.CsuMode, &out.CsuMode
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
 		*out = new(string)
 		**out = **in
 	}
-	if in.RateStep != nil {
-		in, out := &in.RateStep, &out.RateStep
-		*out = new(uint32)
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmDs3Subrate.
-func (in *ConfigurePortTdmDs3Subrate) DeepCopy() *ConfigurePortTdmDs3Subrate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmDs3Subrate)
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1) DeepCopyInto(out *ConfigurePortTdmE1) {
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.BerThreshold != nil {
-		in, out := &in.BerThreshold, &out.BerThreshold
-		*out = new(ConfigurePortTdmE1BerThreshold)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ChannelGroup != nil {
-		in, out := &in.ChannelGroup, &out.ChannelGroup
-		*out = make([]*ConfigurePortTdmE1ChannelGroup, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmE1ChannelGroup)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
+	if in == nil {
+		return nil
 	}
-	if in.ClockSource != nil {
-		in, out := &in.ClockSource, &out.ClockSource
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
+	*out = *in
+	if in.Cir != nil {
+		in, out := &in.Cir, &out.Cir
 		*out = new(string)
 		**out = **in
 	}
-	if in.E1Index != nil {
-		in, out := &in.E1Index, &out.E1Index
+	if in.Pir != nil {
+		in, out := &in.Pir, &out.Pir
 		*out = new(string)
 		**out = **in
 	}
-	if in.Framing != nil {
-		in, out := &in.Framing, &out.Framing
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
+	if in == nil {
+		return nil
 	}
-	if in.HoldTime != nil {
-		in, out := &in.HoldTime, &out.HoldTime
-		*out = new(ConfigurePortTdmE1HoldTime)
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate) {
+	*out = *in
+	if in.RateOrPercentRate != nil {
+		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Loopback != nil {
-		in, out := &in.Loopback, &out.Loopback
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate {
+	if in == nil {
+		return nil
 	}
-	if in.NationalBits != nil {
-		in, out := &in.NationalBits, &out.NationalBits
-		*out = new(ConfigurePortTdmE1NationalBits)
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
+	*out = *in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ReportAlarm != nil {
-		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortTdmE1ReportAlarm)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SignalMode != nil {
-		in, out := &in.SignalMode, &out.SignalMode
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
+	*out = *in
+	if in.PercentRate != nil {
+		in, out := &in.PercentRate, &out.PercentRate
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1.
-func (in *ConfigurePortTdmE1) DeepCopy() *ConfigurePortTdmE1 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1)
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1BerThreshold) DeepCopyInto(out *ConfigurePortTdmE1BerThreshold) {
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
 	*out = *in
-	if in.SignalDegrade != nil {
-		in, out := &in.SignalDegrade, &out.SignalDegrade
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.SignalFailure != nil {
-		in, out := &in.SignalFailure, &out.SignalFailure
-		*out = new(uint32)
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1BerThreshold.
-func (in *ConfigurePortTdmE1BerThreshold) DeepCopy() *ConfigurePortTdmE1BerThreshold {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
+func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1BerThreshold)
+	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroup) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroup) {
+func (in *ConfigurePortTdmE3Network) DeepCopyInto(out *ConfigurePortTdmE3Network) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
+	if in.AccountingPolicy != nil {
+		in, out := &in.AccountingPolicy, &out.AccountingPolicy
 		*out = new(string)
 		**out = **in
 	}
@@ -10182,145 +12025,150 @@ func (in *ConfigurePortTdmE1ChannelGroup) DeepCopyInto(out *ConfigurePortTdmE1Ch
 		*out = new(string)
 		**out = **in
 	}
-	if in.Crc != nil {
-		in, out := &in.Crc, &out.Crc
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Ds0Index != nil {
-		in, out := &in.Ds0Index, &out.Ds0Index
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortTdmE1ChannelGroupEgress)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EncapType != nil {
-		in, out := &in.EncapType, &out.EncapType
-		*out = new(string)
+	if in.CollectStats != nil {
+		in, out := &in.CollectStats, &out.CollectStats
+		*out = new(bool)
 		**out = **in
 	}
-	if in.IdleCycleFlag != nil {
-		in, out := &in.IdleCycleFlag, &out.IdleCycleFlag
+	if in.QueuePolicy != nil {
+		in, out := &in.QueuePolicy, &out.QueuePolicy
 		*out = new(string)
 		**out = **in
 	}
-	if in.IdlePayloadFill != nil {
-		in, out := &in.IdlePayloadFill, &out.IdlePayloadFill
-		*out = new(ConfigurePortTdmE1ChannelGroupIdlePayloadFill)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IdleSignalFill != nil {
-		in, out := &in.IdleSignalFill, &out.IdleSignalFill
-		*out = new(ConfigurePortTdmE1ChannelGroupIdleSignalFill)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LoadBalancingAlgorithm != nil {
-		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3Network.
+func (in *ConfigurePortTdmE3Network) DeepCopy() *ConfigurePortTdmE3Network {
+	if in == nil {
+		return nil
 	}
-	if in.MacAddress != nil {
-		in, out := &in.MacAddress, &out.MacAddress
+	out := new(ConfigurePortTdmE3Network)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE3Ppp) DeepCopyInto(out *ConfigurePortTdmE3Ppp) {
+	*out = *in
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
-	if in.Mtu != nil {
-		in, out := &in.Mtu, &out.Mtu
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(ConfigurePortTdmE1ChannelGroupNetwork)
+	if in.Keepalive != nil {
+		in, out := &in.Keepalive, &out.Keepalive
+		*out = new(ConfigurePortTdmE3PppKeepalive)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Ppp != nil {
-		in, out := &in.Ppp, &out.Ppp
-		*out = new(ConfigurePortTdmE1ChannelGroupPpp)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3Ppp.
+func (in *ConfigurePortTdmE3Ppp) DeepCopy() *ConfigurePortTdmE3Ppp {
+	if in == nil {
+		return nil
 	}
-	if in.Speed != nil {
-		in, out := &in.Speed, &out.Speed
+	out := new(ConfigurePortTdmE3Ppp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurePortTdmE3PppKeepalive) DeepCopyInto(out *ConfigurePortTdmE3PppKeepalive) {
+	*out = *in
+	if in.DropCount != nil {
+		in, out := &in.DropCount, &out.DropCount
 		*out = new(uint32)
 		**out = **in
 	}
-	if in.Timeslot != nil {
-		in, out := &in.Timeslot, &out.Timeslot
-		*out = new(uint32)
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroup.
-func (in *ConfigurePortTdmE1ChannelGroup) DeepCopy() *ConfigurePortTdmE1ChannelGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3PppKeepalive.
+func (in *ConfigurePortTdmE3PppKeepalive) DeepCopy() *ConfigurePortTdmE3PppKeepalive {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroup)
+	out := new(ConfigurePortTdmE3PppKeepalive)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgress) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgress) {
+func (in *ConfigurePortTdmE3ReportAlarm) DeepCopyInto(out *ConfigurePortTdmE3ReportAlarm) {
 	*out = *in
-	if in.PortSchedulerPolicy != nil {
-		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy)
-		(*in).DeepCopyInto(*out)
+	if in.Ais != nil {
+		in, out := &in.Ais, &out.Ais
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Looped != nil {
+		in, out := &in.Looped, &out.Looped
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Los != nil {
+		in, out := &in.Los, &out.Los
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Oof != nil {
+		in, out := &in.Oof, &out.Oof
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Rai != nil {
+		in, out := &in.Rai, &out.Rai
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgress.
-func (in *ConfigurePortTdmE1ChannelGroupEgress) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3ReportAlarm.
+func (in *ConfigurePortTdmE3ReportAlarm) DeepCopy() *ConfigurePortTdmE3ReportAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgress)
+	out := new(ConfigurePortTdmE3ReportAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy) {
+func (in *ConfigurePortTdmHoldTime) DeepCopyInto(out *ConfigurePortTdmHoldTime) {
 	*out = *in
-	if in.Overrides != nil {
-		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides)
-		(*in).DeepCopyInto(*out)
+	if in.Down != nil {
+		in, out := &in.Down, &out.Down
+		*out = new(uint32)
+		**out = **in
 	}
-	if in.PolicyName != nil {
-		in, out := &in.PolicyName, &out.PolicyName
-		*out = new(string)
+	if in.Up != nil {
+		in, out := &in.Up, &out.Up
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmHoldTime.
+func (in *ConfigurePortTdmHoldTime) DeepCopy() *ConfigurePortTdmHoldTime {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicy)
+	out := new(ConfigurePortTdmHoldTime)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides) {
+func (in *ConfigurePortTransceiver) DeepCopyInto(out *ConfigurePortTransceiver) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -10332,36 +12180,25 @@ func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides) Deep
 		*out = new(string)
 		**out = **in
 	}
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = make([]*ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.MaxRate != nil {
-		in, out := &in.MaxRate, &out.MaxRate
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate)
-		(*in).DeepCopyInto(*out)
+	if in.DigitalCoherentOptics != nil {
+		in, out := &in.DigitalCoherentOptics, &out.DigitalCoherentOptics
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTransceiver.
+func (in *ConfigurePortTransceiver) DeepCopy() *ConfigurePortTransceiver {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverrides)
+	out := new(ConfigurePortTransceiver)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) {
+func (in *ConfigurePortXc) DeepCopyInto(out *ConfigurePortXc) {
 	*out = *in
 	if in.ApplyGroups != nil {
 		in, out := &in.ApplyGroups, &out.ApplyGroups
@@ -10373,645 +12210,667 @@ func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel)
 		*out = new(string)
 		**out = **in
 	}
-	if in.PriorityLevel != nil {
-		in, out := &in.PriorityLevel, &out.PriorityLevel
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.RateOrPercentRate != nil {
-		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.Pxc != nil {
+		in, out := &in.Pxc, &out.Pxc
+		*out = make([]*ConfigurePortXcPxc, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigurePortXcPxc)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortXc.
+func (in *ConfigurePortXc) DeepCopy() *ConfigurePortXc {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevel)
+	out := new(ConfigurePortXc)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
+func (in *ConfigurePortXcObservation) DeepCopyInto(out *ConfigurePortXcObservation) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortXcObservation.
+func (in *ConfigurePortXcObservation) DeepCopy() *ConfigurePortXcObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+	out := new(ConfigurePortXcObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
+func (in *ConfigurePortXcParameters) DeepCopyInto(out *ConfigurePortXcParameters) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+	if in.SrosConfigurePortXc != nil {
+		in, out := &in.SrosConfigurePortXc, &out.SrosConfigurePortXc
+		*out = new(ConfigurePortXc)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortXcParameters.
+func (in *ConfigurePortXcParameters) DeepCopy() *ConfigurePortXcParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+	out := new(ConfigurePortXcParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
+func (in *ConfigurePortXcPxc) DeepCopyInto(out *ConfigurePortXcPxc) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
-	if in == nil {
-		return nil
-	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
-	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
-	if in == nil {
-		return nil
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
-	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.PortId != nil {
+		in, out := &in.PortId, &out.PortId
 		*out = new(string)
 		**out = **in
 	}
+	if in.PxcId != nil {
+		in, out := &in.PxcId, &out.PxcId
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortXcPxc.
+func (in *ConfigurePortXcPxc) DeepCopy() *ConfigurePortXcPxc {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	out := new(ConfigurePortXcPxc)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) {
+func (in *ConfigurePortXcSpec) DeepCopyInto(out *ConfigurePortXcSpec) {
 	*out = *in
-	if in.RateOrPercentRate != nil {
-		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortXcSpec.
+func (in *ConfigurePortXcSpec) DeepCopy() *ConfigurePortXcSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRate)
+	out := new(ConfigurePortXcSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
+func (in *ConfigurePortXcStatus) DeepCopyInto(out *ConfigurePortXcStatus) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortXcStatus.
+func (in *ConfigurePortXcStatus) DeepCopy() *ConfigurePortXcStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+	out := new(ConfigurePortXcStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
+func (in *ConfigureRouterBgp) DeepCopyInto(out *ConfigureRouterBgp) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
-	if in == nil {
-		return nil
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
-	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
+	if in.ApplyGroupsExclude != nil {
+		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
-func (in *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
-	if in == nil {
-		return nil
+	if in.ConnectRetry != nil {
+		in, out := &in.ConnectRetry, &out.ConnectRetry
+		*out = new(uint32)
+		**out = **in
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupEgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFill) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdlePayloadFill) {
-	*out = *in
-	if in.IdlePayloadFillChoice != nil {
-		in, out := &in.IdlePayloadFillChoice, &out.IdlePayloadFillChoice
-		*out = new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(ConfigureRouterBgpExport)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdlePayloadFill.
-func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFill) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdlePayloadFill {
-	if in == nil {
-		return nil
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]*ConfigureRouterBgpGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigureRouterBgpGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupIdlePayloadFill)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) {
-	*out = *in
-	if in.AllOnes != nil {
-		in, out := &in.AllOnes, &out.AllOnes
-		*out = new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes)
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(ConfigureRouterBgpImport)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Pattern != nil {
-		in, out := &in.Pattern, &out.Pattern
-		*out = new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern)
-		(*in).DeepCopyInto(*out)
+	if in.Keepalive != nil {
+		in, out := &in.Keepalive, &out.Keepalive
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Neighbor != nil {
+		in, out := &in.Neighbor, &out.Neighbor
+		*out = make([]*ConfigureRouterBgpNeighbor, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigureRouterBgpNeighbor)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.RouterId != nil {
+		in, out := &in.RouterId, &out.RouterId
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice.
-func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgp.
+func (in *ConfigureRouterBgp) DeepCopy() *ConfigureRouterBgp {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoice)
+	out := new(ConfigureRouterBgp)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) {
+func (in *ConfigureRouterBgpExport) DeepCopyInto(out *ConfigureRouterBgpExport) {
 	*out = *in
-	if in.AllOnes != nil {
-		in, out := &in.AllOnes, &out.AllOnes
-		*out = new(string)
-		**out = **in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes.
-func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpExport.
+func (in *ConfigureRouterBgpExport) DeepCopy() *ConfigureRouterBgpExport {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoiceAllOnes)
+	out := new(ConfigureRouterBgpExport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) {
+func (in *ConfigureRouterBgpGroup) DeepCopyInto(out *ConfigureRouterBgpGroup) {
 	*out = *in
-	if in.Pattern != nil {
-		in, out := &in.Pattern, &out.Pattern
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(ConfigureRouterBgpGroupExport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Family != nil {
+		in, out := &in.Family, &out.Family
+		*out = new(ConfigureRouterBgpGroupFamily)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(ConfigureRouterBgpGroupImport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalAddress != nil {
+		in, out := &in.LocalAddress, &out.LocalAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerAs != nil {
+		in, out := &in.PeerAs, &out.PeerAs
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern.
-func (in *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpGroup.
+func (in *ConfigureRouterBgpGroup) DeepCopy() *ConfigureRouterBgpGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupIdlePayloadFillIdlePayloadFillChoicePattern)
+	out := new(ConfigureRouterBgpGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFill) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdleSignalFill) {
+func (in *ConfigureRouterBgpGroupExport) DeepCopyInto(out *ConfigureRouterBgpGroupExport) {
 	*out = *in
-	if in.IdleSignalFillChoice != nil {
-		in, out := &in.IdleSignalFillChoice, &out.IdleSignalFillChoice
-		*out = new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice)
-		(*in).DeepCopyInto(*out)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdleSignalFill.
-func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFill) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdleSignalFill {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpGroupExport.
+func (in *ConfigureRouterBgpGroupExport) DeepCopy() *ConfigureRouterBgpGroupExport {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupIdleSignalFill)
+	out := new(ConfigureRouterBgpGroupExport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice) {
+func (in *ConfigureRouterBgpGroupFamily) DeepCopyInto(out *ConfigureRouterBgpGroupFamily) {
 	*out = *in
-	if in.AllOnes != nil {
-		in, out := &in.AllOnes, &out.AllOnes
-		*out = new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes)
-		(*in).DeepCopyInto(*out)
+	if in.Evpn != nil {
+		in, out := &in.Evpn, &out.Evpn
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Pattern != nil {
-		in, out := &in.Pattern, &out.Pattern
-		*out = new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern)
-		(*in).DeepCopyInto(*out)
+	if in.Ipv4 != nil {
+		in, out := &in.Ipv4, &out.Ipv4
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Ipv6 != nil {
+		in, out := &in.Ipv6, &out.Ipv6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VpnIpv4 != nil {
+		in, out := &in.VpnIpv4, &out.VpnIpv4
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VpnIpv6 != nil {
+		in, out := &in.VpnIpv6, &out.VpnIpv6
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice.
-func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpGroupFamily.
+func (in *ConfigureRouterBgpGroupFamily) DeepCopy() *ConfigureRouterBgpGroupFamily {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoice)
+	out := new(ConfigureRouterBgpGroupFamily)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) {
+func (in *ConfigureRouterBgpGroupImport) DeepCopyInto(out *ConfigureRouterBgpGroupImport) {
 	*out = *in
-	if in.AllOnes != nil {
-		in, out := &in.AllOnes, &out.AllOnes
-		*out = new(string)
-		**out = **in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes.
-func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpGroupImport.
+func (in *ConfigureRouterBgpGroupImport) DeepCopy() *ConfigureRouterBgpGroupImport {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoiceAllOnes)
+	out := new(ConfigureRouterBgpGroupImport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) {
+func (in *ConfigureRouterBgpImport) DeepCopyInto(out *ConfigureRouterBgpImport) {
 	*out = *in
-	if in.Pattern != nil {
-		in, out := &in.Pattern, &out.Pattern
-		*out = new(uint32)
-		**out = **in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern.
-func (in *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern) DeepCopy() *ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpImport.
+func (in *ConfigureRouterBgpImport) DeepCopy() *ConfigureRouterBgpImport {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupIdleSignalFillIdleSignalFillChoicePattern)
+	out := new(ConfigureRouterBgpImport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupNetwork) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupNetwork) {
+func (in *ConfigureRouterBgpNeighbor) DeepCopyInto(out *ConfigureRouterBgpNeighbor) {
 	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(ConfigureRouterBgpNeighborExport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Family != nil {
+		in, out := &in.Family, &out.Family
+		*out = new(ConfigureRouterBgpNeighborFamily)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
 		*out = new(string)
 		**out = **in
 	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(ConfigureRouterBgpNeighborImport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IpAddress != nil {
+		in, out := &in.IpAddress, &out.IpAddress
+		*out = new(string)
 		**out = **in
 	}
-	if in.QueuePolicy != nil {
-		in, out := &in.QueuePolicy, &out.QueuePolicy
+	if in.LocalAddress != nil {
+		in, out := &in.LocalAddress, &out.LocalAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerAs != nil {
+		in, out := &in.PeerAs, &out.PeerAs
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupNetwork.
-func (in *ConfigurePortTdmE1ChannelGroupNetwork) DeepCopy() *ConfigurePortTdmE1ChannelGroupNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpNeighbor.
+func (in *ConfigureRouterBgpNeighbor) DeepCopy() *ConfigureRouterBgpNeighbor {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupNetwork)
+	out := new(ConfigureRouterBgpNeighbor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupPpp) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupPpp) {
+func (in *ConfigureRouterBgpNeighborExport) DeepCopyInto(out *ConfigureRouterBgpNeighborExport) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.BerSfLinkDown != nil {
-		in, out := &in.BerSfLinkDown, &out.BerSfLinkDown
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Compress != nil {
-		in, out := &in.Compress, &out.Compress
-		*out = new(ConfigurePortTdmE1ChannelGroupPppCompress)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Keepalive != nil {
-		in, out := &in.Keepalive, &out.Keepalive
-		*out = new(ConfigurePortTdmE1ChannelGroupPppKeepalive)
-		(*in).DeepCopyInto(*out)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupPpp.
-func (in *ConfigurePortTdmE1ChannelGroupPpp) DeepCopy() *ConfigurePortTdmE1ChannelGroupPpp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpNeighborExport.
+func (in *ConfigureRouterBgpNeighborExport) DeepCopy() *ConfigureRouterBgpNeighborExport {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupPpp)
+	out := new(ConfigureRouterBgpNeighborExport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupPppCompress) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupPppCompress) {
+func (in *ConfigureRouterBgpNeighborFamily) DeepCopyInto(out *ConfigureRouterBgpNeighborFamily) {
 	*out = *in
-	if in.Acfc != nil {
-		in, out := &in.Acfc, &out.Acfc
+	if in.Evpn != nil {
+		in, out := &in.Evpn, &out.Evpn
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Pfc != nil {
-		in, out := &in.Pfc, &out.Pfc
+	if in.Ipv4 != nil {
+		in, out := &in.Ipv4, &out.Ipv4
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Ipv6 != nil {
+		in, out := &in.Ipv6, &out.Ipv6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VpnIpv4 != nil {
+		in, out := &in.VpnIpv4, &out.VpnIpv4
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VpnIpv6 != nil {
+		in, out := &in.VpnIpv6, &out.VpnIpv6
 		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupPppCompress.
-func (in *ConfigurePortTdmE1ChannelGroupPppCompress) DeepCopy() *ConfigurePortTdmE1ChannelGroupPppCompress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpNeighborFamily.
+func (in *ConfigureRouterBgpNeighborFamily) DeepCopy() *ConfigureRouterBgpNeighborFamily {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupPppCompress)
+	out := new(ConfigureRouterBgpNeighborFamily)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ChannelGroupPppKeepalive) DeepCopyInto(out *ConfigurePortTdmE1ChannelGroupPppKeepalive) {
+func (in *ConfigureRouterBgpNeighborImport) DeepCopyInto(out *ConfigureRouterBgpNeighborImport) {
 	*out = *in
-	if in.DropCount != nil {
-		in, out := &in.DropCount, &out.DropCount
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(string)
-		**out = **in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ChannelGroupPppKeepalive.
-func (in *ConfigurePortTdmE1ChannelGroupPppKeepalive) DeepCopy() *ConfigurePortTdmE1ChannelGroupPppKeepalive {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpNeighborImport.
+func (in *ConfigureRouterBgpNeighborImport) DeepCopy() *ConfigureRouterBgpNeighborImport {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ChannelGroupPppKeepalive)
+	out := new(ConfigureRouterBgpNeighborImport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1HoldTime) DeepCopyInto(out *ConfigurePortTdmE1HoldTime) {
+func (in *ConfigureRouterBgpObservation) DeepCopyInto(out *ConfigureRouterBgpObservation) {
 	*out = *in
-	if in.Down != nil {
-		in, out := &in.Down, &out.Down
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Up != nil {
-		in, out := &in.Up, &out.Up
-		*out = new(uint32)
-		**out = **in
+	if in.Neighbor != nil {
+		in, out := &in.Neighbor, &out.Neighbor
+		*out = make([]*ConfigureRouterBgpObservationNeighbor, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigureRouterBgpObservationNeighbor)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1HoldTime.
-func (in *ConfigurePortTdmE1HoldTime) DeepCopy() *ConfigurePortTdmE1HoldTime {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpObservation.
+func (in *ConfigureRouterBgpObservation) DeepCopy() *ConfigureRouterBgpObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1HoldTime)
+	out := new(ConfigureRouterBgpObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1NationalBits) DeepCopyInto(out *ConfigurePortTdmE1NationalBits) {
+func (in *ConfigureRouterBgpObservationNeighbor) DeepCopyInto(out *ConfigureRouterBgpObservationNeighbor) {
 	*out = *in
-	if in.Sa4 != nil {
-		in, out := &in.Sa4, &out.Sa4
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Sa5 != nil {
-		in, out := &in.Sa5, &out.Sa5
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Sa6 != nil {
-		in, out := &in.Sa6, &out.Sa6
-		*out = new(bool)
+	if in.IpAddress != nil {
+		in, out := &in.IpAddress, &out.IpAddress
+		*out = new(string)
 		**out = **in
 	}
-	if in.Sa7 != nil {
-		in, out := &in.Sa7, &out.Sa7
-		*out = new(bool)
+	if in.SessionState != nil {
+		in, out := &in.SessionState, &out.SessionState
+		*out = new(string)
 		**out = **in
 	}
-	if in.Sa8 != nil {
-		in, out := &in.Sa8, &out.Sa8
-		*out = new(bool)
+	if in.LastState != nil {
+		in, out := &in.LastState, &out.LastState
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1NationalBits.
-func (in *ConfigurePortTdmE1NationalBits) DeepCopy() *ConfigurePortTdmE1NationalBits {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpObservationNeighbor.
+func (in *ConfigureRouterBgpObservationNeighbor) DeepCopy() *ConfigureRouterBgpObservationNeighbor {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1NationalBits)
+	out := new(ConfigureRouterBgpObservationNeighbor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE1ReportAlarm) DeepCopyInto(out *ConfigurePortTdmE1ReportAlarm) {
+func (in *ConfigureRouterBgpParameters) DeepCopyInto(out *ConfigureRouterBgpParameters) {
 	*out = *in
-	if in.Ais != nil {
-		in, out := &in.Ais, &out.Ais
-		*out = new(bool)
-		**out = **in
-	}
-	if in.BerSd != nil {
-		in, out := &in.BerSd, &out.BerSd
-		*out = new(bool)
-		**out = **in
-	}
-	if in.BerSf != nil {
-		in, out := &in.BerSf, &out.BerSf
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Looped != nil {
-		in, out := &in.Looped, &out.Looped
-		*out = new(bool)
+	if in.RouterName != nil {
+		in, out := &in.RouterName, &out.RouterName
+		*out = new(string)
 		**out = **in
 	}
-	if in.Los != nil {
-		in, out := &in.Los, &out.Los
-		*out = new(bool)
-		**out = **in
+	if in.SrosConfigureRouterBgp != nil {
+		in, out := &in.SrosConfigureRouterBgp, &out.SrosConfigureRouterBgp
+		*out = new(ConfigureRouterBgp)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Oof != nil {
-		in, out := &in.Oof, &out.Oof
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpParameters.
+func (in *ConfigureRouterBgpParameters) DeepCopy() *ConfigureRouterBgpParameters {
+	if in == nil {
+		return nil
 	}
-	if in.Rai != nil {
-		in, out := &in.Rai, &out.Rai
-		*out = new(bool)
-		**out = **in
+	out := new(ConfigureRouterBgpParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigureRouterBgpSpec) DeepCopyInto(out *ConfigureRouterBgpSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpSpec.
+func (in *ConfigureRouterBgpSpec) DeepCopy() *ConfigureRouterBgpSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(ConfigureRouterBgpSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE1ReportAlarm.
-func (in *ConfigurePortTdmE1ReportAlarm) DeepCopy() *ConfigurePortTdmE1ReportAlarm {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigureRouterBgpStatus) DeepCopyInto(out *ConfigureRouterBgpStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtNetworkNode.DeepCopyInto(&out.AtNetworkNode)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterBgpStatus.
+func (in *ConfigureRouterBgpStatus) DeepCopy() *ConfigureRouterBgpStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE1ReportAlarm)
+	out := new(ConfigureRouterBgpStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3) DeepCopyInto(out *ConfigurePortTdmE3) {
+func (in *ConfigureRouterInterface) DeepCopyInto(out *ConfigureRouterInterface) {
 	*out = *in
 	if in.AdminState != nil {
 		in, out := &in.AdminState, &out.AdminState
@@ -11028,697 +12887,661 @@ func (in *ConfigurePortTdmE3) DeepCopyInto(out *ConfigurePortTdmE3) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ClockSource != nil {
-		in, out := &in.ClockSource, &out.ClockSource
-		*out = new(string)
-		**out = **in
-	}
-	if in.Crc != nil {
-		in, out := &in.Crc, &out.Crc
-		*out = new(uint32)
-		**out = **in
-	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.E3Index != nil {
-		in, out := &in.E3Index, &out.E3Index
-		*out = new(string)
-		**out = **in
-	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(ConfigurePortTdmE3Egress)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EncapType != nil {
-		in, out := &in.EncapType, &out.EncapType
+	if in.InterfaceName != nil {
+		in, out := &in.InterfaceName, &out.InterfaceName
 		*out = new(string)
 		**out = **in
 	}
-	if in.Framing != nil {
-		in, out := &in.Framing, &out.Framing
-		*out = new(string)
+	if in.IpMtu != nil {
+		in, out := &in.IpMtu, &out.IpMtu
+		*out = new(uint32)
 		**out = **in
 	}
-	if in.IdleCycleFlag != nil {
-		in, out := &in.IdleCycleFlag, &out.IdleCycleFlag
-		*out = new(string)
-		**out = **in
+	if in.Ipv4 != nil {
+		in, out := &in.Ipv4, &out.Ipv4
+		*out = new(ConfigureRouterInterfaceIpv4)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.LoadBalancingAlgorithm != nil {
-		in, out := &in.LoadBalancingAlgorithm, &out.LoadBalancingAlgorithm
-		*out = new(string)
-		**out = **in
+	if in.Ipv6 != nil {
+		in, out := &in.Ipv6, &out.Ipv6
+		*out = new(ConfigureRouterInterfaceIpv6)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Loopback != nil {
 		in, out := &in.Loopback, &out.Loopback
-		*out = new(string)
-		**out = **in
-	}
-	if in.MacAddress != nil {
-		in, out := &in.MacAddress, &out.MacAddress
-		*out = new(string)
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
 		*out = new(string)
 		**out = **in
 	}
-	if in.Mtu != nil {
-		in, out := &in.Mtu, &out.Mtu
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(ConfigurePortTdmE3Network)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ppp != nil {
-		in, out := &in.Ppp, &out.Ppp
-		*out = new(ConfigurePortTdmE3Ppp)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ReportAlarm != nil {
-		in, out := &in.ReportAlarm, &out.ReportAlarm
-		*out = new(ConfigurePortTdmE3ReportAlarm)
+	if in.Qos != nil {
+		in, out := &in.Qos, &out.Qos
+		*out = new(ConfigureRouterInterfaceQos)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Scramble != nil {
-		in, out := &in.Scramble, &out.Scramble
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3.
-func (in *ConfigurePortTdmE3) DeepCopy() *ConfigurePortTdmE3 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterface.
+func (in *ConfigureRouterInterface) DeepCopy() *ConfigureRouterInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3)
+	out := new(ConfigureRouterInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3Egress) DeepCopyInto(out *ConfigurePortTdmE3Egress) {
+func (in *ConfigureRouterInterfaceIpv4) DeepCopyInto(out *ConfigureRouterInterfaceIpv4) {
 	*out = *in
-	if in.PortSchedulerPolicy != nil {
-		in, out := &in.PortSchedulerPolicy, &out.PortSchedulerPolicy
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicy)
+	if in.Bfd != nil {
+		in, out := &in.Bfd, &out.Bfd
+		*out = new(ConfigureRouterInterfaceIpv4Bfd)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(ConfigureRouterInterfaceIpv4Primary)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Secondary != nil {
+		in, out := &in.Secondary, &out.Secondary
+		*out = make([]*ConfigureRouterInterfaceIpv4Secondary, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigureRouterInterfaceIpv4Secondary)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3Egress.
-func (in *ConfigurePortTdmE3Egress) DeepCopy() *ConfigurePortTdmE3Egress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceIpv4.
+func (in *ConfigureRouterInterfaceIpv4) DeepCopy() *ConfigureRouterInterfaceIpv4 {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3Egress)
+	out := new(ConfigureRouterInterfaceIpv4)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicy) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicy) {
+func (in *ConfigureRouterInterfaceIpv4Bfd) DeepCopyInto(out *ConfigureRouterInterfaceIpv4Bfd) {
 	*out = *in
-	if in.Overrides != nil {
-		in, out := &in.Overrides, &out.Overrides
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PolicyName != nil {
-		in, out := &in.PolicyName, &out.PolicyName
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TransmitInterval != nil {
+		in, out := &in.TransmitInterval, &out.TransmitInterval
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicy.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicy) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceIpv4Bfd.
+func (in *ConfigureRouterInterfaceIpv4Bfd) DeepCopy() *ConfigureRouterInterfaceIpv4Bfd {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicy)
+	out := new(ConfigureRouterInterfaceIpv4Bfd)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides) {
+func (in *ConfigureRouterInterfaceIpv4Primary) DeepCopyInto(out *ConfigureRouterInterfaceIpv4Primary) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
+	if in.PrefixLength != nil {
+		in, out := &in.PrefixLength, &out.PrefixLength
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = make([]*ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.MaxRate != nil {
-		in, out := &in.MaxRate, &out.MaxRate
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceIpv4Primary.
+func (in *ConfigureRouterInterfaceIpv4Primary) DeepCopy() *ConfigureRouterInterfaceIpv4Primary {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverrides)
+	out := new(ConfigureRouterInterfaceIpv4Primary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel) {
+func (in *ConfigureRouterInterfaceIpv4Secondary) DeepCopyInto(out *ConfigureRouterInterfaceIpv4Secondary) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
 		*out = new(string)
 		**out = **in
 	}
-	if in.PriorityLevel != nil {
-		in, out := &in.PriorityLevel, &out.PriorityLevel
-		*out = new(uint32)
+	if in.PrefixLength != nil {
+		in, out := &in.PrefixLength, &out.PrefixLength
+		*out = new(uint8)
 		**out = **in
 	}
-	if in.RateOrPercentRate != nil {
-		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceIpv4Secondary.
+func (in *ConfigureRouterInterfaceIpv4Secondary) DeepCopy() *ConfigureRouterInterfaceIpv4Secondary {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevel)
+	out := new(ConfigureRouterInterfaceIpv4Secondary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) {
+func (in *ConfigureRouterInterfaceIpv6) DeepCopyInto(out *ConfigureRouterInterfaceIpv6) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = make([]*ConfigureRouterInterfaceIpv6Address, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigureRouterInterfaceIpv6Address)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	if in.Bfd != nil {
+		in, out := &in.Bfd, &out.Bfd
+		*out = new(ConfigureRouterInterfaceIpv6Bfd)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceIpv6.
+func (in *ConfigureRouterInterfaceIpv6) DeepCopy() *ConfigureRouterInterfaceIpv6 {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRate)
+	out := new(ConfigureRouterInterfaceIpv6)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) {
+func (in *ConfigureRouterInterfaceIpv6Address) DeepCopyInto(out *ConfigureRouterInterfaceIpv6Address) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.Ipv6Address != nil {
+		in, out := &in.Ipv6Address, &out.Ipv6Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixLength != nil {
+		in, out := &in.PrefixLength, &out.PrefixLength
+		*out = new(uint8)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceIpv6Address.
+func (in *ConfigureRouterInterfaceIpv6Address) DeepCopy() *ConfigureRouterInterfaceIpv6Address {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRate)
+	out := new(ConfigureRouterInterfaceIpv6Address)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) {
-	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+func (in *ConfigureRouterInterfaceIpv6Bfd) DeepCopyInto(out *ConfigureRouterInterfaceIpv6Bfd) {
+	*out = *in
+	if in.AdminState != nil {
+		in, out := &in.AdminState, &out.AdminState
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
-		*out = new(string)
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TransmitInterval != nil {
+		in, out := &in.TransmitInterval, &out.TransmitInterval
+		*out = new(uint32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceIpv6Bfd.
+func (in *ConfigureRouterInterfaceIpv6Bfd) DeepCopy() *ConfigureRouterInterfaceIpv6Bfd {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRatePercentRatePercentRate)
+	out := new(ConfigureRouterInterfaceIpv6Bfd)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) {
+func (in *ConfigureRouterInterfaceObservation) DeepCopyInto(out *ConfigureRouterInterfaceObservation) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceObservation.
+func (in *ConfigureRouterInterfaceObservation) DeepCopy() *ConfigureRouterInterfaceObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRate)
+	out := new(ConfigureRouterInterfaceObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) {
+func (in *ConfigureRouterInterfaceParameters) DeepCopyInto(out *ConfigureRouterInterfaceParameters) {
 	*out = *in
-	if in.Cir != nil {
-		in, out := &in.Cir, &out.Cir
+	if in.RouterName != nil {
+		in, out := &in.RouterName, &out.RouterName
 		*out = new(string)
 		**out = **in
 	}
-	if in.Pir != nil {
-		in, out := &in.Pir, &out.Pir
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
 		*out = new(string)
 		**out = **in
 	}
+	if in.SrosConfigureRouterInterface != nil {
+		in, out := &in.SrosConfigureRouterInterface, &out.SrosConfigureRouterInterface
+		*out = new(ConfigureRouterInterface)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceParameters.
+func (in *ConfigureRouterInterfaceParameters) DeepCopy() *ConfigureRouterInterfaceParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesLevelRateOrPercentRateRateRate)
+	out := new(ConfigureRouterInterfaceParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate) {
+func (in *ConfigureRouterInterfaceQos) DeepCopyInto(out *ConfigureRouterInterfaceQos) {
 	*out = *in
-	if in.RateOrPercentRate != nil {
-		in, out := &in.RateOrPercentRate, &out.RateOrPercentRate
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
-		(*in).DeepCopyInto(*out)
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceQos.
+func (in *ConfigureRouterInterfaceQos) DeepCopy() *ConfigureRouterInterfaceQos {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRate)
+	out := new(ConfigureRouterInterfaceQos)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) {
+func (in *ConfigureRouterInterfaceSpec) DeepCopyInto(out *ConfigureRouterInterfaceSpec) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceSpec.
+func (in *ConfigureRouterInterfaceSpec) DeepCopy() *ConfigureRouterInterfaceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRate)
+	out := new(ConfigureRouterInterfaceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) {
+func (in *ConfigureRouterInterfaceStatus) DeepCopyInto(out *ConfigureRouterInterfaceStatus) {
 	*out = *in
-	if in.PercentRate != nil {
-		in, out := &in.PercentRate, &out.PercentRate
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtNetworkNode = in.AtNetworkNode
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigureRouterInterfaceStatus.
+func (in *ConfigureRouterInterfaceStatus) DeepCopy() *ConfigureRouterInterfaceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRatePercentRate)
+	out := new(ConfigureRouterInterfaceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopyInto(out *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) {
+func (in *Registration) DeepCopyInto(out *Registration) {
 	*out = *in
-	if in.Rate != nil {
-		in, out := &in.Rate, &out.Rate
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate.
-func (in *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate) DeepCopy() *ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Registration.
+func (in *Registration) DeepCopy() *Registration {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3EgressPortSchedulerPolicyOverridesMaxRateRateOrPercentRateRate)
+	out := new(Registration)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Registration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3Network) DeepCopyInto(out *ConfigurePortTdmE3Network) {
+func (in *RegistrationList) DeepCopyInto(out *RegistrationList) {
 	*out = *in
-	if in.AccountingPolicy != nil {
-		in, out := &in.AccountingPolicy, &out.AccountingPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.CollectStats != nil {
-		in, out := &in.CollectStats, &out.CollectStats
-		*out = new(bool)
-		**out = **in
-	}
-	if in.QueuePolicy != nil {
-		in, out := &in.QueuePolicy, &out.QueuePolicy
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Registration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3Network.
-func (in *ConfigurePortTdmE3Network) DeepCopy() *ConfigurePortTdmE3Network {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationList.
+func (in *RegistrationList) DeepCopy() *RegistrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3Network)
+	out := new(RegistrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegistrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3Ppp) DeepCopyInto(out *ConfigurePortTdmE3Ppp) {
+func (in *RegistrationObservation) DeepCopyInto(out *RegistrationObservation) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.Keepalive != nil {
-		in, out := &in.Keepalive, &out.Keepalive
-		*out = new(ConfigurePortTdmE3PppKeepalive)
-		(*in).DeepCopyInto(*out)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]RegistrationTargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3Ppp.
-func (in *ConfigurePortTdmE3Ppp) DeepCopy() *ConfigurePortTdmE3Ppp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationObservation.
+func (in *RegistrationObservation) DeepCopy() *RegistrationObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3Ppp)
+	out := new(RegistrationObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3PppKeepalive) DeepCopyInto(out *ConfigurePortTdmE3PppKeepalive) {
+func (in *RegistrationParameters) DeepCopyInto(out *RegistrationParameters) {
 	*out = *in
-	if in.DropCount != nil {
-		in, out := &in.DropCount, &out.DropCount
-		*out = new(uint32)
-		**out = **in
+	if in.Subscriptions != nil {
+		in, out := &in.Subscriptions, &out.Subscriptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(string)
-		**out = **in
+	if in.ExceptionPaths != nil {
+		in, out := &in.ExceptionPaths, &out.ExceptionPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExplicitExceptionPaths != nil {
+		in, out := &in.ExplicitExceptionPaths, &out.ExplicitExceptionPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3PppKeepalive.
-func (in *ConfigurePortTdmE3PppKeepalive) DeepCopy() *ConfigurePortTdmE3PppKeepalive {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationParameters.
+func (in *RegistrationParameters) DeepCopy() *RegistrationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3PppKeepalive)
+	out := new(RegistrationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmE3ReportAlarm) DeepCopyInto(out *ConfigurePortTdmE3ReportAlarm) {
+func (in *RegistrationSpec) DeepCopyInto(out *RegistrationSpec) {
 	*out = *in
-	if in.Ais != nil {
-		in, out := &in.Ais, &out.Ais
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Looped != nil {
-		in, out := &in.Looped, &out.Looped
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Los != nil {
-		in, out := &in.Los, &out.Los
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Oof != nil {
-		in, out := &in.Oof, &out.Oof
-		*out = new(bool)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	if in.NetworkNodeSelector != nil {
+		in, out := &in.NetworkNodeSelector, &out.NetworkNodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Rai != nil {
-		in, out := &in.Rai, &out.Rai
-		*out = new(bool)
+	if in.SwVersion != nil {
+		in, out := &in.SwVersion, &out.SwVersion
+		*out = new(string)
 		**out = **in
 	}
+	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmE3ReportAlarm.
-func (in *ConfigurePortTdmE3ReportAlarm) DeepCopy() *ConfigurePortTdmE3ReportAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationSpec.
+func (in *RegistrationSpec) DeepCopy() *RegistrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmE3ReportAlarm)
+	out := new(RegistrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTdmHoldTime) DeepCopyInto(out *ConfigurePortTdmHoldTime) {
+func (in *RegistrationStatus) DeepCopyInto(out *RegistrationStatus) {
 	*out = *in
-	if in.Down != nil {
-		in, out := &in.Down, &out.Down
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.Up != nil {
-		in, out := &in.Up, &out.Up
-		*out = new(uint32)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtNetworkNode.DeepCopyInto(&out.AtNetworkNode)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTdmHoldTime.
-func (in *ConfigurePortTdmHoldTime) DeepCopy() *ConfigurePortTdmHoldTime {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationStatus.
+func (in *RegistrationStatus) DeepCopy() *RegistrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTdmHoldTime)
+	out := new(RegistrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortTransceiver) DeepCopyInto(out *ConfigurePortTransceiver) {
+func (in *RegistrationTargetStatus) DeepCopyInto(out *RegistrationTargetStatus) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.DigitalCoherentOptics != nil {
-		in, out := &in.DigitalCoherentOptics, &out.DigitalCoherentOptics
-		*out = new(bool)
-		**out = **in
-	}
+	in.Registered.DeepCopyInto(&out.Registered)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortTransceiver.
-func (in *ConfigurePortTransceiver) DeepCopy() *ConfigurePortTransceiver {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationTargetStatus.
+func (in *RegistrationTargetStatus) DeepCopy() *RegistrationTargetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortTransceiver)
+	out := new(RegistrationTargetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortXc) DeepCopyInto(out *ConfigurePortXc) {
+func (in *SrosConfigureLag) DeepCopyInto(out *SrosConfigureLag) {
 	*out = *in
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigureLag.
+func (in *SrosConfigureLag) DeepCopy() *SrosConfigureLag {
+	if in == nil {
+		return nil
 	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
+	out := new(SrosConfigureLag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrosConfigureLag) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Pxc != nil {
-		in, out := &in.Pxc, &out.Pxc
-		*out = make([]*ConfigurePortXcPxc, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SrosConfigureLagList) DeepCopyInto(out *SrosConfigureLagList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrosConfigureLag, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConfigurePortXcPxc)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortXc.
-func (in *ConfigurePortXc) DeepCopy() *ConfigurePortXc {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigureLagList.
+func (in *SrosConfigureLagList) DeepCopy() *SrosConfigureLagList {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortXc)
+	out := new(SrosConfigureLagList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrosConfigureLagList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurePortXcPxc) DeepCopyInto(out *ConfigurePortXcPxc) {
+func (in *SrosConfigurePort) DeepCopyInto(out *SrosConfigurePort) {
 	*out = *in
-	if in.AdminState != nil {
-		in, out := &in.AdminState, &out.AdminState
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroups != nil {
-		in, out := &in.ApplyGroups, &out.ApplyGroups
-		*out = new(string)
-		**out = **in
-	}
-	if in.ApplyGroupsExclude != nil {
-		in, out := &in.ApplyGroupsExclude, &out.ApplyGroupsExclude
-		*out = new(string)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigurePort.
+func (in *SrosConfigurePort) DeepCopy() *SrosConfigurePort {
+	if in == nil {
+		return nil
 	}
-	if in.PortId != nil {
-		in, out := &in.PortId, &out.PortId
-		*out = new(string)
-		**out = **in
+	out := new(SrosConfigurePort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrosConfigurePort) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.PxcId != nil {
-		in, out := &in.PxcId, &out.PxcId
-		*out = new(uint32)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SrosConfigurePortList) DeepCopyInto(out *SrosConfigurePortList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrosConfigurePort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurePortXcPxc.
-func (in *ConfigurePortXcPxc) DeepCopy() *ConfigurePortXcPxc {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigurePortList.
+func (in *SrosConfigurePortList) DeepCopy() *SrosConfigurePortList {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurePortXcPxc)
+	out := new(SrosConfigurePortList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrosConfigurePortList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Registration) DeepCopyInto(out *Registration) {
+func (in *SrosConfigurePortPolicy) DeepCopyInto(out *SrosConfigurePortPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -11726,18 +13549,18 @@ func (in *Registration) DeepCopyInto(out *Registration) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Registration.
-func (in *Registration) DeepCopy() *Registration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigurePortPolicy.
+func (in *SrosConfigurePortPolicy) DeepCopy() *SrosConfigurePortPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(Registration)
+	out := new(SrosConfigurePortPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Registration) DeepCopyObject() runtime.Object {
+func (in *SrosConfigurePortPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -11745,31 +13568,31 @@ func (in *Registration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationList) DeepCopyInto(out *RegistrationList) {
+func (in *SrosConfigurePortPolicyList) DeepCopyInto(out *SrosConfigurePortPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Registration, len(*in))
+		*out = make([]SrosConfigurePortPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationList.
-func (in *RegistrationList) DeepCopy() *RegistrationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigurePortPolicyList.
+func (in *SrosConfigurePortPolicyList) DeepCopy() *SrosConfigurePortPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistrationList)
+	out := new(SrosConfigurePortPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RegistrationList) DeepCopyObject() runtime.Object {
+func (in *SrosConfigurePortPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -11777,86 +13600,125 @@ func (in *RegistrationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationObservation) DeepCopyInto(out *RegistrationObservation) {
+func (in *SrosConfigurePortXc) DeepCopyInto(out *SrosConfigurePortXc) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationObservation.
-func (in *RegistrationObservation) DeepCopy() *RegistrationObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigurePortXc.
+func (in *SrosConfigurePortXc) DeepCopy() *SrosConfigurePortXc {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistrationObservation)
+	out := new(SrosConfigurePortXc)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrosConfigurePortXc) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationParameters) DeepCopyInto(out *RegistrationParameters) {
+func (in *SrosConfigurePortXcList) DeepCopyInto(out *SrosConfigurePortXcList) {
 	*out = *in
-	if in.Subscriptions != nil {
-		in, out := &in.Subscriptions, &out.Subscriptions
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExceptionPaths != nil {
-		in, out := &in.ExceptionPaths, &out.ExceptionPaths
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExplicitExceptionPaths != nil {
-		in, out := &in.ExplicitExceptionPaths, &out.ExplicitExceptionPaths
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrosConfigurePortXc, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationParameters.
-func (in *RegistrationParameters) DeepCopy() *RegistrationParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigurePortXcList.
+func (in *SrosConfigurePortXcList) DeepCopy() *SrosConfigurePortXcList {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistrationParameters)
+	out := new(SrosConfigurePortXcList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrosConfigurePortXcList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationSpec) DeepCopyInto(out *RegistrationSpec) {
+func (in *SrosConfigureRouterBgp) DeepCopyInto(out *SrosConfigureRouterBgp) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForNetworkNode.DeepCopyInto(&out.ForNetworkNode)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationSpec.
-func (in *RegistrationSpec) DeepCopy() *RegistrationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigureRouterBgp.
+func (in *SrosConfigureRouterBgp) DeepCopy() *SrosConfigureRouterBgp {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistrationSpec)
+	out := new(SrosConfigureRouterBgp)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrosConfigureRouterBgp) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrationStatus) DeepCopyInto(out *RegistrationStatus) {
+func (in *SrosConfigureRouterBgpList) DeepCopyInto(out *SrosConfigureRouterBgpList) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtNetworkNode = in.AtNetworkNode
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SrosConfigureRouterBgp, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationStatus.
-func (in *RegistrationStatus) DeepCopy() *RegistrationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigureRouterBgpList.
+func (in *SrosConfigureRouterBgpList) DeepCopy() *SrosConfigureRouterBgpList {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistrationStatus)
+	out := new(SrosConfigureRouterBgpList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SrosConfigureRouterBgpList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SrosConfigurePort) DeepCopyInto(out *SrosConfigurePort) {
+func (in *SrosConfigureRouterInterface) DeepCopyInto(out *SrosConfigureRouterInterface) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -11864,18 +13726,18 @@ func (in *SrosConfigurePort) DeepCopyInto(out *SrosConfigurePort) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigurePort.
-func (in *SrosConfigurePort) DeepCopy() *SrosConfigurePort {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigureRouterInterface.
+func (in *SrosConfigureRouterInterface) DeepCopy() *SrosConfigureRouterInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(SrosConfigurePort)
+	out := new(SrosConfigureRouterInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SrosConfigurePort) DeepCopyObject() runtime.Object {
+func (in *SrosConfigureRouterInterface) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -11883,31 +13745,31 @@ func (in *SrosConfigurePort) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SrosConfigurePortList) DeepCopyInto(out *SrosConfigurePortList) {
+func (in *SrosConfigureRouterInterfaceList) DeepCopyInto(out *SrosConfigureRouterInterfaceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SrosConfigurePort, len(*in))
+		*out = make([]SrosConfigureRouterInterface, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigurePortList.
-func (in *SrosConfigurePortList) DeepCopy() *SrosConfigurePortList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SrosConfigureRouterInterfaceList.
+func (in *SrosConfigureRouterInterfaceList) DeepCopy() *SrosConfigureRouterInterfaceList {
 	if in == nil {
 		return nil
 	}
-	out := new(SrosConfigurePortList)
+	out := new(SrosConfigureRouterInterfaceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SrosConfigurePortList) DeepCopyObject() runtime.Object {
+func (in *SrosConfigureRouterInterfaceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
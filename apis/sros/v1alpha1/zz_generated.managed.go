@@ -90,6 +90,76 @@ func (mg *Registration) SetTarget(t []string) {
 	mg.Status.Target = t
 }
 
+// GetActive of this SrosConfigureLag.
+func (mg *SrosConfigureLag) GetActive() bool {
+	return mg.Spec.Active
+}
+
+// GetCondition of this SrosConfigureLag.
+func (mg *SrosConfigureLag) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// GetDeletionPolicy of this SrosConfigureLag.
+func (mg *SrosConfigureLag) GetDeletionPolicy() nddv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetExternalLeafRefs of this SrosConfigureLag.
+func (mg *SrosConfigureLag) GetExternalLeafRefs() []string {
+	return mg.Status.ExternalLeafRefs
+}
+
+// GetNetworkNodeReference of this SrosConfigureLag.
+func (mg *SrosConfigureLag) GetNetworkNodeReference() *nddv1.Reference {
+	return mg.Spec.NetworkNodeReference
+}
+
+// GetResourceIndexes of this SrosConfigureLag.
+func (mg *SrosConfigureLag) GetResourceIndexes() map[string]string {
+	return mg.Status.ResourceIndexes
+}
+
+// GetTarget of this SrosConfigureLag.
+func (mg *SrosConfigureLag) GetTarget() []string {
+	return mg.Status.Target
+}
+
+// SetActive of this SrosConfigureLag.
+func (mg *SrosConfigureLag) SetActive(b bool) {
+	mg.Spec.Active = b
+}
+
+// SetConditions of this SrosConfigureLag.
+func (mg *SrosConfigureLag) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this SrosConfigureLag.
+func (mg *SrosConfigureLag) SetDeletionPolicy(r nddv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetExternalLeafRefs of this SrosConfigureLag.
+func (mg *SrosConfigureLag) SetExternalLeafRefs(n []string) {
+	mg.Status.ExternalLeafRefs = n
+}
+
+// SetNetworkNodeReference of this SrosConfigureLag.
+func (mg *SrosConfigureLag) SetNetworkNodeReference(r *nddv1.Reference) {
+	mg.Spec.NetworkNodeReference = r
+}
+
+// SetResourceIndexes of this SrosConfigureLag.
+func (mg *SrosConfigureLag) SetResourceIndexes(n map[string]string) {
+	mg.Status.ResourceIndexes = n
+}
+
+// SetTarget of this SrosConfigureLag.
+func (mg *SrosConfigureLag) SetTarget(t []string) {
+	mg.Status.Target = t
+}
+
 // GetActive of this SrosConfigurePort.
 func (mg *SrosConfigurePort) GetActive() bool {
 	return mg.Spec.Active
@@ -159,3 +229,283 @@ func (mg *SrosConfigurePort) SetResourceIndexes(n map[string]string) {
 func (mg *SrosConfigurePort) SetTarget(t []string) {
 	mg.Status.Target = t
 }
+
+// GetActive of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) GetActive() bool {
+	return mg.Spec.Active
+}
+
+// GetCondition of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// GetDeletionPolicy of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) GetDeletionPolicy() nddv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetExternalLeafRefs of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) GetExternalLeafRefs() []string {
+	return mg.Status.ExternalLeafRefs
+}
+
+// GetNetworkNodeReference of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) GetNetworkNodeReference() *nddv1.Reference {
+	return mg.Spec.NetworkNodeReference
+}
+
+// GetResourceIndexes of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) GetResourceIndexes() map[string]string {
+	return mg.Status.ResourceIndexes
+}
+
+// GetTarget of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) GetTarget() []string {
+	return mg.Status.Target
+}
+
+// SetActive of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) SetActive(b bool) {
+	mg.Spec.Active = b
+}
+
+// SetConditions of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) SetDeletionPolicy(r nddv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetExternalLeafRefs of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) SetExternalLeafRefs(n []string) {
+	mg.Status.ExternalLeafRefs = n
+}
+
+// SetNetworkNodeReference of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) SetNetworkNodeReference(r *nddv1.Reference) {
+	mg.Spec.NetworkNodeReference = r
+}
+
+// SetResourceIndexes of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) SetResourceIndexes(n map[string]string) {
+	mg.Status.ResourceIndexes = n
+}
+
+// SetTarget of this SrosConfigurePortPolicy.
+func (mg *SrosConfigurePortPolicy) SetTarget(t []string) {
+	mg.Status.Target = t
+}
+
+// GetActive of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) GetActive() bool {
+	return mg.Spec.Active
+}
+
+// GetCondition of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// GetDeletionPolicy of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) GetDeletionPolicy() nddv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetExternalLeafRefs of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) GetExternalLeafRefs() []string {
+	return mg.Status.ExternalLeafRefs
+}
+
+// GetNetworkNodeReference of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) GetNetworkNodeReference() *nddv1.Reference {
+	return mg.Spec.NetworkNodeReference
+}
+
+// GetResourceIndexes of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) GetResourceIndexes() map[string]string {
+	return mg.Status.ResourceIndexes
+}
+
+// GetTarget of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) GetTarget() []string {
+	return mg.Status.Target
+}
+
+// SetActive of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) SetActive(b bool) {
+	mg.Spec.Active = b
+}
+
+// SetConditions of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) SetDeletionPolicy(r nddv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetExternalLeafRefs of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) SetExternalLeafRefs(n []string) {
+	mg.Status.ExternalLeafRefs = n
+}
+
+// SetNetworkNodeReference of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) SetNetworkNodeReference(r *nddv1.Reference) {
+	mg.Spec.NetworkNodeReference = r
+}
+
+// SetResourceIndexes of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) SetResourceIndexes(n map[string]string) {
+	mg.Status.ResourceIndexes = n
+}
+
+// SetTarget of this SrosConfigurePortXc.
+func (mg *SrosConfigurePortXc) SetTarget(t []string) {
+	mg.Status.Target = t
+}
+
+// GetActive of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) GetActive() bool {
+	return mg.Spec.Active
+}
+
+// GetCondition of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// GetDeletionPolicy of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) GetDeletionPolicy() nddv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetExternalLeafRefs of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) GetExternalLeafRefs() []string {
+	return mg.Status.ExternalLeafRefs
+}
+
+// GetNetworkNodeReference of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) GetNetworkNodeReference() *nddv1.Reference {
+	return mg.Spec.NetworkNodeReference
+}
+
+// GetResourceIndexes of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) GetResourceIndexes() map[string]string {
+	return mg.Status.ResourceIndexes
+}
+
+// GetTarget of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) GetTarget() []string {
+	return mg.Status.Target
+}
+
+// SetActive of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) SetActive(b bool) {
+	mg.Spec.Active = b
+}
+
+// SetConditions of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) SetDeletionPolicy(r nddv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetExternalLeafRefs of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) SetExternalLeafRefs(n []string) {
+	mg.Status.ExternalLeafRefs = n
+}
+
+// SetNetworkNodeReference of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) SetNetworkNodeReference(r *nddv1.Reference) {
+	mg.Spec.NetworkNodeReference = r
+}
+
+// SetResourceIndexes of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) SetResourceIndexes(n map[string]string) {
+	mg.Status.ResourceIndexes = n
+}
+
+// SetTarget of this SrosConfigureRouterBgp.
+func (mg *SrosConfigureRouterBgp) SetTarget(t []string) {
+	mg.Status.Target = t
+}
+
+// GetActive of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) GetActive() bool {
+	return mg.Spec.Active
+}
+
+// GetCondition of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) GetCondition(ck nddv1.ConditionKind) nddv1.Condition {
+	return mg.Status.GetCondition(ck)
+}
+
+// GetDeletionPolicy of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) GetDeletionPolicy() nddv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetExternalLeafRefs of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) GetExternalLeafRefs() []string {
+	return mg.Status.ExternalLeafRefs
+}
+
+// GetNetworkNodeReference of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) GetNetworkNodeReference() *nddv1.Reference {
+	return mg.Spec.NetworkNodeReference
+}
+
+// GetResourceIndexes of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) GetResourceIndexes() map[string]string {
+	return mg.Status.ResourceIndexes
+}
+
+// GetTarget of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) GetTarget() []string {
+	return mg.Status.Target
+}
+
+// SetActive of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) SetActive(b bool) {
+	mg.Spec.Active = b
+}
+
+// SetConditions of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) SetConditions(c ...nddv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) SetDeletionPolicy(r nddv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetExternalLeafRefs of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) SetExternalLeafRefs(n []string) {
+	mg.Status.ExternalLeafRefs = n
+}
+
+// SetNetworkNodeReference of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) SetNetworkNodeReference(r *nddv1.Reference) {
+	mg.Spec.NetworkNodeReference = r
+}
+
+// SetResourceIndexes of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) SetResourceIndexes(n map[string]string) {
+	mg.Status.ResourceIndexes = n
+}
+
+// SetTarget of this SrosConfigureRouterInterface.
+func (mg *SrosConfigureRouterInterface) SetTarget(t []string) {
+	mg.Status.Target = t
+}
@@ -29,6 +29,15 @@ func (l *RegistrationList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this SrosConfigureLagList.
+func (l *SrosConfigureLagList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this SrosConfigurePortList.
 func (l *SrosConfigurePortList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -37,3 +46,39 @@ func (l *SrosConfigurePortList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this SrosConfigurePortPolicyList.
+func (l *SrosConfigurePortPolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this SrosConfigurePortXcList.
+func (l *SrosConfigurePortXcList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this SrosConfigureRouterBgpList.
+func (l *SrosConfigureRouterBgpList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this SrosConfigureRouterInterfaceList.
+func (l *SrosConfigureRouterInterfaceList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -41,6 +41,13 @@ import (
 	//+kubebuilder:scaffold:imports
 )
 
+const (
+	// registrationConfigMap is the name of the ConfigMap in the namespace of the
+	// provider that overrides the default paths of the Registration object, it
+	// is read at initialization and watched while the provider runs
+	registrationConfigMap = "sros-registrations"
+)
+
 var (
 	scheme = runtime.NewScheme()
 	debug  bool
@@ -87,7 +94,9 @@ func init() {
 		initializer.NewCRDWaiter([]string{
 			fmt.Sprintf("%s.%s", "registrations", srosv1alpha1.Group),
 		}, time.Minute, time.Second, logging.NewLogrLogger(zlog.WithName("nddrbacinit"))),
-		initializer.NewRegistrationObject(),
+		initializer.NewRegistrationObject(
+			initializer.WithRegistrationConfigMap(os.Getenv("POD_NAMESPACE"), registrationConfigMap),
+		),
 	)
 	if err := i.Init(context.TODO()); err != nil {
 		fmt.Printf("cannot initialize provider %s\n", err)
@@ -17,13 +17,16 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"os"
 	"time"
 
+	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -31,7 +34,9 @@ import (
 	"github.com/yndd/ndd-runtime/pkg/logging"
 	"github.com/yndd/ndd-runtime/pkg/ratelimiter"
 
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
 	"github.com/yndd/ndd-provider-sros/internal/controllers"
+	"github.com/yndd/ndd-provider-sros/internal/initializer"
 
 	"github.com/yndd/ndd-provider-sros/internal/collector"
 	//+kubebuilder:scaffold:imports
@@ -46,8 +51,16 @@ var (
 	pollInterval         time.Duration
 	namespace            string
 	podname              string
+	enableWebhooks       bool
+	webhookCertDir       string
+	gnmiIdleTimeout      time.Duration
+	// gnmi subscriptions of the deviation server
+	encodings []string
 )
 
+// webhookPort is the port the webhook server listens on
+const webhookPort = 9443
+
 // startCmd represents the start command for the network device driver
 var startCmd = &cobra.Command{
 	Use:          "start",
@@ -65,7 +78,8 @@ var startCmd = &cobra.Command{
 		mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 			Scheme:                 scheme,
 			MetricsBindAddress:     metricsAddr,
-			Port:                   9443,
+			Port:                   webhookPort,
+			CertDir:                webhookCertDir,
 			HealthProbeBindAddress: probeAddr,
 			//LeaderElection:         false,
 			LeaderElection:   enableLeaderElection,
@@ -75,22 +89,65 @@ var startCmd = &cobra.Command{
 			return errors.Wrap(err, "Cannot create manager")
 		}
 
+		// pool is the gnmi client cache the controllers share to reach the device drivers
+		pool := clientpool.New(
+			clientpool.WithLogger(logging.NewLogrLogger(zlog.WithName("clientpool"))),
+			clientpool.WithIdleTimeout(gnmiIdleTimeout),
+		)
+		if err := pool.SetupWithManager(context.Background(), mgr); err != nil {
+			return errors.Wrap(err, "Cannot add gnmi client pool to manager")
+		}
+
 		//tuChan is the communication channel by which gnmi subscriptions to the device driver are handled
 		tuChan := make(chan collector.TargetUpdate)
 
-		eventChans, err := controllers.Setup(mgr, nddCtlrOptions(concurrency), logging.NewLogrLogger(zlog.WithName("sros")), autoPilot, pollInterval, namespace, tuChan)
+		eventChans, err := controllers.Setup(mgr, nddCtlrOptions(concurrency), logging.NewLogrLogger(zlog.WithName("sros")), autoPilot, pollInterval, namespace, pool, tuChan)
 		if err != nil {
 			return errors.Wrap(err, "Cannot add ndd controllers to manager")
 		}
 
+		// the Registration object is created during initialization, changes of
+		// its ConfigMap are applied while the provider runs
+		if err := initializer.NewRegistrationObject(
+			initializer.WithRegistrationConfigMap(namespace, registrationConfigMap),
+		).SetupWithManager(mgr); err != nil {
+			return errors.Wrap(err, "Cannot add registration ConfigMap watch to manager")
+		}
+
+		if enableWebhooks {
+			// the manager client reads from the cache, which is only started
+			// with the manager
+			cl, err := client.New(mgr.GetConfig(), client.Options{Scheme: scheme})
+			if err != nil {
+				return errors.Wrap(err, "Cannot create kubernetes client")
+			}
+			i := initializer.New(cl,
+				initializer.NewWebhookConfigurations(namespace, podname, webhookCertDir, initializer.WithWebhookPort(webhookPort)),
+			)
+			if err := i.Init(context.Background()); err != nil {
+				return errors.Wrap(err, "Cannot provision ndd webhooks")
+			}
+			if err := controllers.SetupWebhooks(mgr, logging.NewLogrLogger(zlog.WithName("webhook"))); err != nil {
+				return errors.Wrap(err, "Cannot add ndd webhooks to manager")
+			}
+		}
+
+		collectorOpts, err := subscriptionOptions()
+		if err != nil {
+			return errors.Wrap(err, "Cannot configure gnmi subscriptions")
+		}
 		d := collector.NewDeviationServer(
 			collector.WithEventChannels(eventChans),
 			collector.WithTargetUpdateChannel(tuChan),
 			collector.WithLogging(logging.NewLogrLogger(zlog.WithName("srl"))),
+			collector.WithKubeClient(mgr.GetClient()),
+			collector.WithCollectorOptions(collectorOpts...),
 		)
-		go func() {
-			d.StartTargetChangeHandler()
-		}()
+		// the deviation server runs with the manager, on the leader only when
+		// leader election is enabled
+		if err := mgr.Add(d); err != nil {
+			return errors.Wrap(err, "Cannot add deviation server to manager")
+		}
 
 		// +kubebuilder:scaffold:builder
 
@@ -121,6 +178,30 @@ func init() {
 	startCmd.Flags().DurationVarP(&pollInterval, "poll-interval", "", 1*time.Minute, "Poll interval controls how often an individual resource should be checked for drift.")
 	startCmd.Flags().StringVarP(&namespace, "namespace", "n", os.Getenv("POD_NAMESPACE"), "Namespace used to unpack and run packages.")
 	startCmd.Flags().StringVarP(&podname, "podname", "", os.Getenv("POD_NAME"), "Name from the pod")
+	startCmd.Flags().BoolVarP(&enableWebhooks, "enable-webhooks", "", true, "Serve the admission webhooks that validate the resources before they are stored, the certificate, service and webhook configurations are provisioned at startup.")
+	startCmd.Flags().StringVarP(&webhookCertDir, "webhook-cert-dir", "", "/tmp/k8s-webhook-server/serving-certs", "Directory that contains the tls.crt and tls.key the webhook server serves with, the certificates are reloaded when they change.")
+	startCmd.Flags().StringSliceVarP(&encodings, "gnmi-encodings", "", []string{"json_ietf_config_only", "json_ietf", "json"}, "Encodings of the gnmi subscriptions in order of preference, the first one the device driver supports is used.")
+	startCmd.Flags().DurationVarP(&gnmiIdleTimeout, "gnmi-idle-timeout", "", 10*time.Minute, "Time after which a gnmi connection to a device driver that is not used is closed.")
+}
+
+// subscriptionOptions returns the collector options of the gnmi subscription
+// flags, the config subscriptions of the deviation server are always
+// ON_CHANGE such that only changes trigger a reconcile
+func subscriptionOptions() ([]collector.DeviceCollectorOption, error) {
+	encs := make([]gnmi.Encoding, 0, len(encodings))
+	for _, e := range encodings {
+		enc, err := collector.ParseEncoding(e)
+		if err != nil {
+			return nil, err
+		}
+		encs = append(encs, enc)
+	}
+	if len(encs) == 0 {
+		return nil, errors.New("no gnmi encoding configured")
+	}
+	return []collector.DeviceCollectorOption{
+		collector.WithEncodings(encs...),
+	}, nil
 }
 
 func nddCtlrOptions(c int) controller.Options {
@@ -5,11 +5,14 @@ go 1.16
 require (
 	github.com/karimra/gnmic v0.18.0
 	github.com/openconfig/gnmi v0.0.0-20210903142221-87b435c38f6a
+	github.com/openconfig/goyang v0.2.7
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.11.0
 	github.com/spf13/cobra v1.1.3
 	github.com/yndd/ndd-core v0.1.1
 	github.com/yndd/ndd-runtime v0.1.1
 	github.com/yndd/ndd-yang v0.1.88
+	google.golang.org/grpc v1.39.0
 	k8s.io/api v0.21.3
 	k8s.io/apiextensions-apiserver v0.21.2
 	k8s.io/apimachinery v0.21.3
@@ -0,0 +1,189 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// refpaths generates the reference paths of a resource from the SR OS yang.
+// The reference paths contain every container and list of the resource subtree
+// and are used to split the data of the resource in fine-grained updates.
+//
+//	go run ./hack/refpaths -yang <dir> -module nokia-conf -path /configure/port \
+//		-var resourceRefPathsConfigurePort -out srosconfigureport_refpaths.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-yang/pkg/container"
+	"github.com/yndd/ndd-yang/pkg/resource"
+)
+
+func main() {
+	var (
+		yangDirs   = flag.String("yang", "", "comma separated list of directories with the yang files")
+		module     = flag.String("module", "nokia-conf", "name of the yang module of the resource")
+		path       = flag.String("path", "", "path of the resource in the yang module, e.g. /configure/port")
+		varName    = flag.String("var", "", "name of the generated variable, e.g. resourceRefPathsConfigurePort")
+		pkg        = flag.String("package", "sros", "package of the generated file")
+		headerFile = flag.String("header-file", "", "file with the header of the generated file")
+		out        = flag.String("out", "", "generated file, stdout when empty")
+	)
+	flag.Parse()
+
+	if err := run(*yangDirs, *module, *path, *varName, *pkg, *headerFile, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "refpaths: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(yangDirs, module, path, varName, pkg, headerFile, out string) error {
+	if yangDirs == "" || path == "" || varName == "" {
+		return errors.New("-yang, -path and -var are mandatory")
+	}
+	for _, d := range strings.Split(yangDirs, ",") {
+		yang.AddPath(d)
+	}
+	ms := yang.NewModules()
+	m, errs := ms.GetModule(module)
+	if len(errs) != 0 {
+		return errors.Wrapf(errs[0], "cannot read yang module %s", module)
+	}
+	e, err := findEntry(m, path)
+	if err != nil {
+		return err
+	}
+
+	header := []byte{}
+	if headerFile != "" {
+		if header, err = ioutil.ReadFile(headerFile); err != nil {
+			return errors.Wrap(err, "cannot read the header file")
+		}
+	}
+	src, err := render(header, pkg, varName, refPaths(e))
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return ioutil.WriteFile(out, src, 0644)
+}
+
+// findEntry returns the entry of the path in the module
+func findEntry(m *yang.Entry, path string) (*yang.Entry, error) {
+	e := m
+	for _, name := range strings.Split(strings.Trim(path, "/"), "/") {
+		next := findChild(e, name)
+		if next == nil {
+			return nil, errors.Errorf("path %s not found in module %s", path, m.Name)
+		}
+		e = next
+	}
+	if !e.IsContainer() && !e.IsList() {
+		return nil, errors.Errorf("path %s is not a container or list", path)
+	}
+	return e, nil
+}
+
+func findChild(e *yang.Entry, name string) *yang.Entry {
+	for _, c := range dataChildren(e) {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// dataChildren returns the configurable children of the entry sorted by name,
+// the children of choices and cases are part of the data tree of the entry
+func dataChildren(e *yang.Entry) []*yang.Entry {
+	children := make([]*yang.Entry, 0, len(e.Dir))
+	for _, c := range e.Dir {
+		switch {
+		case c.IsChoice() || c.IsCase():
+			children = append(children, dataChildren(c)...)
+		case c.ReadOnly():
+		default:
+			children = append(children, c)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].Name < children[j].Name })
+	return children
+}
+
+// refPaths returns the paths of the containers and lists of the entry,
+// depth-first in the order of their names
+func refPaths(e *yang.Entry) []*gnmi.Path {
+	r := resource.NewResource()
+	r.RootContainerEntry = newContainerEntry(e, nil)
+	r.ContainerList = append(r.ContainerList, r.RootContainerEntry.Next)
+	return r.GetInternalHierarchicalPaths()
+}
+
+func newContainerEntry(e *yang.Entry, prev *container.Container) *container.Entry {
+	ce := container.NewEntry(e.Name)
+	if e.IsList() {
+		ce.Key = e.Key
+	}
+	ce.Prev = prev
+	ce.Next = container.NewContainer(e.Name, prev)
+	for _, c := range dataChildren(e) {
+		if c.IsContainer() || c.IsList() {
+			ce.Next.Entries = append(ce.Next.Entries, newContainerEntry(c, ce.Next))
+		}
+	}
+	return ce
+}
+
+// render returns the formatted source of the reference paths variable
+func render(header []byte, pkg, varName string, paths []*gnmi.Path) ([]byte, error) {
+	var b bytes.Buffer
+	if len(header) != 0 {
+		fmt.Fprintf(&b, "%s\n\n", bytes.TrimSpace(header))
+	}
+	fmt.Fprintf(&b, "// Code generated by hack/refpaths. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/openconfig/gnmi/proto/gnmi\"\n\n")
+	fmt.Fprintf(&b, "var %s = []*gnmi.Path{\n", varName)
+	for _, p := range paths {
+		fmt.Fprintf(&b, "{\nElem: []*gnmi.PathElem{\n")
+		for _, elem := range p.GetElem() {
+			if len(elem.GetKey()) == 0 {
+				fmt.Fprintf(&b, "{Name: %q},\n", elem.GetName())
+				continue
+			}
+			keys := make([]string, 0, len(elem.GetKey()))
+			for k := range elem.GetKey() {
+				keys = append(keys, fmt.Sprintf("%q: \"\"", k))
+			}
+			sort.Strings(keys)
+			fmt.Fprintf(&b, "{Name: %q, Key: map[string]string{%s}},\n", elem.GetName(), strings.Join(keys, ", "))
+		}
+		fmt.Fprintf(&b, "},\n},\n")
+	}
+	fmt.Fprintf(&b, "}\n")
+	src, err := format.Source(b.Bytes())
+	return src, errors.Wrap(err, "cannot format the generated source")
+}
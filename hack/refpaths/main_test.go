@@ -0,0 +1,96 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRun generates the reference paths of the example port, the paths are
+// sorted depth-first by name, choices are part of the data tree, state is
+// skipped and lists are keyed by their first key
+func TestRun(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "refpaths.go")
+	if err := run("testdata", "example-conf", "/configure/port", "resourceRefPathsExample", "example", "", out); err != nil {
+		t.Fatalf("run(): %v", err)
+	}
+	got, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `// Code generated by hack/refpaths. DO NOT EDIT.
+
+package example
+
+import "github.com/openconfig/gnmi/proto/gnmi"
+
+var resourceRefPathsExample = []*gnmi.Path{
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "port", Key: map[string]string{"port-id": ""}},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "port", Key: map[string]string{"port-id": ""}},
+			{Name: "access"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "port", Key: map[string]string{"port-id": ""}},
+			{Name: "ethernet"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "port", Key: map[string]string{"port-id": ""}},
+			{Name: "ethernet"},
+			{Name: "dot1q"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "port", Key: map[string]string{"port-id": ""}},
+			{Name: "ethernet"},
+			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+		},
+	},
+}
+`
+	if string(got) != want {
+		t.Errorf("run(): got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRunInvalidPath(t *testing.T) {
+	cases := map[string]string{
+		"NotFound": "/configure/card",
+		"Leaf":     "/configure/port/description",
+	}
+	for name, path := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := run("testdata", "example-conf", path, "resourceRefPathsExample", "example", "", "")
+			if err == nil || !strings.Contains(err.Error(), path) {
+				t.Errorf("run(): got %v, want an error for %s", err, path)
+			}
+		})
+	}
+}
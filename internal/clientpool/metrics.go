@@ -0,0 +1,60 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientpool
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// results of a dial
+	dialResultSuccess = "success"
+	dialResultFailure = "failure"
+
+	// reasons a client is closed
+	evictReasonConfigChanged = "config_changed"
+	evictReasonIdle          = "idle"
+	evictReasonUnhealthy     = "unhealthy"
+	evictReasonDeleted       = "deleted"
+	evictReasonStopped       = "stopped"
+)
+
+var (
+	openConnections = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "sros",
+		Subsystem: "gnmi_client_pool",
+		Name:      "open_connections",
+		Help:      "Number of open gnmi connections to device drivers",
+	})
+	dialsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "sros",
+		Subsystem: "gnmi_client_pool",
+		Name:      "dials_total",
+		Help:      "Total number of gnmi connections dialed to device drivers by result",
+	}, []string{"result"})
+	evictionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "sros",
+		Subsystem: "gnmi_client_pool",
+		Name:      "evictions_total",
+		Help:      "Total number of gnmi connections closed by reason",
+	}, []string{"reason"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(openConnections, dialsTotal, evictionsTotal)
+}
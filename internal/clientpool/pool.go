@@ -0,0 +1,446 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientpool
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	"github.com/karimra/gnmic/types"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	kcache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// errors
+	errCreateTLSConfig   = "cannot create tls config"
+	errDialTarget        = "cannot dial target"
+	errGetInformer       = "cannot get NetworkNode informer"
+	errAddPoolToManager  = "cannot add client pool to manager"
+	errHealthCheckFailed = "health check failed"
+
+	// defaults
+	defaultIdleTimeout         = 10 * time.Minute
+	defaultHealthCheckInterval = 1 * time.Minute
+	defaultHealthCheckTimeout  = 5 * time.Second
+	defaultCloseDelay          = 1 * time.Minute
+	defaultDialTimeout         = 10 * time.Second
+)
+
+// Pool caches a gnmi client per network node, such that the controllers reuse
+// the connection to the device driver across reconciles. A client is replaced
+// when the target config of the network node changes, which is the case when
+// its credentials change, and closed when it is idle, fails its health check
+// or the network node is deleted. The connection of a client that is removed
+// from the pool is closed after the close delay, such that reconciles that got
+// the client before keep using it.
+type Pool struct {
+	log                 logging.Logger
+	idleTimeout         time.Duration
+	healthCheckInterval time.Duration
+	healthCheckTimeout  time.Duration
+	closeDelay          time.Duration
+	dialOpts            []grpc.DialOption
+
+	mu      sync.Mutex
+	clients map[string]*client
+
+	// retired holds the connections of the clients that are removed from the
+	// pool and not closed yet
+	retiredMu sync.Mutex
+	retired   []retiredClient
+}
+
+// retiredClient is a connection that is closed after the close delay
+type retiredClient struct {
+	name   string
+	target *target.Target
+	conn   *grpc.ClientConn
+	since  time.Time
+}
+
+// client is a cached gnmi client of a network node
+type client struct {
+	// mu serializes the creation of the client, such that concurrent reconciles
+	// of the same network node dial once
+	mu sync.Mutex
+	// removed is set when the client is removed from the pool, a Get that
+	// waited for the lock retries with a new client
+	removed bool
+	// fingerprint of the target config the client is created with
+	fingerprint string
+	target      *target.Target
+	conn        *grpc.ClientConn
+	lastUsed    time.Time
+}
+
+// Option is a function to initialize the options of the pool
+type Option func(p *Pool)
+
+// WithLogger initializes the pool with a logger
+func WithLogger(l logging.Logger) Option {
+	return func(p *Pool) {
+		p.log = l
+	}
+}
+
+// WithIdleTimeout initializes the pool with the time after which a client that
+// is not used is closed
+func WithIdleTimeout(d time.Duration) Option {
+	return func(p *Pool) {
+		p.idleTimeout = d
+	}
+}
+
+// WithHealthCheckInterval initializes the pool with the interval at which the
+// clients are checked
+func WithHealthCheckInterval(d time.Duration) Option {
+	return func(p *Pool) {
+		p.healthCheckInterval = d
+	}
+}
+
+// WithCloseDelay initializes the pool with the time the connection of a client
+// that is removed from the pool stays open for the callers that still use it
+func WithCloseDelay(d time.Duration) Option {
+	return func(p *Pool) {
+		p.closeDelay = d
+	}
+}
+
+// WithDialOptions initializes the pool with grpc dial options that are added
+// to the options derived from the target config
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(p *Pool) {
+		p.dialOpts = append(p.dialOpts, opts...)
+	}
+}
+
+// New returns a new client pool
+func New(opts ...Option) *Pool {
+	p := &Pool{
+		log:                 logging.NewNopLogger(),
+		idleTimeout:         defaultIdleTimeout,
+		healthCheckInterval: defaultHealthCheckInterval,
+		healthCheckTimeout:  defaultHealthCheckTimeout,
+		closeDelay:          defaultCloseDelay,
+		clients:             make(map[string]*client),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// SetupWithManager adds the pool to the manager, which runs the health checks
+// while the manager runs, and closes the client of a network node when the
+// network node is deleted.
+func (p *Pool) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	informer, err := mgr.GetCache().GetInformer(ctx, &ndrv1.NetworkNode{})
+	if err != nil {
+		return errors.Wrap(err, errGetInformer)
+	}
+	informer.AddEventHandler(kcache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj interface{}) {
+			if d, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+				obj = d.Obj
+			}
+			if nn, ok := obj.(*ndrv1.NetworkNode); ok {
+				p.evict(nn.GetName(), evictReasonDeleted)
+			}
+		},
+	})
+	return errors.Wrap(mgr.Add(p), errAddPoolToManager)
+}
+
+// Get returns the client of the network node the target config belongs to, a
+// new client is created when there is none or when the target config changed.
+func (p *Pool) Get(ctx context.Context, cfg *types.TargetConfig) (*target.Target, error) {
+	fingerprint := getFingerprint(cfg)
+
+	c := p.lock(cfg.Name)
+	defer c.mu.Unlock()
+	if c.target != nil && c.fingerprint == fingerprint {
+		c.lastUsed = time.Now()
+		return c.target, nil
+	}
+	if c.target != nil {
+		p.log.Debug("Target config changed, replacing client", "target", cfg.Name)
+		p.close(cfg.Name, c, evictReasonConfigChanged)
+	}
+
+	conn, err := Dial(ctx, cfg, p.dialOpts...)
+	if err != nil {
+		dialsTotal.WithLabelValues(dialResultFailure).Inc()
+		return nil, errors.Wrap(err, errDialTarget)
+	}
+	dialsTotal.WithLabelValues(dialResultSuccess).Inc()
+	openConnections.Inc()
+
+	t := target.NewTarget(cfg)
+	t.Client = gnmi.NewGNMIClient(conn)
+	c.fingerprint = fingerprint
+	c.target = t
+	c.conn = conn
+	c.lastUsed = time.Now()
+	p.log.Debug("Created client", "target", cfg.Name)
+	return t, nil
+}
+
+// lock returns the locked client of the network node, a client is added to the
+// pool when there is none
+func (p *Pool) lock(name string) *client {
+	for {
+		p.mu.Lock()
+		c, ok := p.clients[name]
+		if !ok {
+			c = &client{}
+			p.clients[name] = c
+		}
+		p.mu.Unlock()
+
+		c.mu.Lock()
+		if !c.removed {
+			return c
+		}
+		c.mu.Unlock()
+	}
+}
+
+// evict removes the client of the network node from the pool and closes it
+func (p *Pool) evict(name, reason string) {
+	p.mu.Lock()
+	c, ok := p.clients[name]
+	delete(p.clients, name)
+	p.mu.Unlock()
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removed = true
+	p.close(name, c, reason)
+}
+
+// Start runs the health checks until the context is done, after which all
+// clients are closed. It implements the manager.Runnable interface.
+func (p *Pool) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			p.log.Debug("Stopping client pool")
+			for _, name := range p.names() {
+				p.evict(name, evictReasonStopped)
+			}
+			p.closeRetired(time.Time{})
+			return nil
+		case <-ticker.C:
+			p.checkHealth(ctx)
+			p.closeRetired(time.Now().Add(-p.closeDelay))
+		}
+	}
+}
+
+// checkHealth closes the clients that are idle or unhealthy and removes them
+// from the pool, together with the clients that have no connection, such as
+// the client of a network node that failed to dial and no longer exists. The
+// health check runs without the lock of the client, such that a device driver
+// that does not answer does not block the reconciles that get the client.
+func (p *Pool) checkHealth(ctx context.Context) {
+	for _, name := range p.names() {
+		p.mu.Lock()
+		c, ok := p.clients[name]
+		p.mu.Unlock()
+		if !ok {
+			continue
+		}
+		c.mu.Lock()
+		if c.target == nil {
+			p.remove(name, c)
+			c.mu.Unlock()
+			continue
+		}
+		if time.Since(c.lastUsed) > p.idleTimeout {
+			p.log.Debug("Closing idle client", "target", name)
+			p.close(name, c, evictReasonIdle)
+			p.remove(name, c)
+			c.mu.Unlock()
+			continue
+		}
+		t, conn := c.target, c.conn
+		c.mu.Unlock()
+
+		err := p.healthCheck(ctx, t, conn)
+		if err == nil {
+			continue
+		}
+		c.mu.Lock()
+		// the client is left alone when it got replaced or evicted during the
+		// health check
+		if c.target == t {
+			p.log.Debug("Closing unhealthy client", "target", name, "error", err)
+			p.close(name, c, evictReasonUnhealthy)
+			p.remove(name, c)
+		}
+		c.mu.Unlock()
+	}
+}
+
+// healthCheck returns an error when the connection of the client failed or the
+// device driver does not answer a capabilities request
+func (p *Pool) healthCheck(ctx context.Context, t *target.Target, conn *grpc.ClientConn) error {
+	if state := conn.GetState(); state == connectivity.TransientFailure || state == connectivity.Shutdown {
+		return fmt.Errorf("%s: connection state %s", errHealthCheckFailed, state)
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.healthCheckTimeout)
+	defer cancel()
+	if _, err := t.Capabilities(ctx); err != nil {
+		return errors.Wrap(err, errHealthCheckFailed)
+	}
+	return nil
+}
+
+// remove removes the client from the pool, a Get that waits for the lock of the
+// client retries with a new client. The caller holds the lock of the client.
+func (p *Pool) remove(name string, c *client) {
+	p.mu.Lock()
+	if p.clients[name] == c {
+		delete(p.clients, name)
+	}
+	p.mu.Unlock()
+	c.removed = true
+}
+
+// close removes the connection from the client, the connection is closed by
+// closeRetired after the close delay. The caller holds the lock of the client.
+func (p *Pool) close(name string, c *client, reason string) {
+	if c.target == nil {
+		return
+	}
+	p.retiredMu.Lock()
+	p.retired = append(p.retired, retiredClient{name: name, target: c.target, conn: c.conn, since: time.Now()})
+	p.retiredMu.Unlock()
+	c.target = nil
+	c.conn = nil
+	c.fingerprint = ""
+	evictionsTotal.WithLabelValues(reason).Inc()
+}
+
+// closeRetired closes the connections that were removed from the pool before
+// the time, a zero time closes all of them
+func (p *Pool) closeRetired(before time.Time) {
+	p.retiredMu.Lock()
+	retired := make([]retiredClient, 0, len(p.retired))
+	keep := p.retired[:0]
+	for _, r := range p.retired {
+		if before.IsZero() || r.since.Before(before) {
+			retired = append(retired, r)
+			continue
+		}
+		keep = append(keep, r)
+	}
+	p.retired = keep
+	p.retiredMu.Unlock()
+
+	for _, r := range retired {
+		r.target.Stop()
+		if err := r.conn.Close(); err != nil {
+			p.log.Debug("Cannot close client", "target", r.name, "error", err)
+		}
+		openConnections.Dec()
+	}
+}
+
+// names returns the names of the network nodes that have a client
+func (p *Pool) names() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	names := make([]string, 0, len(p.clients))
+	for name := range p.clients {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Dial creates the grpc connection of the target config the same way as
+// target.CreateGNMIClient does, but returns the connection such that it can be
+// closed. The dial blocks until the connection is up or the timeout of the
+// target config expires. The additional dial options are appended to the
+// options derived from the target config.
+func Dial(ctx context.Context, cfg *types.TargetConfig, dialOpts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts := make([]grpc.DialOption, 0, 3+len(dialOpts))
+	opts = append(opts, grpc.WithBlock())
+	if cfg.Insecure != nil && *cfg.Insecure {
+		opts = append(opts, grpc.WithInsecure())
+	} else {
+		tlsConfig, err := cfg.NewTLS()
+		if err != nil {
+			return nil, errors.Wrap(err, errCreateTLSConfig)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	opts = append(opts, dialOpts...)
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultDialTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return grpc.DialContext(ctx, cfg.Address, opts...)
+}
+
+// getFingerprint returns a hash of the parameters of the target config that
+// determine the connection, which includes the credentials and the files of
+// the tls material
+func getFingerprint(cfg *types.TargetConfig) string {
+	h := sha256.New()
+	for _, v := range []string{
+		cfg.Address,
+		stringValue(cfg.Username),
+		stringValue(cfg.Password),
+		stringValue(cfg.TLSCA),
+		stringValue(cfg.TLSCert),
+		stringValue(cfg.TLSKey),
+		fmt.Sprintf("%t", cfg.SkipVerify != nil && *cfg.SkipVerify),
+		fmt.Sprintf("%t", cfg.Insecure != nil && *cfg.Insecure),
+	} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
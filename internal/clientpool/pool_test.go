@@ -0,0 +1,273 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientpool
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	"github.com/karimra/gnmic/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+func testTargetConfig(name, password string) *types.TargetConfig {
+	return &types.TargetConfig{
+		Name:       name,
+		Address:    name + ":57400",
+		Username:   utils.StringPtr("admin"),
+		Password:   utils.StringPtr(password),
+		Timeout:    10 * time.Second,
+		Insecure:   utils.BoolPtr(true),
+		SkipVerify: utils.BoolPtr(false),
+		TLSCA:      utils.StringPtr(""),
+		TLSCert:    utils.StringPtr(""),
+		TLSKey:     utils.StringPtr(""),
+		Gzip:       utils.BoolPtr(false),
+	}
+}
+
+func capabilities(ctx context.Context, t *target.Target) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	_, err := t.Capabilities(ctx)
+	return err
+}
+
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	p := New(WithDialOptions(dd.Dialer()))
+
+	t1, err := p.Get(ctx, testTargetConfig("sr1", "admin"))
+	if err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	if err := capabilities(ctx, t1); err != nil {
+		t.Fatalf("the client cannot reach the device driver: %v", err)
+	}
+	t2, err := p.Get(ctx, testTargetConfig("sr1", "admin"))
+	if err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	if t1 != t2 {
+		t.Error("Get(): the client of an unchanged target config must be reused")
+	}
+	t3, err := p.Get(ctx, testTargetConfig("sr1", "changed"))
+	if err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	if t3 == t1 {
+		t.Error("Get(): a changed target config must result in a new client")
+	}
+	// the replaced client stays usable for the reconciles that got it before
+	if err := capabilities(ctx, t1); err != nil {
+		t.Errorf("the replaced client is closed before the close delay: %v", err)
+	}
+}
+
+// TestGetDialTimeout dials a device driver that never answers, the dial must
+// give up after the timeout of the target config and count as a failure
+func TestGetDialTimeout(t *testing.T) {
+	hang := grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	p := New(WithDialOptions(hang))
+	cfg := testTargetConfig("sr1", "admin")
+	cfg.Timeout = 100 * time.Millisecond
+
+	failures := testutil.ToFloat64(dialsTotal.WithLabelValues(dialResultFailure))
+	start := time.Now()
+	if _, err := p.Get(context.Background(), cfg); err == nil {
+		t.Fatal("Get(): a device driver that does not answer must fail the dial")
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("Get(): the dial took %s, want about the timeout of the target config", d)
+	}
+	if got := testutil.ToFloat64(dialsTotal.WithLabelValues(dialResultFailure)) - failures; got != 1 {
+		t.Errorf("failed dials: got %v, want 1", got)
+	}
+}
+
+// TestGetConcurrent gets the client of the same network node concurrently,
+// the device driver is dialed once
+func TestGetConcurrent(t *testing.T) {
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	var dials int32
+	counting := grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		atomic.AddInt32(&dials, 1)
+		return dd.Dial(ctx, addr)
+	})
+	p := New(WithDialOptions(counting))
+
+	var wg sync.WaitGroup
+	targets := make([]*target.Target, 16)
+	for i := range targets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cl, err := p.Get(context.Background(), testTargetConfig("sr1", "admin"))
+			if err != nil {
+				t.Errorf("Get(): %v", err)
+				return
+			}
+			targets[i] = cl
+		}(i)
+	}
+	wg.Wait()
+	for _, cl := range targets {
+		if cl != targets[0] {
+			t.Fatal("Get(): concurrent gets must return the same client")
+		}
+	}
+	if got := atomic.LoadInt32(&dials); got != 1 {
+		t.Errorf("dials: got %d, want 1", got)
+	}
+}
+
+// TestEvictCloseDelay evicts a client that is in use, its connection is only
+// closed after the close delay
+func TestEvictCloseDelay(t *testing.T) {
+	ctx := context.Background()
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	p := New(WithDialOptions(dd.Dialer()), WithCloseDelay(time.Hour))
+
+	cl, err := p.Get(ctx, testTargetConfig("sr1", "admin"))
+	if err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	conn := p.clients["sr1"].conn
+	p.evict("sr1", evictReasonDeleted)
+	if err := capabilities(ctx, cl); err != nil {
+		t.Fatalf("the evicted client is closed while in use: %v", err)
+	}
+
+	p.closeRetired(time.Now().Add(-time.Hour))
+	if conn.GetState() == connectivity.Shutdown {
+		t.Fatal("the evicted client is closed before the close delay")
+	}
+	p.closeRetired(time.Now().Add(time.Second))
+	if conn.GetState() != connectivity.Shutdown {
+		t.Error("the evicted client is not closed after the close delay")
+	}
+	if len(p.retired) != 0 {
+		t.Errorf("retired clients: got %d, want 0", len(p.retired))
+	}
+}
+
+// TestStartClosesClients stops the pool, all clients are closed without
+// waiting for the close delay
+func TestStartClosesClients(t *testing.T) {
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	p := New(WithDialOptions(dd.Dialer()), WithCloseDelay(time.Hour))
+	if _, err := p.Get(context.Background(), testTargetConfig("sr1", "admin")); err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	conn := p.clients["sr1"].conn
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := p.Start(ctx); err != nil {
+		t.Fatalf("Start(): %v", err)
+	}
+	if conn.GetState() != connectivity.Shutdown {
+		t.Error("Start(): the clients must be closed when the pool stops")
+	}
+	if len(p.names()) != 0 {
+		t.Errorf("Start(): clients left in the pool: %v", p.names())
+	}
+}
+
+// TestCheckHealthWithoutLock checks a client whose device driver does not
+// answer, the client can be used while the health check waits
+func TestCheckHealthWithoutLock(t *testing.T) {
+	ctx := context.Background()
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	p := New(WithDialOptions(dd.Dialer()), WithCloseDelay(time.Hour))
+	p.healthCheckTimeout = time.Hour
+	cl, err := p.Get(ctx, testTargetConfig("sr1", "admin"))
+	if err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+
+	unblock := make(chan struct{})
+	waiting := dd.BlockCapabilities(unblock)
+	checked := make(chan struct{})
+	go func() {
+		p.checkHealth(ctx)
+		close(checked)
+	}()
+	<-waiting
+
+	got := make(chan *target.Target)
+	go func() {
+		cl, err := p.Get(ctx, testTargetConfig("sr1", "admin"))
+		if err != nil {
+			t.Errorf("Get(): %v", err)
+		}
+		got <- cl
+	}()
+	select {
+	case g := <-got:
+		if g != cl {
+			t.Error("Get(): the client must be reused while it is checked")
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Get(): blocked by the health check")
+	}
+	close(unblock)
+	<-checked
+	if len(p.names()) != 1 {
+		t.Errorf("checkHealth(): clients in the pool: got %v, want [sr1]", p.names())
+	}
+}
+
+// TestCheckHealthRemovesClients checks a pool with an idle client and an empty
+// client, such as the client of a network node that failed to dial, both are
+// removed from the pool
+func TestCheckHealthRemovesClients(t *testing.T) {
+	ctx := context.Background()
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	p := New(WithDialOptions(dd.Dialer()), WithCloseDelay(time.Hour))
+	if _, err := p.Get(ctx, testTargetConfig("sr1", "admin")); err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	p.lock("sr2").mu.Unlock()
+	if len(p.names()) != 2 {
+		t.Fatalf("clients in the pool: got %v, want 2", p.names())
+	}
+
+	p.idleTimeout = 0
+	p.checkHealth(ctx)
+	if len(p.names()) != 0 {
+		t.Errorf("checkHealth(): clients left in the pool: %v", p.names())
+	}
+	if len(p.retired) != 1 {
+		t.Errorf("checkHealth(): retired clients: got %d, want 1", len(p.retired))
+	}
+	p.closeRetired(time.Time{})
+}
@@ -18,6 +18,7 @@ package collector
 
 import (
 	"context"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -25,24 +26,38 @@ import (
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/pkg/errors"
 	"github.com/yndd/ndd-runtime/pkg/logging"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
 	defaultTargetReceivebuffer = 1000
 	defaultLockRetry           = 5 * time.Second
 	defaultRetryTimer          = 10 * time.Second
+	defaultMaxRetryTimer       = 5 * time.Minute
+	// number of consecutive failed attempts after which the target is redialed
+	defaultRedialAttempts = 3
+	// interval at which a POLL subscription is polled without sample interval
+	defaultPollInterval = 10 * time.Second
+	// dscp marking of the subscriptions
+	defaultQos = 21
 
 	// errors
 	errCreateSubscriptionRequest = "cannot create subscription request"
+	errSubscriptionExists        = "subscription already exists"
+	errCreateSubscribeClient     = "cannot create subscribe client"
+	errSendSubscribeRequest      = "cannot send subscribe request"
+	errReceiveSubscribeResponse  = "cannot receive subscribe response"
+	errRedialTarget              = "cannot redial target"
+	errGetCapabilities           = "cannot get capabilities"
+	errSelectEncoding            = "cannot select encoding"
+	errSendPollRequest           = "cannot send poll request"
 )
 
 // Collector defines the interfaces for the collector
 type Collector interface {
-	Lock()
-	Unlock()
 	GetSubscription(subName string) bool
-	StopSubscription(ctx context.Context, sub string) error
-	StartSubscription(ctx context.Context, subName string, sub []string) error
+	StopSubscription(ctx context.Context, subName string) error
+	StartSubscription(ctx context.Context, target, subName string, paths []*gnmi.Path) error
 }
 
 // DeviceCollectorOption can be used to manipulate Options.
@@ -55,21 +70,102 @@ func WithDeviceCollectorLogger(log logging.Logger) DeviceCollectorOption {
 	}
 }
 
-// GNMICollector defines the parameters for the collector
+// WithResponseHandler specifies the function the responses of the
+// subscriptions are passed to.
+func WithResponseHandler(fn func(subName string, resp *gnmi.SubscribeResponse)) DeviceCollectorOption {
+	return func(o *GNMICollector) {
+		o.handleResponse = fn
+	}
+}
+
+// WithStateHandler specifies the function that is called when a subscription
+// connects or fails, err holds the reason the subscription failed.
+func WithStateHandler(fn func(subName string, connected bool, err error)) DeviceCollectorOption {
+	return func(o *GNMICollector) {
+		o.handleState = fn
+	}
+}
+
+// WithRedial specifies the function that returns a client on a new
+// connection, it is used when subscribing on the current client keeps failing.
+func WithRedial(fn func(ctx context.Context) (gnmi.GNMIClient, error)) DeviceCollectorOption {
+	return func(o *GNMICollector) {
+		o.redial = fn
+	}
+}
+
+// WithSubscriptionMode specifies the mode of the subscriptions, the default is
+// ON_CHANGE.
+func WithSubscriptionMode(m SubscriptionMode) DeviceCollectorOption {
+	return func(o *GNMICollector) {
+		o.subscribeOptions.Mode = m
+	}
+}
+
+// WithSampleInterval specifies the interval at which the values are sent in
+// SAMPLE mode and polled in POLL mode.
+func WithSampleInterval(d time.Duration) DeviceCollectorOption {
+	return func(o *GNMICollector) {
+		o.subscribeOptions.SampleInterval = d
+	}
+}
+
+// WithHeartbeatInterval specifies the interval at which the values are sent
+// even when they did not change.
+func WithHeartbeatInterval(d time.Duration) DeviceCollectorOption {
+	return func(o *GNMICollector) {
+		o.subscribeOptions.HeartbeatInterval = d
+	}
+}
+
+// WithSuppressRedundant specifies that values that did not change are not sent
+// in SAMPLE mode.
+func WithSuppressRedundant(b bool) DeviceCollectorOption {
+	return func(o *GNMICollector) {
+		o.subscribeOptions.SuppressRedundant = b
+	}
+}
+
+// WithQos specifies the DSCP marking of the subscriptions, 0 requests no
+// marking.
+func WithQos(marking uint32) DeviceCollectorOption {
+	return func(o *GNMICollector) {
+		o.subscribeOptions.Qos = marking
+	}
+}
+
+// WithEncodings specifies the encodings in order of preference, the first
+// encoding the target advertises in its capabilities is used.
+func WithEncodings(e ...gnmi.Encoding) DeviceCollectorOption {
+	return func(o *GNMICollector) {
+		o.subscribeOptions.Encodings = e
+	}
+}
+
+// GNMICollector defines the parameters for the collector, it is safe for
+// concurrent use. A subscription that fails is retried with exponential
+// backoff, starting at RetryTimer up to MaxRetryTimer.
 type GNMICollector struct {
 	TargetReceiveBuffer uint
 	RetryTimer          time.Duration
+	MaxRetryTimer       time.Duration
+	RedialAttempts      int
 	Target              *target.Target
-	//targetSubRespChan   chan *collector.SubscribeResponse
-	//targetSubErrChan    chan *collector.TargetError
-	Subscriptions map[string]*Subscription
-	Mutex         sync.RWMutex
-	log           logging.Logger
+	log                 logging.Logger
+
+	subscribeOptions SubscribeOptions
+	handleResponse   func(subName string, resp *gnmi.SubscribeResponse)
+	handleState      func(subName string, connected bool, err error)
+	redial           func(ctx context.Context) (gnmi.GNMIClient, error)
+
+	// mu protects the subscriptions and the client of the target
+	mu            sync.Mutex
+	subscriptions map[string]*Subscription
 }
 
 // Subscription defines the parameters for the subscription
 type Subscription struct {
-	StopCh   chan bool
+	// CancelFn stops the subscription
 	CancelFn context.CancelFunc
 }
 
@@ -77,10 +173,21 @@ type Subscription struct {
 func NewGNMICollector(t *target.Target, opts ...DeviceCollectorOption) *GNMICollector {
 	c := &GNMICollector{
 		Target:              t,
-		Subscriptions:       make(map[string]*Subscription),
-		Mutex:               sync.RWMutex{},
+		subscriptions:       make(map[string]*Subscription),
 		TargetReceiveBuffer: defaultTargetReceivebuffer,
 		RetryTimer:          defaultRetryTimer,
+		MaxRetryTimer:       defaultMaxRetryTimer,
+		RedialAttempts:      defaultRedialAttempts,
+		log:                 logging.NewNopLogger(),
+		subscribeOptions: SubscribeOptions{
+			Mode: SubscriptionModeOnChange,
+			Qos:  defaultQos,
+			Encodings: []gnmi.Encoding{
+				EncodingJSONIETFConfigOnly,
+				gnmi.Encoding_JSON_IETF,
+				gnmi.Encoding_JSON,
+			},
+		},
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -88,66 +195,208 @@ func NewGNMICollector(t *target.Target, opts ...DeviceCollectorOption) *GNMIColl
 	return c
 }
 
-// Lock locks a gnmi collector
-func (c *GNMICollector) Lock() {
-	c.Mutex.RLock()
-}
-
-// Unlock unlocks a gnmi collector
-func (c *GNMICollector) Unlock() {
-	c.Mutex.RUnlock()
-}
-
-// GetSubscription returns a bool based on a subscription name
+// GetSubscription returns true when the subscription exists
 func (c *GNMICollector) GetSubscription(subName string) bool {
-	if _, ok := c.Subscriptions[subName]; !ok {
-		return true
-	}
-	return false
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.subscriptions[subName]
+	return ok
 }
 
-// StopSubscription stops a subscription
-func (c *GNMICollector) StopSubscription(ctx context.Context, sub string) error {
-	c.log.WithValues("subscription", sub)
-	c.log.Debug("subscription stop...")
-	c.Subscriptions[sub].StopCh <- true // trigger quit
-
-	c.log.Debug("subscription stopped")
+// StopSubscription stops a subscription, stopping a subscription that does not
+// exist is not an error
+func (c *GNMICollector) StopSubscription(ctx context.Context, subName string) error {
+	log := c.log.WithValues("subscription", subName)
+	log.Debug("subscription stop...")
+	c.mu.Lock()
+	sub, ok := c.subscriptions[subName]
+	delete(c.subscriptions, subName)
+	c.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	sub.CancelFn()
+	log.Debug("subscription stopped")
 	return nil
 }
 
-// StartSubscription starts a subscription
+// StartSubscription starts a subscription on the paths, the responses are
+// passed to the response handler. The subscription is retried when it fails
+// and runs until it is stopped or the context is done.
 func (c *GNMICollector) StartSubscription(dctx context.Context, target, subName string, paths []*gnmi.Path) error {
 	log := c.log.WithValues("subscription", subName, "Paths", paths)
 	log.Debug("subscription start...")
-	// initialize new subscription
-	ctx, cancel := context.WithCancel(dctx)
 
-	c.Subscriptions[subName] = &Subscription{
-		StopCh:   make(chan bool),
-		CancelFn: cancel,
+	// the request is validated here, the encoding is selected from the
+	// capabilities of the target whenever it is subscribed
+	if _, err := CreateSubscriptionRequest(target, subName, paths, c.subscribeOptions, 0); err != nil {
+		log.Debug(errCreateSubscriptionRequest, "error", err)
+		return errors.Wrap(err, errCreateSubscriptionRequest)
 	}
 
-	req, err := CreateSubscriptionRequest(target, subName, paths)
-	if err != nil {
-		c.log.Debug(errCreateSubscriptionRequest, "error", err)
-		return errors.Wrap(err, errCreateSubscriptionRequest)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.subscriptions[subName]; ok {
+		return errors.Errorf("%s: %s", errSubscriptionExists, subName)
 	}
+	// initialize new subscription
+	ctx, cancel := context.WithCancel(dctx)
+	sub := &Subscription{CancelFn: cancel}
+	c.subscriptions[subName] = sub
 
 	go func() {
-		c.Target.Subscribe(ctx, req, subName)
+		c.run(ctx, target, subName, paths)
+		// the subscription ended, it is removed unless it got replaced
+		c.mu.Lock()
+		if c.subscriptions[subName] == sub {
+			delete(c.subscriptions, subName)
+		}
+		c.mu.Unlock()
+		cancel()
+		log.Debug("subscription ended")
 	}()
 	log.Debug("subscription started ...")
+	return nil
+}
+
+// run subscribes until the context is done, a failed subscription is retried
+// with backoff and the target is redialed after RedialAttempts consecutive
+// failures
+func (c *GNMICollector) run(ctx context.Context, target, subName string, paths []*gnmi.Path) {
+	log := c.log.WithValues("subscription", subName)
+	attempt := 0
+	for {
+		connected, err := c.receive(ctx, target, subName, paths)
+		if ctx.Err() != nil {
+			return
+		}
+		// the backoff starts over when the subscription was connected
+		if connected {
+			attempt = 0
+		}
+		attempt++
+		c.setState(subName, false, err)
+
+		delay := retryDelay(c.RetryTimer, c.MaxRetryTimer, attempt)
+		log.Debug("subscription failed, retrying", "error", err, "attempt", attempt, "retry", delay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		if c.redial != nil && c.RedialAttempts > 0 && attempt%c.RedialAttempts == 0 {
+			log.Debug("redialing target", "attempt", attempt)
+			client, err := c.redial(ctx)
+			if err != nil {
+				log.Debug(errRedialTarget, "error", err)
+				continue
+			}
+			c.mu.Lock()
+			c.Target.Client = client
+			c.mu.Unlock()
+		}
+	}
+}
 
+// receive subscribes once and passes the responses to the response handler
+// until the subscription fails, it returns true when the subscription got
+// connected, which is the case when a response was received. The encoding is
+// selected from the capabilities of the target, which can change when the
+// device driver restarts.
+func (c *GNMICollector) receive(ctx context.Context, target, subName string, paths []*gnmi.Path) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c.mu.Lock()
+	client := c.Target.Client
+	c.mu.Unlock()
+
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		"username", stringPtrValue(c.Target.Config.Username),
+		"password", stringPtrValue(c.Target.Config.Password))
+	capRsp, err := client.Capabilities(ctx, &gnmi.CapabilityRequest{})
+	if err != nil {
+		return false, errors.Wrap(err, errGetCapabilities)
+	}
+	encoding, err := selectEncoding(c.subscribeOptions.Encodings, capRsp.GetSupportedEncodings())
+	if err != nil {
+		return false, errors.Wrap(err, errSelectEncoding)
+	}
+	req, err := CreateSubscriptionRequest(target, subName, paths, c.subscribeOptions, encoding)
+	if err != nil {
+		return false, errors.Wrap(err, errCreateSubscriptionRequest)
+	}
+
+	subscribeClient, err := client.Subscribe(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, errCreateSubscribeClient)
+	}
+	if err := subscribeClient.Send(req); err != nil {
+		return false, errors.Wrap(err, errSendSubscribeRequest)
+	}
+	if c.subscribeOptions.Mode == SubscriptionModePoll {
+		go c.poll(ctx, subName, subscribeClient)
+	}
+
+	connected := false
+	for {
+		resp, err := subscribeClient.Recv()
+		if err != nil {
+			return connected, errors.Wrap(err, errReceiveSubscribeResponse)
+		}
+		if !connected {
+			connected = true
+			c.setState(subName, true, nil)
+		}
+		if c.handleResponse != nil {
+			c.handleResponse(subName, resp)
+		}
+	}
+}
+
+// setState passes the state of the subscription to the state handler
+func (c *GNMICollector) setState(subName string, connected bool, err error) {
+	if c.handleState != nil {
+		c.handleState(subName, connected, err)
+	}
+}
+
+// retryDelay returns the delay before the retry attempt, which doubles from
+// base with every attempt up to maxDelay, with up to 20% jitter added such
+// that targets that failed together do not retry at the same time
+func retryDelay(base, maxDelay time.Duration, attempt int) time.Duration {
+	d := base
+	for i := 1; i < attempt && d < maxDelay; i++ {
+		d *= 2
+	}
+	if d > maxDelay {
+		d = maxDelay
+	}
+	return d + time.Duration(rand.Int63n(int64(d)/5+1))
+}
+
+// poll sends a poll request at the sample interval until the context is done,
+// the stream failed when a poll request cannot be sent, which the receive loop
+// reports
+func (c *GNMICollector) poll(ctx context.Context, subName string, subscribeClient gnmi.GNMI_SubscribeClient) {
+	interval := c.subscribeOptions.SampleInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 	for {
 		select {
-		case <-c.Subscriptions[subName].StopCh: // execute quit
-			c.Subscriptions[subName].CancelFn()
-			c.Mutex.Lock()
-			delete(c.Subscriptions, subName)
-			c.Mutex.Unlock()
-			c.log.Debug("subscription cancelled")
-			return nil
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := subscribeClient.Send(&gnmi.SubscribeRequest{
+				Request: &gnmi.SubscribeRequest_Poll{Poll: &gnmi.Poll{}},
+			}); err != nil {
+				c.log.Debug(errSendPollRequest, "subscription", subName, "error", err)
+				return
+			}
 		}
 	}
 }
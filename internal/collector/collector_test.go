@@ -0,0 +1,304 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	"github.com/karimra/gnmic/types"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+func testTargetConfig(name, password string) *types.TargetConfig {
+	return &types.TargetConfig{
+		Name:       name,
+		Address:    name + ":57400",
+		Username:   utils.StringPtr("admin"),
+		Password:   utils.StringPtr(password),
+		Timeout:    10 * time.Second,
+		Insecure:   utils.BoolPtr(true),
+		SkipVerify: utils.BoolPtr(false),
+		TLSCA:      utils.StringPtr(""),
+		TLSCert:    utils.StringPtr(""),
+		TLSKey:     utils.StringPtr(""),
+		Gzip:       utils.BoolPtr(false),
+	}
+}
+
+var testPaths = []*gnmi.Path{{Elem: []*gnmi.PathElem{{Name: "provider-resource-update"}}}}
+
+// eventually polls the condition until it holds or the timeout expires
+func eventually(t *testing.T, timeout time.Duration, cond func() bool) bool {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return cond()
+}
+
+func newTestCollector(t *testing.T, dd *gnmitest.DeviceDriver, opts ...DeviceCollectorOption) *GNMICollector {
+	t.Helper()
+	cfg := testTargetConfig("sr1", "admin")
+	conn, err := clientpool.Dial(context.Background(), cfg, dd.Dialer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	tg := target.NewTarget(cfg)
+	tg.Client = gnmi.NewGNMIClient(conn)
+	return NewGNMICollector(tg, opts...)
+}
+
+func TestGetSubscription(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	connected := make(chan struct{}, 1)
+	c := newTestCollector(t, dd, WithStateHandler(func(subName string, ok bool, err error) {
+		if ok {
+			select {
+			case connected <- struct{}{}:
+			default:
+			}
+		}
+	}))
+
+	if c.GetSubscription("sub1") {
+		t.Error("GetSubscription(): an absent subscription must not exist")
+	}
+	if err := c.StartSubscription(ctx, "sr1", "sub1", testPaths); err != nil {
+		t.Fatalf("StartSubscription(): %v", err)
+	}
+	if !c.GetSubscription("sub1") {
+		t.Error("GetSubscription(): a started subscription must exist")
+	}
+	if c.GetSubscription("sub2") {
+		t.Error("GetSubscription(): an absent subscription must not exist")
+	}
+	if err := c.StartSubscription(ctx, "sr1", "sub1", testPaths); err == nil {
+		t.Error("StartSubscription(): starting an existing subscription must fail")
+	}
+	select {
+	case <-connected:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the subscription did not connect")
+	}
+
+	if err := c.StopSubscription(ctx, "sub1"); err != nil {
+		t.Fatalf("StopSubscription(): %v", err)
+	}
+	if c.GetSubscription("sub1") {
+		t.Error("GetSubscription(): a stopped subscription must not exist")
+	}
+	if !eventually(t, 5*time.Second, func() bool { return dd.Subscriptions() == 0 }) {
+		t.Errorf("the stream of the stopped subscription is open: %d streams", dd.Subscriptions())
+	}
+	if err := c.StopSubscription(ctx, "sub1"); err != nil {
+		t.Errorf("StopSubscription(): stopping an absent subscription must succeed: %v", err)
+	}
+}
+
+// TestSubscriptionConcurrent starts, queries and stops subscriptions from
+// many goroutines, run it with -race
+func TestSubscriptionConcurrent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	c := newTestCollector(t, dd)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				name := fmt.Sprintf("sub%d", (i+j)%4)
+				// starting an existing subscription fails, which is expected
+				_ = c.StartSubscription(ctx, "sr1", name, testPaths)
+				c.GetSubscription(name)
+				if j%3 == 0 {
+					if err := c.StopSubscription(ctx, name); err != nil {
+						t.Errorf("StopSubscription(): %v", err)
+					}
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < 4; i++ {
+		if err := c.StopSubscription(ctx, fmt.Sprintf("sub%d", i)); err != nil {
+			t.Errorf("StopSubscription(): %v", err)
+		}
+	}
+	if !eventually(t, 5*time.Second, func() bool { return dd.Subscriptions() == 0 }) {
+		t.Errorf("streams of stopped subscriptions are open: %d streams", dd.Subscriptions())
+	}
+}
+
+// TestHandleTargetUpdateConcurrent adds, restarts and deletes targets from
+// many goroutines while the deviation server runs and then stops it, all
+// subscriptions must be torn down. Run it with -race.
+func TestHandleTargetUpdateConcurrent(t *testing.T) {
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	tuCh := make(chan TargetUpdate)
+	d := NewDeviationServer(
+		WithTargetUpdateChannel(tuCh),
+		WithEventChannels(map[string]chan event.GenericEvent{}),
+		WithDialOptions(dd.Dialer()),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan error)
+	go func() { stopped <- d.Start(ctx) }()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				name := fmt.Sprintf("sr%d", (i+j)%4)
+				tu := TargetUpdate{Name: name, Action: TargetAdd, TargetConfig: testTargetConfig(name, fmt.Sprintf("pw%d", j%2))}
+				if j%5 == 4 {
+					tu = TargetUpdate{Name: name, Action: TargetDelete}
+				}
+				// the updates arrive through the channel, like the registration
+				// controller sends them, and directly
+				if i%2 == 0 {
+					tuCh <- tu
+				} else if err := d.HandleTargetUpdate(ctx, tu); err != nil {
+					t.Errorf("HandleTargetUpdate(%s, %s): %v", tu.Name, tu.Action, err)
+				}
+				d.GetTarget(name)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	cancel()
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Fatalf("Start(): %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Start(): the deviation server did not stop")
+	}
+	for i := 0; i < 4; i++ {
+		if _, ok := d.GetTarget(fmt.Sprintf("sr%d", i)); ok {
+			t.Errorf("target sr%d is left after the deviation server stopped", i)
+		}
+	}
+	if !eventually(t, 5*time.Second, func() bool { return dd.Subscriptions() == 0 }) {
+		t.Errorf("subscriptions are open after the deviation server stopped: %d streams", dd.Subscriptions())
+	}
+}
+
+func TestParseSubscriptionMode(t *testing.T) {
+	for s, want := range map[string]SubscriptionMode{
+		"on_change":      SubscriptionModeOnChange,
+		"ON-CHANGE":      SubscriptionModeOnChange,
+		"sample":         SubscriptionModeSample,
+		"target_defined": SubscriptionModeTargetDefined,
+		"poll":           SubscriptionModePoll,
+	} {
+		if got, err := ParseSubscriptionMode(s); err != nil || got != want {
+			t.Errorf("ParseSubscriptionMode(%q): got %q, %v, want %q", s, got, err, want)
+		}
+	}
+	if _, err := ParseSubscriptionMode("once"); err == nil {
+		t.Error("ParseSubscriptionMode(once): an unknown mode must fail")
+	}
+}
+
+func TestParseEncoding(t *testing.T) {
+	for s, want := range map[string]gnmi.Encoding{
+		"json_ietf_config_only": EncodingJSONIETFConfigOnly,
+		"json-ietf":             gnmi.Encoding_JSON_IETF,
+		"JSON":                  gnmi.Encoding_JSON,
+		"proto":                 gnmi.Encoding_PROTO,
+	} {
+		if got, err := ParseEncoding(s); err != nil || got != want {
+			t.Errorf("ParseEncoding(%q): got %v, %v, want %v", s, got, err, want)
+		}
+	}
+	if _, err := ParseEncoding("xml"); err == nil {
+		t.Error("ParseEncoding(xml): an unknown encoding must fail")
+	}
+}
+
+// TestHandleTargetUpdateCollectorOptions adds a target, its collector
+// subscribes with the collector options of the deviation server, except for the
+// mode and the intervals, the config subscription only reports the changes
+func TestHandleTargetUpdateCollectorOptions(t *testing.T) {
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	d := NewDeviationServer(
+		WithEventChannels(map[string]chan event.GenericEvent{}),
+		WithDialOptions(dd.Dialer()),
+		WithCollectorOptions(
+			WithSubscriptionMode(SubscriptionModeSample),
+			WithSampleInterval(5*time.Second),
+			WithHeartbeatInterval(time.Minute),
+			WithSuppressRedundant(true),
+			WithEncodings(gnmi.Encoding_JSON),
+		),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan error)
+	go func() { stopped <- d.Start(ctx) }()
+
+	if err := d.HandleTargetUpdate(ctx, TargetUpdate{Name: "sr1", Action: TargetAdd, TargetConfig: testTargetConfig("sr1", "admin")}); err != nil {
+		t.Fatalf("HandleTargetUpdate(): %v", err)
+	}
+	tg, ok := d.GetTarget("sr1")
+	if !ok {
+		t.Fatal("HandleTargetUpdate(): the target is not added")
+	}
+	want := SubscribeOptions{
+		Mode:      SubscriptionModeOnChange,
+		Qos:       defaultQos,
+		Encodings: []gnmi.Encoding{gnmi.Encoding_JSON},
+	}
+	if got := tg.Collector.subscribeOptions; !reflect.DeepEqual(got, want) {
+		t.Errorf("subscribe options: got %+v, want %+v", got, want)
+	}
+	if tg.Collector.handleResponse == nil || tg.Collector.handleState == nil || tg.Collector.redial == nil {
+		t.Error("the handlers of the deviation server must be set")
+	}
+
+	cancel()
+	if err := <-stopped; err != nil {
+		t.Fatalf("Start(): %v", err)
+	}
+}
@@ -19,37 +19,122 @@ package collector
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/karimra/gnmic/utils"
 	"github.com/openconfig/gnmi/proto/gnmi"
 )
 
+// EncodingJSONIETFConfigOnly is the encoding of the device drivers that
+// returns json ietf encoded values without the state leafs, it is not part of
+// the gnmi specification
+const EncodingJSONIETFConfigOnly gnmi.Encoding = 46
+
+// SubscriptionMode is the mode in which the paths of a subscription are
+// subscribed
+type SubscriptionMode string
+
+// Subscription modes
+const (
+	// the target sends the values at the sample interval
+	SubscriptionModeSample SubscriptionMode = "SAMPLE"
+	// the target sends the values when they change
+	SubscriptionModeOnChange SubscriptionMode = "ON_CHANGE"
+	// the target decides per path to sample or send the changes
+	SubscriptionModeTargetDefined SubscriptionMode = "TARGET_DEFINED"
+	// the target sends the values when the collector polls
+	SubscriptionModePoll SubscriptionMode = "POLL"
+)
+
+// ParseSubscriptionMode returns the subscription mode with the name, the name
+// is case insensitive
+func ParseSubscriptionMode(s string) (SubscriptionMode, error) {
+	m := SubscriptionMode(strings.ToUpper(strings.ReplaceAll(s, "-", "_")))
+	switch m {
+	case SubscriptionModeSample, SubscriptionModeOnChange, SubscriptionModeTargetDefined, SubscriptionModePoll:
+		return m, nil
+	}
+	return "", fmt.Errorf("unknown subscription mode %q", s)
+}
+
+// ParseEncoding returns the gnmi encoding with the name, the name is case
+// insensitive and JSON_IETF_CONFIG_ONLY is the encoding of the device drivers
+func ParseEncoding(s string) (gnmi.Encoding, error) {
+	name := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	if name == "JSON_IETF_CONFIG_ONLY" {
+		return EncodingJSONIETFConfigOnly, nil
+	}
+	if e, ok := gnmi.Encoding_value[name]; ok {
+		return gnmi.Encoding(e), nil
+	}
+	return 0, fmt.Errorf("unknown encoding %q", s)
+}
+
+// SubscribeOptions defines how the paths of a subscription are subscribed
+type SubscribeOptions struct {
+	// Mode of the subscription
+	Mode SubscriptionMode
+	// SampleInterval at which the target sends the values in SAMPLE mode and
+	// the collector polls in POLL mode
+	SampleInterval time.Duration
+	// HeartbeatInterval at which the target sends the values, even when they
+	// did not change
+	HeartbeatInterval time.Duration
+	// SuppressRedundant suppresses the values that did not change in SAMPLE
+	// mode
+	SuppressRedundant bool
+	// Qos is the DSCP marking of the subscription, no marking is requested
+	// when it is 0
+	Qos uint32
+	// Encodings in order of preference, the first the target advertises is
+	// used
+	Encodings []gnmi.Encoding
+}
+
 // CreateSubscriptionRequest create a gnmi subscription
-func CreateSubscriptionRequest(target, subName string, paths []*gnmi.Path) (*gnmi.SubscribeRequest, error) {
+func CreateSubscriptionRequest(target, subName string, paths []*gnmi.Path, o SubscribeOptions, encoding gnmi.Encoding) (*gnmi.SubscribeRequest, error) {
 	// create subscription
 
 	gnmiPrefix, err := utils.CreatePrefix(subName, target)
 	if err != nil {
 		return nil, fmt.Errorf("create prefix failed")
 	}
-	modeVal := gnmi.SubscriptionList_Mode_value[strings.ToUpper("STREAM")]
-	qos := &gnmi.QOSMarking{Marking: 21}
+
+	listMode := gnmi.SubscriptionList_STREAM
+	var mode gnmi.SubscriptionMode
+	switch o.Mode {
+	case SubscriptionModeSample:
+		mode = gnmi.SubscriptionMode_SAMPLE
+	case SubscriptionModeOnChange:
+		mode = gnmi.SubscriptionMode_ON_CHANGE
+	case SubscriptionModeTargetDefined:
+		mode = gnmi.SubscriptionMode_TARGET_DEFINED
+	case SubscriptionModePoll:
+		listMode = gnmi.SubscriptionList_POLL
+	default:
+		return nil, fmt.Errorf("unknown subscription mode %q", o.Mode)
+	}
 
 	subscriptions := make([]*gnmi.Subscription, len(paths))
 	for i, p := range paths {
 		subscriptions[i] = &gnmi.Subscription{Path: p}
-		switch gnmi.SubscriptionList_Mode(modeVal) {
-		case gnmi.SubscriptionList_STREAM:
-			mode := gnmi.SubscriptionMode_value[strings.Replace(strings.ToUpper("ON_CHANGE"), "-", "_", -1)]
-			subscriptions[i].Mode = gnmi.SubscriptionMode(mode)
+		if listMode == gnmi.SubscriptionList_STREAM {
+			subscriptions[i].Mode = mode
+			subscriptions[i].SampleInterval = uint64(o.SampleInterval.Nanoseconds())
+			subscriptions[i].HeartbeatInterval = uint64(o.HeartbeatInterval.Nanoseconds())
+			subscriptions[i].SuppressRedundant = o.SuppressRedundant
 		}
 	}
+	var qos *gnmi.QOSMarking
+	if o.Qos != 0 {
+		qos = &gnmi.QOSMarking{Marking: o.Qos}
+	}
 	req := &gnmi.SubscribeRequest{
 		Request: &gnmi.SubscribeRequest_Subscribe{
 			Subscribe: &gnmi.SubscriptionList{
 				Prefix:       gnmiPrefix,
-				Mode:         gnmi.SubscriptionList_Mode(modeVal),
-				Encoding:     46, // "JSON_IETF_CONFIG_ONLY"
+				Mode:         listMode,
+				Encoding:     encoding,
 				Subscription: subscriptions,
 				Qos:          qos,
 			},
@@ -57,3 +142,23 @@ func CreateSubscriptionRequest(target, subName string, paths []*gnmi.Path) (*gnm
 	}
 	return req, nil
 }
+
+// selectEncoding returns the first preferred encoding the target supports, the
+// first preferred encoding is returned when the target does not advertise its
+// encodings
+func selectEncoding(preferred, supported []gnmi.Encoding) (gnmi.Encoding, error) {
+	if len(preferred) == 0 {
+		return 0, fmt.Errorf("no encoding configured")
+	}
+	if len(supported) == 0 {
+		return preferred[0], nil
+	}
+	for _, p := range preferred {
+		for _, s := range supported {
+			if p == s {
+				return p, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("target supports none of the encodings %v, it supports %v", preferred, supported)
+}
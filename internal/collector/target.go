@@ -18,14 +18,28 @@ package collector
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/karimra/gnmic/target"
 	"github.com/karimra/gnmic/types"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/pkg/errors"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/gvk"
 	"github.com/yndd/ndd-runtime/pkg/logging"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
 )
 
 const (
@@ -33,7 +47,14 @@ const (
 	configSubscription = "ConfigChangesubscription"
 
 	// errors
-	errCreateGnmiClient = "cannot create gnmi client"
+	errCreateGnmiClient     = "cannot create gnmi client"
+	errDecodeResourceUpdate = "cannot decode resource update"
+	errUnknownResourceKind  = "no event channel for resource kind"
+	errTargetStopped        = "target is stopped"
+	errSendEvent            = "cannot send event to the controller"
+	errListRegistrations    = "cannot list Registrations"
+	errUpdateRegistration   = "cannot update Registration status"
+	errListResources        = "cannot list resources"
 
 	// timers
 	defaultTimeout = 5 * time.Second
@@ -57,14 +78,38 @@ type TargetUpdate struct {
 	TargetConfig *types.TargetConfig
 }
 
-// DeviationServer contains the device driver information
+// DeviationServer contains the device driver information, it is safe for
+// concurrent use
 type DeviationServer struct {
 	eventChs map[string]chan event.GenericEvent
 	tuCh     chan TargetUpdate
 	log      logging.Logger
-	stopCh   chan struct{}
-	Targets  map[string]*Target
-	ctx      context.Context
+	// ctx is the context the deviation server is started with
+	ctx context.Context
+	// kube is used to report the connectivity of the targets on the
+	// Registrations and to resync the resources of a reconnected target
+	kube client.Client
+	// dialOpts are added to the dial options of the targets
+	dialOpts []grpc.DialOption
+	// collectorOpts are applied to the collectors of the targets, except for
+	// the mode and the intervals of the config subscription
+	collectorOpts []DeviceCollectorOption
+
+	// mu protects the targets
+	mu      sync.Mutex
+	targets map[string]*Target
+	// wg tracks the subscription handlers of the targets
+	wg sync.WaitGroup
+
+	// stateMu protects the states
+	stateMu sync.Mutex
+	states  map[string]targetState
+}
+
+// targetState is the connectivity of the subscription of a target
+type targetState struct {
+	connected bool
+	err       error
 }
 
 // Target defines the parameters for a Target
@@ -72,8 +117,17 @@ type Target struct {
 	Config    *types.TargetConfig
 	Target    *target.Target
 	StopCh    chan struct{}
+	stopOnce  sync.Once
 	log       logging.Logger
 	Collector *GNMICollector
+	eventChs  map[string]chan event.GenericEvent
+
+	dialOpts []grpc.DialOption
+
+	// mu protects the connection
+	mu      sync.Mutex
+	stopped bool
+	conn    *grpc.ClientConn
 }
 
 // Option is a function to initialize the options
@@ -100,18 +154,52 @@ func WithLogging(l logging.Logger) Option {
 	}
 }
 
-// WithStopChannel initializes the deviation server with stop channel info
-func WithStopChannel(stopCh chan struct{}) Option {
+// WithKubeClient initializes the deviation server with a kubernetes client
+func WithKubeClient(c client.Client) Option {
+	return func(d *DeviationServer) {
+		d.kube = c
+	}
+}
+
+// WithDialOptions initializes the deviation server with grpc dial options that
+// are added to the options derived from the target config
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(d *DeviationServer) {
+		d.dialOpts = append(d.dialOpts, opts...)
+	}
+}
+
+// WithCollectorOptions initializes the deviation server with options that are
+// applied to the collectors of the targets, such as the encodings. The config
+// subscription of a target is always ON_CHANGE, the mode and the intervals of
+// the options are replaced.
+func WithCollectorOptions(opts ...DeviceCollectorOption) Option {
 	return func(d *DeviationServer) {
-		d.stopCh = stopCh
+		d.collectorOpts = append(d.collectorOpts, opts...)
 	}
 }
 
+// configSubscriptionOptions returns the collector options with the mode and
+// the intervals of the config subscription applied last. Every notification of
+// the config subscription requeues the resources of its path, a SAMPLE or POLL
+// mode or a heartbeat would requeue every resource of the target at each
+// interval, so the subscription only reports the changes.
+func configSubscriptionOptions(opts []DeviceCollectorOption) []DeviceCollectorOption {
+	return append(append([]DeviceCollectorOption{}, opts...),
+		WithSubscriptionMode(SubscriptionModeOnChange),
+		WithSampleInterval(0),
+		WithHeartbeatInterval(0),
+		WithSuppressRedundant(false),
+	)
+}
+
 // NewDeviationServer function defines a new Deviation Server
 func NewDeviationServer(opts ...Option) *DeviationServer {
 	s := &DeviationServer{
-		Targets: make(map[string]*Target),
+		targets: make(map[string]*Target),
+		states:  make(map[string]targetState),
 		ctx:     context.Background(),
+		log:     logging.NewNopLogger(),
 	}
 
 	for _, o := range opts {
@@ -121,116 +209,439 @@ func NewDeviationServer(opts ...Option) *DeviationServer {
 	return s
 }
 
-// StartTargetChangeHandler changes to targets, targets can be deleted or created
-// this function handles the changes to the targets
-func (d *DeviationServer) StartTargetChangeHandler() {
+// Start handles the changes to the targets until the context is done, targets
+// can be deleted or created. When the context is done the subscriptions of all
+// targets are stopped and their connections are closed. It implements the
+// manager.Runnable interface, such that the manager starts it after the caches
+// are synced.
+func (d *DeviationServer) Start(ctx context.Context) error {
 	d.log.Debug("Starting subscription gnmi server...")
+	// the targets are added with the context of the deviation server
+	d.mu.Lock()
+	d.ctx = ctx
+	d.mu.Unlock()
 
 	for {
 		select {
 		case tu := <-d.tuCh:
 			d.log.Debug("subscription server", "Action", tu.Action, "Target", tu.Name)
 
-			if err := d.HandleTargetUpdate(d.ctx, tu); err != nil {
+			if err := d.HandleTargetUpdate(ctx, tu); err != nil {
 				d.log.Debug("HandleSubscription", "Error", err)
 			}
-		case <-d.stopCh:
+		case <-ctx.Done():
 			d.log.Debug("stopping subscription handler")
-
+			d.mu.Lock()
+			for name := range d.targets {
+				d.stopTarget(ctx, name)
+			}
+			d.mu.Unlock()
+			d.wg.Wait()
+			d.log.Debug("subscription handler stopped")
+			return nil
 		}
 	}
 }
 
+// NeedLeaderElection returns true, such that only the elected leader
+// subscribes to the device drivers when leader election is enabled. It
+// implements the manager.LeaderElectionRunnable interface.
+func (d *DeviationServer) NeedLeaderElection() bool {
+	return true
+}
+
+// serverContext returns the context the deviation server is started with
+func (d *DeviationServer) serverContext() context.Context {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.ctx
+}
+
+// GetTarget returns the target with the name
+func (d *DeviationServer) GetTarget(name string) (*Target, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	t, ok := d.targets[name]
+	return t, ok
+}
+
 // HandleTargetUpdate supports updates of a Target
 func (d *DeviationServer) HandleTargetUpdate(ctx context.Context, tu TargetUpdate) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 	switch tu.Action {
 	case TargetAdd:
-		// it is possible that during a restart the subscription got removed
-		if _, ok := d.Targets[tu.Name]; !ok {
-			t := target.NewTarget(tu.TargetConfig)
-			d.log.Debug("Target", "Config", tu.TargetConfig, "Target", t)
+		if t, ok := d.targets[tu.Name]; ok {
+			if !targetConfigChanged(t.Config, tu.TargetConfig) {
+				return nil
+			}
+			// when the credentials or tls material of the target changed the
+			// connection is restarted with the new config
+			d.log.Debug("Target config changed, restarting target", "Target", tu.Name)
+			d.deleteTarget(ctx, tu.Name)
+		}
+		return d.addTarget(tu)
+	case TargetDelete:
+		d.deleteTarget(ctx, tu.Name)
+	}
+	return nil
+}
+
+// addTarget connects to the target and starts its subscription handler, the
+// caller holds the lock of the deviation server
+func (d *DeviationServer) addTarget(tu TargetUpdate) error {
+	t := target.NewTarget(tu.TargetConfig)
+	d.log.Debug("Target", "Config", tu.TargetConfig, "Target", t)
+	// the subscription runs with the context of the deviation server, which is
+	// read under the lock
+	sctx := d.ctx
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	// the connection is dialed here rather than with CreateGNMIClient, such
+	// that it can be closed when the target is redialed or stopped
+	conn, err := clientpool.Dial(ctx, tu.TargetConfig, d.dialOpts...)
+	if err != nil {
+		d.log.Debug("Error Creating client", "Error", err)
+		return errors.Wrap(err, errCreateGnmiClient)
+	}
+	t.Client = gnmi.NewGNMIClient(conn)
+	nt := &Target{
+		log:      d.log,
+		Config:   tu.TargetConfig,
+		Target:   t,
+		StopCh:   make(chan struct{}),
+		eventChs: d.eventChs,
+		dialOpts: d.dialOpts,
+		conn:     conn,
+	}
+	// the handlers of the deviation server are applied last, such that the
+	// collector options cannot replace them
+	opts := append([]DeviceCollectorOption{WithDeviceCollectorLogger(d.log)}, configSubscriptionOptions(d.collectorOpts)...)
+	opts = append(opts,
+		WithResponseHandler(func(subName string, resp *gnmi.SubscribeResponse) {
+			nt.ReconcileOnChange(sctx, resp)
+		}),
+		WithStateHandler(func(subName string, connected bool, err error) {
+			d.handleTargetState(tu.Name, connected, err)
+		}),
+		WithRedial(nt.redial),
+	)
+	nt.Collector = NewGNMICollector(t, opts...)
+	d.targets[tu.Name] = nt
+
+	// start gnmi subscription handler
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.log.Debug("Target", "TargetName", tu.Name, "Target Info", nt.Target)
+
+		nt.StartGnmiSubscriptionHandler(sctx)
+		nt.Stop()
+		// the handler returns when the target is stopped or the subscription
+		// could not be started, the target could have been replaced in the
+		// meantime
+		d.mu.Lock()
+		if d.targets[tu.Name] == nt {
+			delete(d.targets, tu.Name)
+		}
+		d.mu.Unlock()
+	}()
+	return nil
+}
 
-			ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
-			defer cancel()
+// deleteTarget stops the subscription of the target and updates the
+// connectivity of its Registrations, the caller holds the lock of the deviation
+// server
+func (d *DeviationServer) deleteTarget(ctx context.Context, name string) {
+	if !d.stopTarget(ctx, name) {
+		return
+	}
+	// the target no longer counts for the connectivity of its Registrations
+	go d.updateRegistrations(name)
+}
 
-			if err := t.CreateGNMIClient(ctx); err != nil {
-				d.log.Debug("Error Creating client", "Error", err)
-				return errors.Wrap(err, errCreateGnmiClient)
-			}
-			d.Targets[tu.Name] = &Target{
-				log:       d.log,
-				Config:    tu.TargetConfig,
-				Target:    t,
-				StopCh:    make(chan struct{}),
-				Collector: NewGNMICollector(t, WithDeviceCollectorLogger(d.log)),
-			}
+// stopTarget stops the subscription of the target, closes its connection and
+// removes it, false is returned when the target does not exist. The caller
+// holds the lock of the deviation server.
+func (d *DeviationServer) stopTarget(ctx context.Context, name string) bool {
+	t, ok := d.targets[name]
+	if !ok {
+		return false
+	}
+	if err := t.Collector.StopSubscription(ctx, configSubscription); err != nil {
+		d.log.Debug("Cannot stop subscription", "Target", name, "Error", err)
+	}
+	t.Stop()
+	delete(d.targets, name)
 
-			// start gnmi subscription handler
-			go func() {
-				d.log.Debug("Target", "TargetName", tu.Name, "Target Info", d.Targets[tu.Name].Target)
+	d.stateMu.Lock()
+	delete(d.states, name)
+	d.stateMu.Unlock()
+	return true
+}
 
-				d.Targets[tu.Name].StartGnmiSubscriptionHandler(d.ctx)
-				// we should delete the target since an error occurred and we will get
-				// most likely a device driver got restarted
-				delete(d.Targets, tu.Name)
+// handleTargetState records the connectivity of the subscription of the target
+// and reports it on the Registrations of the target. A target that connects
+// after it was disconnected is resynced, since the changes of its resources
+// while it was disconnected are missed.
+func (d *DeviationServer) handleTargetState(name string, connected bool, err error) {
+	d.stateMu.Lock()
+	prev, ok := d.states[name]
+	d.states[name] = targetState{connected: connected, err: err}
+	d.stateMu.Unlock()
+
+	if ok && prev.connected == connected && !changedError(prev.err, err) {
+		return
+	}
+	d.log.Debug("Target connectivity changed", "Target", name, "Connected", connected, "Error", err)
+	d.updateRegistrations(name)
+	if connected && ok && !prev.connected {
+		d.resync(name)
+	}
+}
 
-			}()
-		}
+// changedError returns true when the errors have a different message
+func changedError(a, b error) bool {
+	if a == nil || b == nil {
+		return a != b
+	}
+	return a.Error() != b.Error()
+}
 
-	case TargetDelete:
-		// delete the
-		if _, ok := d.Targets[tu.Name]; ok {
-			if err := d.Targets[tu.Name].Collector.StopSubscription(ctx, configSubscription); err != nil {
+// updateRegistrations sets the TargetConnected condition of the Registrations
+// of the target
+func (d *DeviationServer) updateRegistrations(name string) {
+	if d.kube == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(d.serverContext(), defaultTimeout)
+	defer cancel()
+
+	l := &srosv1alpha1.RegistrationList{}
+	if err := d.kube.List(ctx, l); err != nil {
+		d.log.Debug(errListRegistrations, "Target", name, "Error", err)
+		return
+	}
+	for _, item := range l.Items {
+		if !hasTarget(&item, name) {
+			continue
+		}
+		key := client.ObjectKeyFromObject(&item)
+		if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			o := &srosv1alpha1.Registration{}
+			if err := d.kube.Get(ctx, key, o); err != nil {
 				return err
 			}
-			d.Targets[tu.Name].StopCh <- struct{}{}
+			c, ok := d.connectivityCondition(o)
+			if !ok || o.GetCondition(srosv1alpha1.ConditionKindTargetConnected).Equal(c) {
+				return nil
+			}
+			o.SetConditions(c)
+			return d.kube.Status().Update(ctx, o)
+		}); err != nil {
+			d.log.Debug(errUpdateRegistration, "Registration", key.Name, "Error", err)
+		}
+	}
+}
+
+// connectivityCondition returns the TargetConnected condition of the
+// Registration from the connectivity of its targets, false is returned when the
+// connectivity of none of its targets is known
+func (d *DeviationServer) connectivityCondition(o *srosv1alpha1.Registration) (nddv1.Condition, bool) {
+	d.stateMu.Lock()
+	defer d.stateMu.Unlock()
+	known := false
+	disconnected := make([]string, 0)
+	for _, name := range targetNames(o) {
+		s, ok := d.states[name]
+		if !ok {
+			continue
+		}
+		known = true
+		if !s.connected {
+			disconnected = append(disconnected, name+": "+errorString(s.err))
+		}
+	}
+	if !known {
+		return nddv1.Condition{}, false
+	}
+	if len(disconnected) == 0 {
+		return srosv1alpha1.TargetConnected(), true
+	}
+	return srosv1alpha1.TargetDisconnected("subscription disconnected, retrying: " + strings.Join(disconnected, "; ")), true
+}
+
+// resync sends an event for every resource on the target, such that they are
+// reconciled against the device
+func (d *DeviationServer) resync(name string) {
+	if d.kube == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(d.serverContext(), defaultTimeout)
+	defer cancel()
+
+	for gk, eventCh := range d.eventChs {
+		listGVK := schema.ParseGroupKind(gk).WithVersion(srosv1alpha1.Version)
+		listGVK.Kind += "List"
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(listGVK)
+		if err := d.kube.List(ctx, l); err != nil {
+			d.log.Debug(errListResources, "Kind", gk, "Error", err)
+			continue
+		}
+		for i := range l.Items {
+			nn, _, _ := unstructured.NestedString(l.Items[i].Object, "spec", "networkNodeRef", "name")
+			if nn != name {
+				continue
+			}
+			d.log.Debug("Resync trigger", "Kind", gk, "Name", l.Items[i].GetName(), "Target", name)
+			select {
+			case eventCh <- event.GenericEvent{Object: &l.Items[i]}:
+			case <-ctx.Done():
+				d.log.Debug("Resync aborted", "Target", name, "Error", ctx.Err())
+				return
+			}
 		}
+	}
+}
 
-		delete(d.Targets, tu.Name)
+// targetNames returns the names of the targets of the Registration
+func targetNames(o *srosv1alpha1.Registration) []string {
+	names := make(map[string]struct{})
+	for _, name := range o.Status.Target {
+		names[name] = struct{}{}
+	}
+	for _, t := range o.Status.AtNetworkNode.Targets {
+		names[t.Name] = struct{}{}
+	}
+	l := make([]string, 0, len(names))
+	for name := range names {
+		l = append(l, name)
+	}
+	sort.Strings(l)
+	return l
+}
 
+// hasTarget returns true when the target is a target of the Registration
+func hasTarget(o *srosv1alpha1.Registration, name string) bool {
+	for _, n := range targetNames(o) {
+		if n == name {
+			return true
+		}
 	}
-	return nil
+	return false
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return "not connected"
+	}
+	return err.Error()
+}
+
+// targetConfigChanged returns true when the connection parameters of the
+// target differ
+func targetConfigChanged(a, b *types.TargetConfig) bool {
+	if a == nil || b == nil {
+		return a != b
+	}
+	return a.Address != b.Address ||
+		stringPtrValue(a.Username) != stringPtrValue(b.Username) ||
+		stringPtrValue(a.Password) != stringPtrValue(b.Password) ||
+		stringPtrValue(a.TLSCA) != stringPtrValue(b.TLSCA) ||
+		stringPtrValue(a.TLSCert) != stringPtrValue(b.TLSCert) ||
+		stringPtrValue(a.TLSKey) != stringPtrValue(b.TLSKey) ||
+		boolPtrValue(a.Insecure) != boolPtrValue(b.Insecure) ||
+		boolPtrValue(a.SkipVerify) != boolPtrValue(b.SkipVerify)
+}
+
+func stringPtrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func boolPtrValue(b *bool) bool {
+	return b != nil && *b
+}
+
+// Stop stops the subscription handler of the target and closes its connection,
+// it can be called more than once
+func (t *Target) Stop() {
+	t.stopOnce.Do(func() {
+		close(t.StopCh)
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.stopped = true
+		if err := t.conn.Close(); err != nil {
+			t.log.Debug("Cannot close connection", "Target", t.Config.Name, "Error", err)
+		}
+	})
+}
+
+// redial replaces the connection of the target with a new connection and
+// returns its client
+func (t *Target) redial(ctx context.Context) (gnmi.GNMIClient, error) {
+	conn, err := clientpool.Dial(ctx, t.Config, t.dialOpts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateGnmiClient)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// the target could be stopped while it was dialing
+	if t.stopped {
+		conn.Close()
+		return nil, errors.New(errTargetStopped)
+	}
+	old := t.conn
+	t.conn = conn
+	if err := old.Close(); err != nil {
+		t.log.Debug("Cannot close connection", "Target", t.Config.Name, "Error", err)
+	}
+	return gnmi.NewGNMIClient(conn), nil
 }
 
-// StartGnmiSubscriptionHandler starts gnmi subscription
+// StartGnmiSubscriptionHandler starts gnmi subscription, which runs until the
+// target is stopped
 func (t *Target) StartGnmiSubscriptionHandler(ctx context.Context) {
 	t.log.Debug("Starting GNMI subscription...", "Target", t.Target.Config.Name)
 
-	t.Collector.Lock()
-	go t.Collector.StartSubscription(ctx, t.Config.Name, configSubscription,
+	if err := t.Collector.StartSubscription(ctx, t.Config.Name, configSubscription,
 		[]*gnmi.Path{{
 			Elem: []*gnmi.PathElem{
 				{Name: "provider-resource-update"},
 			},
-		}})
-	t.Collector.Unlock()
-
-	chanSubResp, chanSubErr := t.Target.ReadSubscriptions()
-
-	for {
-		select {
-		case resp := <-chanSubResp:
-			//log.Infof("SubRsp Response %v", resp)
-			// TODO error handling
-			t.ReconcileOnChange(resp.Response)
-		case tErr := <-chanSubErr:
-			t.log.Debug("subscribe", "error", tErr)
-			return
-		case <-t.StopCh:
-			t.log.Debug("Stopping subscription process...")
-			return
-		}
+		}}); err != nil {
+		t.log.Debug("Cannot start subscription", "error", err)
+		return
+	}
+	// the subscription ends with the handler
+	defer t.Collector.StopSubscription(ctx, configSubscription)
+
+	// the responses are handled and failures are retried by the collector
+	select {
+	case <-t.StopCh:
+		t.log.Debug("Stopping subscription process...")
+	case <-ctx.Done():
 	}
 }
 
-// ReconcileOnChange reconciles an on change update
-func (t *Target) ReconcileOnChange(resp *gnmi.SubscribeResponse) error {
+// ReconcileOnChange reconciles an on change update, the events are dropped
+// when the context is cancelled or the target is stopped
+func (t *Target) ReconcileOnChange(ctx context.Context, resp *gnmi.SubscribeResponse) error {
 	switch resp.GetResponse().(type) {
 	case *gnmi.SubscribeResponse_Update:
+		prefix := resp.GetUpdate().GetPrefix()
 		// handle deletes
 		du := resp.GetUpdate().Delete
 		for _, del := range du {
 			t.log.Debug("ReconcileOnChange", "Delete", del)
+			if err := t.triggerReconcile(ctx, joinPath(prefix, del), nil); err != nil {
+				t.log.Debug("ReconcileOnChange", "Error", err)
+			}
 		}
 
 		// handle updates
@@ -238,6 +649,9 @@ func (t *Target) ReconcileOnChange(resp *gnmi.SubscribeResponse) error {
 		// subscription UPDATE per xpath
 		for _, upd := range u {
 			t.log.Debug("ReconcileOnChange", "Update", upd)
+			if err := t.triggerReconcile(ctx, joinPath(prefix, upd.GetPath()), upd.GetVal()); err != nil {
+				t.log.Debug("ReconcileOnChange", "Error", err)
+			}
 		}
 
 	case *gnmi.SubscribeResponse_SyncResponse:
@@ -246,3 +660,88 @@ func (t *Target) ReconcileOnChange(resp *gnmi.SubscribeResponse) error {
 
 	return nil
 }
+
+// triggerReconcile sends a generic event to the controller of the resource
+// identified in the update, such that only this resource gets reconciled
+func (t *Target) triggerReconcile(ctx context.Context, p *gnmi.Path, val *gnmi.TypedValue) error {
+	r, err := getResourceGVK(p, val)
+	if err != nil {
+		return errors.Wrap(err, errDecodeResourceUpdate)
+	}
+	gk := schema.GroupKind{Group: r.GetGroup(), Kind: r.GetKind()}.String()
+	eventCh, ok := t.eventChs[gk]
+	if !ok {
+		return errors.Errorf("%s: %s", errUnknownResourceKind, gk)
+	}
+
+	o := &unstructured.Unstructured{}
+	o.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   r.GetGroup(),
+		Version: r.GetVersion(),
+		Kind:    r.GetKind(),
+	})
+	o.SetName(r.GetName())
+	o.SetNamespace(r.GetNameSpace())
+
+	t.log.Debug("ReconcileOnChange trigger", "Kind", gk, "Name", r.GetName(), "Namespace", r.GetNameSpace())
+	select {
+	case eventCh <- event.GenericEvent{Object: o}:
+		return nil
+	case <-t.StopCh:
+		return errors.New(errTargetStopped)
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), errSendEvent)
+	}
+}
+
+// getResourceGVK returns the resource identity of a provider resource update.
+// The device driver carries the gvk string of the resource either as the value
+// of the update or as the name key of the last path element; deletes only carry
+// the path.
+func getResourceGVK(p *gnmi.Path, val *gnmi.TypedValue) (*gvk.GVK, error) {
+	var d []byte
+	switch v := val.GetValue().(type) {
+	case *gnmi.TypedValue_StringVal:
+		d = []byte(v.StringVal)
+	case *gnmi.TypedValue_JsonVal:
+		d = v.JsonVal
+	case *gnmi.TypedValue_JsonIetfVal:
+		d = v.JsonIetfVal
+	}
+	if len(d) == 0 {
+		elems := p.GetElem()
+		if len(elems) == 0 {
+			return nil, errors.New("empty path")
+		}
+		name, ok := elems[len(elems)-1].GetKey()["name"]
+		if !ok {
+			return nil, errors.Errorf("no resource name in path %v", p)
+		}
+		d = []byte(name)
+	}
+
+	// the gvk can be json encoded as a string
+	var s string
+	if err := json.Unmarshal(d, &s); err == nil {
+		d = []byte(s)
+	}
+	r := &gvk.GVK{}
+	if err := json.Unmarshal(d, r); err != nil {
+		return nil, err
+	}
+	if r.GetKind() == "" || r.GetName() == "" {
+		return nil, errors.Errorf("incomplete resource identity %s", string(d))
+	}
+	return r, nil
+}
+
+// joinPath returns the path of an update with the elements of the notification
+// prefix prepended
+func joinPath(prefix, p *gnmi.Path) *gnmi.Path {
+	if len(prefix.GetElem()) == 0 {
+		return p
+	}
+	return &gnmi.Path{
+		Elem: append(append([]*gnmi.PathElem{}, prefix.GetElem()...), p.GetElem()...),
+	}
+}
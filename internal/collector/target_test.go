@@ -0,0 +1,194 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/yndd/ndd-runtime/pkg/gvk"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+func gvkString(t *testing.T, kind, name, namespace string) string {
+	t.Helper()
+	s, err := (&gvk.GVK{
+		Group:     srosv1alpha1.Group,
+		Version:   srosv1alpha1.Version,
+		Kind:      kind,
+		Name:      name,
+		NameSpace: namespace,
+	}).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func newTestTarget(gks ...string) (*Target, map[string]chan event.GenericEvent) {
+	chs := make(map[string]chan event.GenericEvent)
+	for _, gk := range gks {
+		chs[gk] = make(chan event.GenericEvent, 4)
+	}
+	return &Target{
+		log:      logging.NewNopLogger(),
+		StopCh:   make(chan struct{}),
+		eventChs: chs,
+	}, chs
+}
+
+func TestReconcileOnChange(t *testing.T) {
+	portGK := srosv1alpha1.ConfigurePortGroupKind
+	lagGK := srosv1alpha1.ConfigureLagGroupKind
+
+	cases := map[string]struct {
+		resp      *gnmi.SubscribeResponse
+		wantGK    string
+		wantName  string
+		wantNs    string
+		wantEvent bool
+	}{
+		"UpdateStringVal": {
+			resp: &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+				Update: []*gnmi.Update{{
+					Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "resource"}}},
+					Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: gvkString(t, "SrosConfigurePort", "port-1", "default")}},
+				}},
+			}}},
+			wantGK:    portGK,
+			wantName:  "port-1",
+			wantNs:    "default",
+			wantEvent: true,
+		},
+		"UpdateJsonVal": {
+			resp: &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+				Update: []*gnmi.Update{{
+					Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "resource"}}},
+					Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(strconv.Quote(gvkString(t, "SrosConfigureLag", "lag-1", "")))}},
+				}},
+			}}},
+			wantGK:    lagGK,
+			wantName:  "lag-1",
+			wantEvent: true,
+		},
+		"DeleteWithPrefix": {
+			resp: &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+				Prefix: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "ndd"}}},
+				Delete: []*gnmi.Path{{Elem: []*gnmi.PathElem{
+					{Name: "resource", Key: map[string]string{"name": gvkString(t, "SrosConfigurePort", "port-2", "ns")}},
+				}}},
+			}}},
+			wantGK:    portGK,
+			wantName:  "port-2",
+			wantNs:    "ns",
+			wantEvent: true,
+		},
+		"UnknownKind": {
+			resp: &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+				Update: []*gnmi.Update{{
+					Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "resource"}}},
+					Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: gvkString(t, "SrosConfigureRouterBgp", "bgp", "")}},
+				}},
+			}}},
+		},
+		"NoResourceIdentity": {
+			resp: &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+				Delete: []*gnmi.Path{{Elem: []*gnmi.PathElem{{Name: "resource"}}}},
+			}}},
+		},
+		"SyncResponse": {
+			resp: &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tgt, chs := newTestTarget(portGK, lagGK)
+			if err := tgt.ReconcileOnChange(context.Background(), tc.resp); err != nil {
+				t.Fatalf("ReconcileOnChange(): %v", err)
+			}
+			for gk, ch := range chs {
+				select {
+				case e := <-ch:
+					if !tc.wantEvent || gk != tc.wantGK {
+						t.Fatalf("unexpected event for %s: %v", gk, e.Object)
+					}
+					if e.Object.GetName() != tc.wantName || e.Object.GetNamespace() != tc.wantNs {
+						t.Errorf("event object %s/%s, want %s/%s", e.Object.GetNamespace(), e.Object.GetName(), tc.wantNs, tc.wantName)
+					}
+					want := schema.ParseGroupKind(tc.wantGK).WithVersion(srosv1alpha1.Version)
+					if got := e.Object.GetObjectKind().GroupVersionKind(); got != want {
+						t.Errorf("event gvk %v, want %v", got, want)
+					}
+				default:
+					if tc.wantEvent && gk == tc.wantGK {
+						t.Fatalf("no event for %s", gk)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestReconcileOnChangeNotBlocking checks that a full event channel does not
+// block the subscription once the target is stopped or the context is done
+func TestReconcileOnChangeNotBlocking(t *testing.T) {
+	resp := &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+		Update: []*gnmi.Update{{
+			Path: &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "resource"}}},
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: gvkString(t, "SrosConfigurePort", "port-1", "")}},
+		}},
+	}}}
+
+	cases := map[string]func(tgt *Target, cancel context.CancelFunc){
+		"TargetStopped": func(tgt *Target, _ context.CancelFunc) { close(tgt.StopCh) },
+		"ContextDone":   func(_ *Target, cancel context.CancelFunc) { cancel() },
+	}
+	for name, stop := range cases {
+		t.Run(name, func(t *testing.T) {
+			tgt := &Target{
+				log:    logging.NewNopLogger(),
+				StopCh: make(chan struct{}),
+				eventChs: map[string]chan event.GenericEvent{
+					// an unbuffered channel without a reader
+					srosv1alpha1.ConfigurePortGroupKind: make(chan event.GenericEvent),
+				},
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				tgt.ReconcileOnChange(ctx, resp) // nolint:errcheck
+			}()
+			stop(tgt, cancel)
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("ReconcileOnChange() blocks on the event channel")
+			}
+		})
+	}
+}
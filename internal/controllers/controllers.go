@@ -17,40 +17,96 @@ limitations under the License.
 package controllers
 
 import (
+	"reflect"
+	"sort"
 	"time"
 
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/resource"
 
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
 	"github.com/yndd/ndd-provider-sros/internal/collector"
 	"github.com/yndd/ndd-provider-sros/internal/controllers/sros"
 )
 
 // Setup package controllers.
-func Setup(mgr ctrl.Manager, option controller.Options, l logging.Logger, autopilot bool, poll time.Duration, namespace string, tuChan chan collector.TargetUpdate) (map[string]chan event.GenericEvent, error) {
+func Setup(mgr ctrl.Manager, option controller.Options, l logging.Logger, autopilot bool, poll time.Duration, namespace string, pool *clientpool.Pool, tuChan chan collector.TargetUpdate) (map[string]chan event.GenericEvent, error) {
 	eventChans := make(map[string]chan event.GenericEvent)
-	for _, setup := range []func(ctrl.Manager, controller.Options, logging.Logger, time.Duration, string) (string, chan event.GenericEvent, error){
-		//sros.SetupRegistration,
-
+	controllers := make(map[string]struct{})
+	for _, setup := range []func(ctrl.Manager, controller.Options, logging.Logger, time.Duration, string, *clientpool.Pool) (string, chan event.GenericEvent, error){
+		sros.SetupConfigurePort,
+		sros.SetupConfigurePortPolicy,
+		sros.SetupConfigureLag,
+		sros.SetupConfigureRouterInterface,
+		sros.SetupConfigureRouterBgp,
+		sros.SetupConfigurePortXc,
 	} {
-		gvk, eventChan, err := setup(mgr, option, l, poll, namespace)
+		gvk, eventChan, err := setup(mgr, option, l, poll, namespace, pool)
 		if err != nil {
 			return nil, err
 		}
 		eventChans[gvk] = eventChan
+		controllers[gvk] = struct{}{}
 	}
 
-	for _, setup := range []func(ctrl.Manager, controller.Options, logging.Logger, time.Duration, string, chan collector.TargetUpdate) error{
+	for _, setup := range []func(ctrl.Manager, controller.Options, logging.Logger, time.Duration, string, *clientpool.Pool, chan collector.TargetUpdate) (string, error){
 		sros.SetupRegistration,
 	} {
-		if err := setup(mgr, option, l, poll, namespace, tuChan); err != nil {
+		gvk, err := setup(mgr, option, l, poll, namespace, pool, tuChan)
+		if err != nil {
 			return nil, err
 		}
+		controllers[gvk] = struct{}{}
+	}
+
+	if err := validateControllers(mgr.GetScheme(), controllers); err != nil {
+		return nil, err
 	}
 
 	return eventChans, nil
 	//return config.Setup(mgr, l, option)
 }
+
+// SetupWebhooks registers the admission webhooks of the package resources with
+// the webhook server of the manager.
+func SetupWebhooks(mgr ctrl.Manager, l logging.Logger) error {
+	for _, setup := range []func(ctrl.Manager, logging.Logger) error{
+		sros.SetupConfigurePortWebhook,
+		sros.SetupConfigurePortXcWebhook,
+		sros.SetupRegistrationWebhook,
+	} {
+		if err := setup(mgr, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateControllers checks that every managed resource kind of the provider
+// api group that is registered in the scheme has a controller, such that a CRD
+// cannot silently be left without a reconciler.
+func validateControllers(s *runtime.Scheme, controllers map[string]struct{}) error {
+	missing := make([]string, 0)
+	for kind, t := range s.KnownTypes(srosv1alpha1.GroupVersion) {
+		if _, ok := reflect.New(t).Interface().(resource.Managed); !ok {
+			continue
+		}
+		gk := schema.GroupKind{Group: srosv1alpha1.Group, Kind: kind}.String()
+		if _, ok := controllers[gk]; !ok {
+			missing = append(missing, gk)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return errors.Errorf("no controller registered for kinds: %v", missing)
+	}
+	return nil
+}
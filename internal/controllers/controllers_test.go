@@ -0,0 +1,239 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
+	"github.com/yndd/ndd-provider-sros/internal/collector"
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		srosv1alpha1.AddToScheme,
+		ndrv1.AddToScheme,
+	} {
+		if err := add(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func TestValidateControllers(t *testing.T) {
+	s := newScheme(t)
+
+	if err := validateControllers(s, map[string]struct{}{
+		srosv1alpha1.ConfigurePortGroupKind: {},
+	}); err == nil {
+		t.Fatal("validateControllers(): expected an error for the kinds without a controller")
+	}
+
+	controllers := map[string]struct{}{
+		srosv1alpha1.ConfigurePortGroupKind:            {},
+		srosv1alpha1.ConfigurePortPolicyGroupKind:      {},
+		srosv1alpha1.ConfigurePortXcGroupKind:          {},
+		srosv1alpha1.ConfigureLagGroupKind:             {},
+		srosv1alpha1.ConfigureRouterInterfaceGroupKind: {},
+		srosv1alpha1.ConfigureRouterBgpGroupKind:       {},
+		srosv1alpha1.RegistrationGroupKind:             {},
+	}
+	if err := validateControllers(s, controllers); err != nil {
+		t.Fatalf("validateControllers(): %v", err)
+	}
+}
+
+// envtestAssets returns the directory of the envtest control plane binaries,
+// or an empty string when they are not found
+func envtestAssets() string {
+	if dir := os.Getenv("KUBEBUILDER_ASSETS"); dir != "" {
+		return dir
+	}
+	dir := filepath.Join("..", "..", "testbin", "bin")
+	if _, err := os.Stat(filepath.Join(dir, "kube-apiserver")); err != nil {
+		return ""
+	}
+	return dir
+}
+
+// TestSetupConfigurePortCreate runs the controllers of the package against an
+// api server and a fake device driver, and checks that a created
+// SrosConfigurePort results in the creation of the port on the device driver.
+// The test requires the envtest control plane binaries, which are located by
+// the KUBEBUILDER_ASSETS environment variable or in the testbin/bin directory
+// that make envtest downloads them to.
+func TestSetupConfigurePortCreate(t *testing.T) {
+	assets := envtestAssets()
+	if assets == "" {
+		t.Skip("the envtest binaries are not found, run make envtest or set KUBEBUILDER_ASSETS")
+	}
+
+	env := &envtest.Environment{
+		CRDDirectoryPaths: []string{
+			filepath.Join("..", "..", "package", "crds"),
+			filepath.Join("testdata", "crds"),
+		},
+		ErrorIfCRDPathMissing: true,
+		BinaryAssetsDirectory: assets,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("cannot start envtest: %v", err)
+	}
+	defer env.Stop() // nolint:errcheck
+
+	s := newScheme(t)
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{Scheme: s, MetricsBindAddress: "0"})
+	if err != nil {
+		t.Fatalf("cannot create manager: %v", err)
+	}
+
+	// the network node has the card and mda the port depends on
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{
+		"configure": map[string]interface{}{
+			"card": []interface{}{
+				map[string]interface{}{
+					"slot-number": 1,
+					"mda": []interface{}{
+						map[string]interface{}{"mda-slot": 1},
+					},
+				},
+			},
+		},
+	})
+	pool := clientpool.New(clientpool.WithDialOptions(dd.Dialer()))
+	tuChan := make(chan collector.TargetUpdate, 16)
+	if _, err := Setup(mgr, controller.Options{}, logging.NewNopLogger(), false, time.Second, "", pool, tuChan); err != nil {
+		t.Fatalf("Setup(): %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		if err := mgr.Start(ctx); err != nil {
+			t.Errorf("cannot start manager: %v", err)
+		}
+	}()
+
+	kube, err := client.New(cfg, client.Options{Scheme: s})
+	if err != nil {
+		t.Fatalf("cannot create client: %v", err)
+	}
+	for _, o := range []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ndrv1.Namespace}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ndrv1.Namespace, Name: "sros-creds"},
+			Data: map[string][]byte{
+				"username": []byte("admin"),
+				"password": []byte("admin"),
+			},
+		},
+		&ndrv1.NetworkNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "sr1"},
+			Spec: ndrv1.NetworkNodeSpec{
+				Target: &ndrv1.TargetDetails{
+					Address:         utils.StringPtr("sr1:57400"),
+					CredentialsName: utils.StringPtr("sros-creds"),
+					Insecure:        utils.BoolPtr(true),
+				},
+				GrpcServerPort: intPtr(9999),
+			},
+		},
+	} {
+		if err := kube.Create(ctx, o); err != nil {
+			t.Fatalf("cannot create %s: %v", o.GetName(), err)
+		}
+	}
+	nn := &ndrv1.NetworkNode{}
+	if err := kube.Get(ctx, client.ObjectKey{Name: "sr1"}, nn); err != nil {
+		t.Fatalf("cannot get network node: %v", err)
+	}
+	nn.SetConditions(ndrv1.Configured())
+	if err := kube.Status().Update(ctx, nn); err != nil {
+		t.Fatalf("cannot update network node status: %v", err)
+	}
+
+	port := &srosv1alpha1.SrosConfigurePort{
+		ObjectMeta: metav1.ObjectMeta{Name: "sr1-port-1-1-1"},
+		Spec: srosv1alpha1.ConfigurePortSpec{
+			ResourceSpec: nddv1.ResourceSpec{
+				NetworkNodeReference: &nddv1.Reference{Name: "sr1"},
+			},
+			ForNetworkNode: srosv1alpha1.ConfigurePortParameters{
+				SrosConfigurePort: &srosv1alpha1.ConfigurePort{
+					PortId:     utils.StringPtr("1/1/1"),
+					AdminState: utils.StringPtr("enable"),
+				},
+			},
+		},
+	}
+	if err := kube.Create(ctx, port); err != nil {
+		t.Fatalf("cannot create port: %v", err)
+	}
+
+	select {
+	case req := <-dd.Sets:
+		paths := setPaths(req)
+		want := "/configure/port[port-id=1/1/1]"
+		for _, p := range paths {
+			if strings.HasPrefix(p, want) {
+				return
+			}
+		}
+		t.Fatalf("set request paths %v: want a path in %s", paths, want)
+	case <-time.After(60 * time.Second):
+		t.Fatal("the port was not created on the device driver")
+	}
+}
+
+// setPaths returns the xpaths of the replaces and updates of a set request
+func setPaths(req *gnmi.SetRequest) []string {
+	p := parser.NewParser()
+	paths := make([]string, 0)
+	for _, u := range append(req.GetReplace(), req.GetUpdate()...) {
+		paths = append(paths, *p.GnmiPathToXPath(u.GetPath(), true))
+	}
+	return paths
+}
+
+func intPtr(i int) *int { return &i }
@@ -0,0 +1,266 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// leafKind identifies the yang base type of a leaf
+type leafKind int
+
+const (
+	leafKindInteger leafKind = iota
+	leafKindDecimal
+	leafKindString
+	leafKindEnum
+)
+
+// valueRange is an inclusive range of values or string lengths
+type valueRange struct {
+	min float64
+	max float64
+}
+
+// leafType holds the yang restrictions of a leaf type
+type leafType struct {
+	kind     leafKind
+	ranges   []valueRange
+	lengths  []valueRange
+	patterns []string
+	enums    []string
+}
+
+// leafConstraint holds the member types of a leaf, a value is valid when it
+// matches one of the member types, which covers yang union types
+type leafConstraint []leafType
+
+// constraintViolation identifies a leaf whose value violates its constraint
+type constraintViolation struct {
+	// elements of the data path of the leaf, list entries include their keys
+	elems  []string
+	value  interface{}
+	detail string
+}
+
+func (v constraintViolation) String() string {
+	return fmt.Sprintf("/%s: %s", strings.Join(v.elems, "/"), v.detail)
+}
+
+// validateConstraints validates the leaf values of the json data against the
+// constraints, which are indexed by the schema path of the leaf. The returned
+// violations contain the data path of every leaf that is not valid.
+func validateConstraints(x interface{}, constraints map[string]leafConstraint, refPaths []*gnmi.Path) []constraintViolation {
+	v := &constraintValidator{
+		constraints: constraints,
+		keys:        getListKeys(refPaths),
+		violations:  make([]constraintViolation, 0),
+	}
+	v.validate(x, "", nil)
+	return v.violations
+}
+
+type constraintValidator struct {
+	constraints map[string]leafConstraint
+	// keys of the lists, indexed by schema path
+	keys       map[string][]string
+	violations []constraintViolation
+}
+
+func (v *constraintValidator) validate(x interface{}, schemaPath string, dataPath []string) {
+	m, ok := x.(map[string]interface{})
+	if !ok {
+		return
+	}
+	// sort the elements to report the violations in a consistent order
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		sp := schemaPath + "/" + name
+		switch value := m[name].(type) {
+		case map[string]interface{}:
+			v.validate(value, sp, appendElem(dataPath, name))
+		case []interface{}:
+			for i, entry := range value {
+				v.validate(entry, sp, appendElem(dataPath, name+v.listEntryKey(sp, entry, i)))
+			}
+		case nil:
+		default:
+			c, ok := v.constraints[sp]
+			if !ok {
+				continue
+			}
+			if err := c.validate(value); err != nil {
+				v.violations = append(v.violations, constraintViolation{
+					elems:  appendElem(dataPath, name),
+					value:  value,
+					detail: err.Error(),
+				})
+			}
+		}
+	}
+}
+
+// appendElem returns a copy of the path with the element appended
+func appendElem(path []string, elem string) []string {
+	return append(append(make([]string, 0, len(path)+1), path...), elem)
+}
+
+// listEntryKey returns the key of a list entry in xpath notation, when the key
+// is unknown the index in the list is used
+func (v *constraintValidator) listEntryKey(schemaPath string, entry interface{}, idx int) string {
+	m, ok := entry.(map[string]interface{})
+	keyNames := v.keys[schemaPath]
+	if !ok || len(keyNames) == 0 {
+		return fmt.Sprintf("[%d]", idx)
+	}
+	keys := make([]string, 0, len(keyNames))
+	for _, keyName := range keyNames {
+		keys = append(keys, fmt.Sprintf("%s=%v", keyName, m[keyName]))
+	}
+	return "[" + strings.Join(keys, ",") + "]"
+}
+
+// getListKeys returns the key names of the lists in the reference paths
+// indexed by schema path
+func getListKeys(refPaths []*gnmi.Path) map[string][]string {
+	keys := make(map[string][]string)
+	for _, refPath := range refPaths {
+		sp := ""
+		for _, elem := range refPath.GetElem() {
+			sp += "/" + elem.GetName()
+		}
+		elems := refPath.GetElem()
+		if len(elems) == 0 || len(elems[len(elems)-1].GetKey()) == 0 {
+			continue
+		}
+		keyNames := make([]string, 0)
+		for keyName := range elems[len(elems)-1].GetKey() {
+			keyNames = append(keyNames, keyName)
+		}
+		sort.Strings(keyNames)
+		keys[sp] = keyNames
+	}
+	return keys
+}
+
+// validate returns an error when the value matches none of the member types
+func (c leafConstraint) validate(value interface{}) error {
+	errs := make([]string, 0, len(c))
+	for _, t := range c {
+		err := t.validate(value)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return fmt.Errorf("%s", strings.Join(errs, " or "))
+}
+
+func (t leafType) validate(value interface{}) error {
+	switch t.kind {
+	case leafKindInteger:
+		f, ok := toFloat(value)
+		if !ok || f != math.Trunc(f) {
+			return fmt.Errorf("%v is not an integer", value)
+		}
+		return checkRanges(f, t.ranges, "%v is not in range %s", value)
+	case leafKindDecimal:
+		f, ok := toFloat(value)
+		if !ok {
+			return fmt.Errorf("%v is not a decimal", value)
+		}
+		return checkRanges(f, t.ranges, "%v is not in range %s", value)
+	case leafKindString:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a string", value)
+		}
+		if err := checkRanges(float64(utf8.RuneCountInString(s)), t.lengths, "length of %q is not in range %s", s); err != nil {
+			return err
+		}
+		for _, pattern := range t.patterns {
+			// yang patterns are implicitly anchored
+			re, err := regexp.Compile("^(?:" + pattern + ")$")
+			if err != nil {
+				return err
+			}
+			if !re.MatchString(s) {
+				return fmt.Errorf("%q does not match pattern %s", s, pattern)
+			}
+		}
+		return nil
+	case leafKindEnum:
+		s := fmt.Sprintf("%v", value)
+		for _, enum := range t.enums {
+			if s == enum {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of %s", s, strings.Join(t.enums, ", "))
+	}
+	return nil
+}
+
+// checkRanges returns an error when f is in none of the ranges, no ranges
+// means no restriction
+func checkRanges(f float64, ranges []valueRange, format string, value interface{}) error {
+	if len(ranges) == 0 {
+		return nil
+	}
+	for _, r := range ranges {
+		if f >= r.min && f <= r.max {
+			return nil
+		}
+	}
+	return fmt.Errorf(format, value, rangesString(ranges))
+}
+
+func rangesString(ranges []valueRange) string {
+	s := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		if r.min == r.max {
+			s = append(s, strconv.FormatFloat(r.min, 'f', -1, 64))
+			continue
+		}
+		s = append(s, strconv.FormatFloat(r.min, 'f', -1, 64)+".."+strconv.FormatFloat(r.max, 'f', -1, 64))
+	}
+	return strings.Join(s, "|")
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
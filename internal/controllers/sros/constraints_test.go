@@ -0,0 +1,143 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/yndd/ndd-yang/pkg/parser"
+)
+
+var testConstraints = map[string]leafConstraint{
+	"/a/int": {
+		{kind: leafKindInteger, ranges: []valueRange{{-10, -1}, {5, 5}}},
+	},
+	"/a/dec": {
+		{kind: leafKindDecimal, ranges: []valueRange{{-30, -13}}},
+	},
+	"/a/name": {
+		{kind: leafKindString, lengths: []valueRange{{1, 4}}, patterns: []string{`[a-z]+`}},
+	},
+	"/a/enum": {
+		{kind: leafKindEnum, enums: []string{"enable", "disable"}},
+	},
+	// a union of an integer range and an enumeration
+	"/a/union": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100}}},
+		{kind: leafKindEnum, enums: []string{"max"}},
+	},
+	"/a/list/value": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 10}}},
+	},
+}
+
+var testRefPaths = []*gnmi.Path{
+	{Elem: []*gnmi.PathElem{{Name: "a"}}},
+	{Elem: []*gnmi.PathElem{{Name: "a"}, {Name: "list", Key: map[string]string{"id": ""}}}},
+}
+
+func TestValidateConstraints(t *testing.T) {
+	cases := map[string]struct {
+		data string
+		want [][]string
+	}{
+		"IntegerInRange":        {data: `{"a":{"int":-5}}`},
+		"IntegerSingleValue":    {data: `{"a":{"int":5}}`},
+		"IntegerAsString":       {data: `{"a":{"int":"-10"}}`},
+		"IntegerOutOfRange":     {data: `{"a":{"int":0}}`, want: [][]string{{"a", "int"}}},
+		"IntegerNotAnInteger":   {data: `{"a":{"int":-1.5}}`, want: [][]string{{"a", "int"}}},
+		"DecimalNegativeRange":  {data: `{"a":{"dec":"-23.5"}}`},
+		"DecimalOutOfRange":     {data: `{"a":{"dec":"-12.99"}}`, want: [][]string{{"a", "dec"}}},
+		"DecimalNotANumber":     {data: `{"a":{"dec":"low"}}`, want: [][]string{{"a", "dec"}}},
+		"StringValid":           {data: `{"a":{"name":"abcd"}}`},
+		"StringTooLong":         {data: `{"a":{"name":"abcde"}}`, want: [][]string{{"a", "name"}}},
+		"StringTooShort":        {data: `{"a":{"name":""}}`, want: [][]string{{"a", "name"}}},
+		"StringPatternAnchored": {data: `{"a":{"name":"ab1"}}`, want: [][]string{{"a", "name"}}},
+		"StringNotAString":      {data: `{"a":{"name":1}}`, want: [][]string{{"a", "name"}}},
+		"EnumValid":             {data: `{"a":{"enum":"enable"}}`},
+		"EnumInvalid":           {data: `{"a":{"enum":"up"}}`, want: [][]string{{"a", "enum"}}},
+		"UnionFirstMember":      {data: `{"a":{"union":50}}`},
+		"UnionSecondMember":     {data: `{"a":{"union":"max"}}`},
+		"UnionNoMember":         {data: `{"a":{"union":"min"}}`, want: [][]string{{"a", "union"}}},
+		"UnconstrainedLeaf":     {data: `{"a":{"other":"anything"}}`},
+		"ListEntriesByKey": {
+			data: `{"a":{"list":[{"id":"x","value":1},{"id":"y","value":11}]}}`,
+			want: [][]string{{"a", "list[id=y]", "value"}},
+		},
+		"MultipleViolationsSorted": {
+			data: `{"a":{"name":"ABC","enum":"up","int":0}}`,
+			want: [][]string{{"a", "enum"}, {"a", "int"}, {"a", "name"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var x interface{}
+			if err := json.Unmarshal([]byte(tc.data), &x); err != nil {
+				t.Fatal(err)
+			}
+			got := make([][]string, 0)
+			for _, v := range validateConstraints(x, testConstraints, testRefPaths) {
+				got = append(got, v.elems)
+			}
+			if len(tc.want) == 0 {
+				tc.want = [][]string{}
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("validateConstraints(%s): got %v, want %v", tc.data, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestValidateConfigurePort validates leafs whose ranges got lost in the
+// markers of the api types against the constraints of the port
+func TestValidateConfigurePort(t *testing.T) {
+	cases := map[string]struct {
+		data  string
+		valid bool
+	}{
+		"RxLosThreshValid":        {data: `{"dwdm":{"coherent":{"rx-los-thresh":"-23"}}}`, valid: true},
+		"RxLosThreshOutOfRange":   {data: `{"dwdm":{"coherent":{"rx-los-thresh":"-12"}}}`},
+		"PtpAsymmetryNegative":    {data: `{"ethernet":{"ptp-asymmetry":-2147483648}}`, valid: true},
+		"PtpAsymmetryOutOfRange":  {data: `{"ethernet":{"ptp-asymmetry":2147483648}}`},
+		"SpeedValid":              {data: `{"ethernet":{"speed":10000}}`, valid: true},
+		"SpeedInvalid":            {data: `{"ethernet":{"speed":20000}}`},
+		"MacAddressValid":         {data: `{"ethernet":{"mac-address":"00:11:22:aa:bb:cc"}}`, valid: true},
+		"MacAddressInvalid":       {data: `{"ethernet":{"mac-address":"00:11:22:aa:bb"}}`},
+		"QueueGroupListEntry":     {data: `{"ethernet":{"access":{"egress":{"queue-group":[{"queue-group-name":"qg1","instance-id":1}]}}}}`, valid: true},
+		"QueueGroupListEntryBad":  {data: `{"ethernet":{"access":{"egress":{"queue-group":[{"queue-group-name":"qg1","instance-id":0}]}}}}`},
+		"DownWhenLoopedRetryZero": {data: `{"ethernet":{"down-when-looped":{"retry-timeout":0}}}`, valid: true},
+		"DownWhenLoopedRetryGap":  {data: `{"ethernet":{"down-when-looped":{"retry-timeout":5}}}`},
+	}
+	p := parser.NewParser()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var port map[string]interface{}
+			if err := json.Unmarshal([]byte(tc.data), &port); err != nil {
+				t.Fatal(err)
+			}
+			port["port-id"] = "1/1/1"
+			violations := validateConfigurePort(p, map[string]interface{}{"port": port})
+			if (len(violations) == 0) != tc.valid {
+				t.Errorf("validateConfigurePort(%s): violations %v, want valid %t", tc.data, violations, tc.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gnmitypes "github.com/karimra/gnmic/types"
+	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+const (
+	// keys of the credentials secret
+	credentialsUsername = "username"
+	credentialsPassword = "password"
+
+	// keys of the tls credentials secret
+	tlsCredentialsCA   = "TLSCA"
+	tlsCredentialsCert = "TLSCert"
+	tlsCredentialsKey  = "TLSKey"
+
+	// directory in which the tls material is stored, gnmic loads the
+	// certificates and keys from files
+	tlsDirectory = "ndd-provider-sros"
+
+	// errors
+	errCredentialsMissing    = "network node credentials are missing"
+	errTLSCredentialsMissing = "network node tls credentials are missing"
+	errGetCredentials        = "cannot get network node credentials"
+	errWriteTLSCredentials   = "cannot write network node tls credentials"
+)
+
+// tlsLocks serializes the access to the tls directory of a network node, the
+// resources of a network node are reconciled concurrently
+var tlsLocks sync.Map
+
+// A missingCredentialsError indicates the credentials of a network node are
+// not available, as opposed to an error reading or writing them
+type missingCredentialsError struct {
+	error
+}
+
+func (e missingCredentialsError) Unwrap() error { return e.error }
+
+func missingCredentials(err error) error {
+	return missingCredentialsError{error: err}
+}
+
+// isMissingCredentials returns true when the error is caused by missing
+// credentials
+func isMissingCredentials(err error) bool {
+	var e missingCredentialsError
+	return errors.As(err, &e)
+}
+
+// getResourceTargetConfig returns the gnmi configuration like getTargetConfig
+// and reflects the availability of the credentials in the Credentials
+// condition of the resource
+func getResourceTargetConfig(ctx context.Context, kube client.Client, nn *ndrv1.NetworkNode, namespace string, o resource.Conditioned) (*gnmitypes.TargetConfig, error) {
+	cfg, err := getTargetConfig(ctx, kube, nn, namespace)
+	if err != nil {
+		if isMissingCredentials(err) {
+			o.SetConditions(srosv1alpha1.CredentialsMissing(err.Error()))
+		}
+		return nil, err
+	}
+	o.SetConditions(srosv1alpha1.CredentialsAvailable())
+	return cfg, nil
+}
+
+// getTargetConfig returns the gnmi configuration to connect to the device driver
+// of the network node. Username and password are resolved from the secret
+// referenced by CredentialsName, the CA, certificate and key from the secret
+// referenced by TLSCredentialsName. When both certificate and key are present
+// mutual TLS is used. The secrets are read on every call such that rotated
+// credentials are picked up by the next reconciliation.
+func getTargetConfig(ctx context.Context, kube client.Client, nn *ndrv1.NetworkNode, namespace string) (*gnmitypes.TargetConfig, error) {
+	if namespace == "" {
+		namespace = ndrv1.Namespace
+	}
+	if nn.Spec.Target == nil || nn.Spec.Target.CredentialsName == nil || *nn.Spec.Target.CredentialsName == "" {
+		return nil, missingCredentials(errors.Errorf("%s: network node %s has no credentialsName", errCredentialsMissing, nn.GetName()))
+	}
+
+	creds, err := getSecret(ctx, kube, namespace, *nn.Spec.Target.CredentialsName)
+	if err != nil {
+		return nil, errors.Wrap(err, errCredentialsMissing)
+	}
+	username, err := getSecretKey(creds, credentialsUsername)
+	if err != nil {
+		return nil, errors.Wrap(err, errCredentialsMissing)
+	}
+	password, err := getSecretKey(creds, credentialsPassword)
+	if err != nil {
+		return nil, errors.Wrap(err, errCredentialsMissing)
+	}
+
+	cfg := &gnmitypes.TargetConfig{
+		Name:       nn.GetName(),
+		Address:    ndrv1.PrefixService + "-" + nn.Name + "." + ndrv1.NamespaceLocalK8sDNS + strconv.Itoa(*nn.Spec.GrpcServerPort),
+		Username:   utils.StringPtr(string(username)),
+		Password:   utils.StringPtr(string(password)),
+		Timeout:    10 * time.Second,
+		SkipVerify: utils.BoolPtr(nn.Spec.Target.SkipVerify != nil && *nn.Spec.Target.SkipVerify),
+		Insecure:   utils.BoolPtr(nn.Spec.Target.Insecure != nil && *nn.Spec.Target.Insecure),
+		TLSCA:      utils.StringPtr(""),
+		TLSCert:    utils.StringPtr(""),
+		TLSKey:     utils.StringPtr(""),
+		Gzip:       utils.BoolPtr(false),
+	}
+
+	if nn.Spec.Target.TLSCredentialsName == nil || *nn.Spec.Target.TLSCredentialsName == "" {
+		return cfg, nil
+	}
+	tlsCreds, err := getSecret(ctx, kube, namespace, *nn.Spec.Target.TLSCredentialsName)
+	if err != nil {
+		return nil, errors.Wrap(err, errTLSCredentialsMissing)
+	}
+	// the resource version is part of the file names, such that a change of the
+	// tls material results in a change of the target config
+	dir := filepath.Join(os.TempDir(), tlsDirectory, nn.GetName())
+	prefix := tlsCreds.GetName() + "-" + tlsCreds.GetResourceVersion()
+	mu, _ := tlsLocks.LoadOrStore(dir, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+	if err := cleanTLSDirectory(dir, prefix); err != nil {
+		return nil, errors.Wrap(err, errWriteTLSCredentials)
+	}
+	if ca, ok := tlsCreds.Data[tlsCredentialsCA]; ok && len(ca) > 0 {
+		if cfg.TLSCA, err = writeTLSFile(dir, prefix+"-ca.crt", ca); err != nil {
+			return nil, errors.Wrap(err, errWriteTLSCredentials)
+		}
+	}
+	cert, hasCert := tlsCreds.Data[tlsCredentialsCert]
+	key, hasKey := tlsCreds.Data[tlsCredentialsKey]
+	if hasCert != hasKey {
+		return nil, missingCredentials(errors.Errorf("%s: secret %s/%s requires both %s and %s for mutual tls",
+			errTLSCredentialsMissing, namespace, tlsCreds.GetName(), tlsCredentialsCert, tlsCredentialsKey))
+	}
+	if hasCert {
+		if cfg.TLSCert, err = writeTLSFile(dir, prefix+"-tls.crt", cert); err != nil {
+			return nil, errors.Wrap(err, errWriteTLSCredentials)
+		}
+		if cfg.TLSKey, err = writeTLSFile(dir, prefix+"-tls.key", key); err != nil {
+			return nil, errors.Wrap(err, errWriteTLSCredentials)
+		}
+	}
+	// tls credentials imply a secure connection
+	cfg.Insecure = utils.BoolPtr(false)
+
+	return cfg, nil
+}
+
+// getSecret returns the secret with the given name; a missing secret is
+// reported as missing credentials rather than a missing target
+func getSecret(ctx context.Context, kube client.Client, namespace, name string) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, s); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, missingCredentials(errors.Errorf("secret %s/%s does not exist", namespace, name))
+		}
+		return nil, errors.Wrap(err, errGetCredentials)
+	}
+	return s, nil
+}
+
+func getSecretKey(s *corev1.Secret, key string) ([]byte, error) {
+	v, ok := s.Data[key]
+	if !ok || len(v) == 0 {
+		return nil, missingCredentials(errors.Errorf("secret %s/%s has no key %s", s.GetNamespace(), s.GetName(), key))
+	}
+	return v, nil
+}
+
+// writeTLSFile writes the tls material to a file in dir, the file is only
+// rewritten when its content changed. The content is written to a temporary
+// file that is renamed, such that a concurrent dial never reads a partially
+// written file.
+func writeTLSFile(dir, name string, d []byte) (*string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	p := filepath.Join(dir, name)
+	if cur, err := ioutil.ReadFile(p); err == nil && bytes.Equal(cur, d) {
+		return utils.StringPtr(p), nil
+	}
+	f, err := ioutil.TempFile(dir, "."+name+"-")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write(d); err != nil {
+		f.Close()           // nolint:errcheck
+		os.Remove(f.Name()) // nolint:errcheck
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name()) // nolint:errcheck
+		return nil, err
+	}
+	if err := os.Rename(f.Name(), p); err != nil {
+		os.Remove(f.Name()) // nolint:errcheck
+		return nil, err
+	}
+	return utils.StringPtr(p), nil
+}
+
+// cleanTLSDirectory removes tls material of previous secret versions
+func cleanTLSDirectory(dir, prefix string) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, f := range files {
+		if !strings.HasPrefix(f.Name(), prefix+"-") {
+			if err := os.Remove(filepath.Join(dir, f.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
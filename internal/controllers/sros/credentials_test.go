@@ -0,0 +1,170 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+func testNetworkNode(name, creds, tlsCreds string) *ndrv1.NetworkNode {
+	nn := &ndrv1.NetworkNode{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: ndrv1.NetworkNodeSpec{
+			Target:         &ndrv1.TargetDetails{},
+			GrpcServerPort: new(int),
+		},
+	}
+	if creds != "" {
+		nn.Spec.Target.CredentialsName = utils.StringPtr(creds)
+	}
+	if tlsCreds != "" {
+		nn.Spec.Target.TLSCredentialsName = utils.StringPtr(tlsCreds)
+	}
+	return nn
+}
+
+func testSecret(name string, data map[string]string) *corev1.Secret {
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ndrv1.Namespace, Name: name, ResourceVersion: "1"},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		s.Data[k] = []byte(v)
+	}
+	return s
+}
+
+func TestGetResourceTargetConfigCondition(t *testing.T) {
+	cases := map[string]struct {
+		nn      *ndrv1.NetworkNode
+		objs    []client.Object
+		wantErr bool
+		want    corev1.ConditionStatus
+	}{
+		"NoCredentialsName": {
+			nn:      testNetworkNode("sr1", "", ""),
+			wantErr: true,
+			want:    corev1.ConditionFalse,
+		},
+		"SecretMissing": {
+			nn:      testNetworkNode("sr1", "creds", ""),
+			wantErr: true,
+			want:    corev1.ConditionFalse,
+		},
+		"PasswordMissing": {
+			nn:      testNetworkNode("sr1", "creds", ""),
+			objs:    []client.Object{testSecret("creds", map[string]string{credentialsUsername: "admin"})},
+			wantErr: true,
+			want:    corev1.ConditionFalse,
+		},
+		"TLSKeyMissing": {
+			nn: testNetworkNode("sr1", "creds", "tls"),
+			objs: []client.Object{
+				testSecret("creds", map[string]string{credentialsUsername: "admin", credentialsPassword: "admin"}),
+				testSecret("tls", map[string]string{tlsCredentialsCert: "cert"}),
+			},
+			wantErr: true,
+			want:    corev1.ConditionFalse,
+		},
+		"Available": {
+			nn:   testNetworkNode("sr1", "creds", ""),
+			objs: []client.Object{testSecret("creds", map[string]string{credentialsUsername: "admin", credentialsPassword: "admin"})},
+			want: corev1.ConditionTrue,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := fake.NewClientBuilder().WithObjects(tc.objs...).Build()
+			o := &srosv1alpha1.SrosConfigurePort{}
+			_, err := getResourceTargetConfig(context.Background(), kube, tc.nn, "", o)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("getResourceTargetConfig(): error %v, want error %t", err, tc.wantErr)
+			}
+			if got := o.GetCondition(srosv1alpha1.ConditionKindCredentials).Status; got != tc.want {
+				t.Errorf("credentials condition %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestGetTargetConfigTLSConcurrent resolves the tls credentials of a network
+// node concurrently while the secret is rotated, every returned file must
+// contain the complete material of one of the secret versions
+func TestGetTargetConfigTLSConcurrent(t *testing.T) {
+	name := "sr-tls-" + filepath.Base(t.TempDir())
+	defer os.RemoveAll(filepath.Join(os.TempDir(), tlsDirectory, name))
+
+	ca := map[string][]byte{
+		"1": bytes.Repeat([]byte("a"), 64*1024),
+		"2": bytes.Repeat([]byte("b"), 64*1024),
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, 64)
+	for i := 0; i < 32; i++ {
+		version := "1"
+		if i%2 == 1 {
+			version = "2"
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tls := testSecret("tls", map[string]string{tlsCredentialsCA: string(ca[version])})
+			tls.ResourceVersion = version
+			kube := fake.NewClientBuilder().WithObjects(
+				testSecret("creds", map[string]string{credentialsUsername: "admin", credentialsPassword: "admin"}),
+				tls,
+			).Build()
+			cfg, err := getTargetConfig(context.Background(), kube, testNetworkNode(name, "creds", "tls"), "")
+			if err != nil {
+				errs <- err
+				return
+			}
+			// the file of this version can be cleaned by a concurrent
+			// rotation, but it must never be partially written
+			d, err := ioutil.ReadFile(*cfg.TLSCA)
+			if err != nil {
+				if !os.IsNotExist(err) {
+					errs <- err
+				}
+				return
+			}
+			if !bytes.Equal(d, ca[version]) {
+				errs <- os.ErrInvalid
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
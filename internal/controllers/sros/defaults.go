@@ -0,0 +1,139 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+)
+
+// defaulter sets the yang defaults of the leafs in json data, the defaults
+// are indexed by the schema path of the parent container
+type defaulter struct {
+	// default values of the leafs
+	leafs map[string]map[string]interface{}
+	// containers the device reports together with their parent, indexed by
+	// the schema path of the parent
+	containers map[string]map[string]bool
+}
+
+// newDefaulter returns a defaulter for the defaults, which are indexed by the
+// schema path of the leaf and hold the default as a json value. The containers
+// are the schema paths of the containers that hold defaulted leafs and can be
+// reported by the device as soon as their parent exists. It panics when a
+// default is not valid json, as the defaults are generated from the yang model.
+func newDefaulter(defaults map[string]string, containers []string) *defaulter {
+	d := &defaulter{
+		leafs:      make(map[string]map[string]interface{}),
+		containers: make(map[string]map[string]bool),
+	}
+	for leafPath, value := range defaults {
+		var v interface{}
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			panic(fmt.Sprintf("invalid default %s for %s: %s", value, leafPath, err))
+		}
+		parent, name := path.Split(leafPath)
+		parent = path.Clean(parent)
+		if _, ok := d.leafs[parent]; !ok {
+			d.leafs[parent] = make(map[string]interface{})
+		}
+		d.leafs[parent][name] = v
+	}
+	for _, containerPath := range containers {
+		parent, name := path.Split(containerPath)
+		parent = path.Clean(parent)
+		if _, ok := d.containers[parent]; !ok {
+			d.containers[parent] = make(map[string]bool)
+		}
+		d.containers[parent][name] = true
+	}
+	return d
+}
+
+// apply sets the default of every leaf that is absent in a container that is
+// present in the json data and returns the data. Containers are never added,
+// a container that is not in the data stays absent.
+func (d *defaulter) apply(x interface{}) interface{} {
+	d.walk(x, "")
+	return x
+}
+
+// normalize defaults the json data like apply and removes the containers the
+// device reports with their parent when they hold nothing but default values.
+// The spec and the data of the device are normalized the same way, such that
+// leafs and containers the device reports with their defaults do not show up
+// as a difference.
+func (d *defaulter) normalize(x interface{}) interface{} {
+	d.walk(x, "")
+	d.prune(x, "")
+	return x
+}
+
+func (d *defaulter) walk(x interface{}, schemaPath string) {
+	m, ok := x.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for name, v := range d.leafs[schemaPath] {
+		if _, ok := m[name]; !ok {
+			m[name] = v
+		}
+	}
+	for name, v := range m {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			d.walk(v, schemaPath+"/"+name)
+		case []interface{}:
+			for _, entry := range v {
+				d.walk(entry, schemaPath+"/"+name)
+			}
+		}
+	}
+}
+
+// prune removes the containers below the json data that hold nothing but
+// default values and reports whether the data itself only holds defaults
+func (d *defaulter) prune(x interface{}, schemaPath string) bool {
+	m, ok := x.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	onlyDefaults := true
+	for name, v := range m {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if d.prune(v, schemaPath+"/"+name) && d.containers[schemaPath][name] {
+				delete(m, name)
+				continue
+			}
+			onlyDefaults = false
+		case []interface{}:
+			for _, entry := range v {
+				d.prune(entry, schemaPath+"/"+name)
+			}
+			onlyDefaults = false
+		default:
+			def, ok := d.leafs[schemaPath][name]
+			if !ok || !reflect.DeepEqual(v, def) {
+				onlyDefaults = false
+			}
+		}
+	}
+	return onlyDefaults
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func unmarshalTestData(t *testing.T, data string) interface{} {
+	t.Helper()
+	var x interface{}
+	if err := json.Unmarshal([]byte(data), &x); err != nil {
+		t.Fatal(err)
+	}
+	return x
+}
+
+func TestDefaulter(t *testing.T) {
+	d := newDefaulter(map[string]string{
+		"/port/admin-state":                `"enable"`,
+		"/port/ethernet/mtu":               `1514`,
+		"/port/ethernet/dampening/half":    `5`,
+		"/port/ethernet/dampening/enabled": `false`,
+		"/port/transceiver/coherent":       `false`,
+		"/port/list/value":                 `1`,
+	}, []string{
+		"/port/ethernet/dampening",
+		"/port/transceiver",
+	})
+
+	cases := map[string]struct {
+		data      string
+		apply     string
+		normalize string
+	}{
+		"ContainersAreNotAdded": {
+			data:      `{"port":{"description":"a"}}`,
+			apply:     `{"port":{"description":"a","admin-state":"enable"}}`,
+			normalize: `{"port":{"description":"a","admin-state":"enable"}}`,
+		},
+		"PresentContainerIsDefaulted": {
+			data:      `{"port":{"ethernet":{"dampening":{"half":10}}}}`,
+			apply:     `{"port":{"admin-state":"enable","ethernet":{"mtu":1514,"dampening":{"half":10,"enabled":false}}}}`,
+			normalize: `{"port":{"admin-state":"enable","ethernet":{"mtu":1514,"dampening":{"half":10,"enabled":false}}}}`,
+		},
+		"DefaultOnlyContainersArePruned": {
+			data:      `{"port":{"ethernet":{"dampening":{}},"transceiver":{"coherent":false}}}`,
+			apply:     `{"port":{"admin-state":"enable","ethernet":{"mtu":1514,"dampening":{"half":5,"enabled":false}},"transceiver":{"coherent":false}}}`,
+			normalize: `{"port":{"admin-state":"enable","ethernet":{"mtu":1514}}}`,
+		},
+		// the ethernet container is not reported by the device with its
+		// parent, it stays when it only holds defaults
+		"OtherContainersAreKept": {
+			data:      `{"port":{"ethernet":{}}}`,
+			apply:     `{"port":{"admin-state":"enable","ethernet":{"mtu":1514}}}`,
+			normalize: `{"port":{"admin-state":"enable","ethernet":{"mtu":1514}}}`,
+		},
+		"ListEntriesAreDefaulted": {
+			data:      `{"port":{"list":[{"id":"a"},{"id":"b","value":2}]}}`,
+			apply:     `{"port":{"admin-state":"enable","list":[{"id":"a","value":1},{"id":"b","value":2}]}}`,
+			normalize: `{"port":{"admin-state":"enable","list":[{"id":"a","value":1},{"id":"b","value":2}]}}`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got, want := d.apply(unmarshalTestData(t, tc.data)), unmarshalTestData(t, tc.apply); !reflect.DeepEqual(got, want) {
+				t.Errorf("apply(%s): got %v, want %v", tc.data, got, want)
+			}
+			if got, want := d.normalize(unmarshalTestData(t, tc.data)), unmarshalTestData(t, tc.normalize); !reflect.DeepEqual(got, want) {
+				t.Errorf("normalize(%s): got %v, want %v", tc.data, got, want)
+			}
+		})
+	}
+}
+
+// TestNormalizeConfigurePort checks that a port the device reports with the
+// default containers compares equal to a spec without them
+func TestNormalizeConfigurePort(t *testing.T) {
+	spec := normalizeConfigurePort(unmarshalTestData(t, `{"port":{"port-id":"1/1/1","description":"a","ethernet":{}}}`))
+	if m := spec.(map[string]interface{})["port"].(map[string]interface{}); m["transceiver"] != nil {
+		t.Errorf("normalizeConfigurePort(): the transceiver container is added to the spec: %v", m)
+	}
+	if m := defaultConfigurePort(unmarshalTestData(t, `{"port":{"port-id":"1/1/1","ethernet":{}}}`)).(map[string]interface{})["port"].(map[string]interface{}); m["transceiver"] != nil {
+		t.Errorf("defaultConfigurePort(): the transceiver container is added to the spec: %v", m)
+	} else if e := m["ethernet"].(map[string]interface{}); e["dampening"] != nil {
+		t.Errorf("defaultConfigurePort(): the dampening container is added to the spec: %v", e)
+	}
+
+	device := normalizeConfigurePort(unmarshalTestData(t, `{"port":{"port-id":"1/1/1","description":"a","ethernet":{"dampening":{}},"transceiver":{"digital-coherent-optics":false}}}`))
+	if !reflect.DeepEqual(spec, device) {
+		t.Errorf("normalizeConfigurePort(): spec %v and device %v differ", spec, device)
+	}
+}
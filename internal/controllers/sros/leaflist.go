@@ -0,0 +1,199 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// leafListPrefix marks a json string that holds the values of a yang
+// leaf-list. The parser treats every json array as a keyed list and drops
+// arrays it has no key for, and the delta calculation cannot compare arrays,
+// so a leaf-list is carried as the value of a leaf until the updates are built.
+// A string of the data that starts with leafListPrefix or leafListEscape is
+// prefixed with leafListEscape, such that it is never taken for a leaf-list.
+const (
+	leafListPrefix = "leaf-list:"
+	leafListEscape = "leaf-list-escape:"
+)
+
+// leafLists holds the schema paths of the leaf-lists of a resource, e.g.
+// /bgp/import/policy. The leaf-lists are taken from the yang model, as a json
+// array of the data is either a keyed list or a leaf-list and the data alone
+// does not tell them apart.
+type leafLists map[string]bool
+
+// newLeafLists returns the leaf-lists with the schema paths
+func newLeafLists(schemaPaths ...string) leafLists {
+	l := make(leafLists, len(schemaPaths))
+	for _, schemaPath := range schemaPaths {
+		l[schemaPath] = true
+	}
+	return l
+}
+
+// mark replaces the json arrays of the leaf-lists in the json data by a marked
+// json string, escapes the strings that would be taken for a marked json
+// string and returns the data
+func (l leafLists) mark(x interface{}) interface{} {
+	return l.markPath(x, "")
+}
+
+func (l leafLists) markPath(x interface{}, schemaPath string) interface{} {
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for name, v := range x {
+			x[name] = l.markPath(v, schemaPath+"/"+name)
+		}
+	case []interface{}:
+		// the array is marked as a whole before any of its values is
+		// changed, such that a leaf-list is never marked half-processed
+		if l[schemaPath] && isScalarArray(x) {
+			b, err := json.Marshal(x)
+			if err != nil {
+				return x
+			}
+			return leafListPrefix + string(b)
+		}
+		for i, v := range x {
+			x[i] = l.markPath(v, schemaPath)
+		}
+	case string:
+		if strings.HasPrefix(x, leafListPrefix) || strings.HasPrefix(x, leafListEscape) {
+			return leafListEscape + x
+		}
+	}
+	return x
+}
+
+// isScalarArray returns true when none of the values of the json array is an
+// object or an array
+func isScalarArray(x []interface{}) bool {
+	for _, v := range x {
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
+			return false
+		}
+	}
+	return true
+}
+
+// restoreLeafLists replaces the marked json strings in the json values of the
+// updates by the json arrays of the leaf-lists and unescapes the other strings
+func restoreLeafLists(updates []*gnmi.Update) ([]*gnmi.Update, error) {
+	for _, u := range updates {
+		var b []byte
+		switch v := u.GetVal().GetValue().(type) {
+		case *gnmi.TypedValue_JsonIetfVal:
+			b = v.JsonIetfVal
+		case *gnmi.TypedValue_JsonVal:
+			b = v.JsonVal
+		default:
+			continue
+		}
+		if !bytes.Contains(b, []byte(leafListPrefix)) && !bytes.Contains(b, []byte(leafListEscape)) {
+			continue
+		}
+		var x interface{}
+		if err := json.Unmarshal(b, &x); err != nil {
+			return nil, err
+		}
+		x, err := unmarkLeafLists(x)
+		if err != nil {
+			return nil, err
+		}
+		if b, err = json.Marshal(x); err != nil {
+			return nil, err
+		}
+		b = bytes.Trim(b, " \r\n\t")
+		// the value keeps its encoding
+		if _, ok := u.GetVal().GetValue().(*gnmi.TypedValue_JsonVal); ok {
+			u.Val = &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: b}}
+			continue
+		}
+		u.Val = &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: b}}
+	}
+	return updates, nil
+}
+
+func unmarkLeafLists(x interface{}) (interface{}, error) {
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for k, v := range x {
+			v, err := unmarkLeafLists(v)
+			if err != nil {
+				return nil, err
+			}
+			x[k] = v
+		}
+	case []interface{}:
+		for i, v := range x {
+			v, err := unmarkLeafLists(v)
+			if err != nil {
+				return nil, err
+			}
+			x[i] = v
+		}
+	case string:
+		switch {
+		case strings.HasPrefix(x, leafListEscape):
+			return strings.TrimPrefix(x, leafListEscape), nil
+		case strings.HasPrefix(x, leafListPrefix):
+			var l []interface{}
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(x, leafListPrefix)), &l); err != nil {
+				return nil, err
+			}
+			return l, nil
+		}
+	}
+	return x, nil
+}
+
+// key replaces the json arrays of the leaf-lists in the json data by a list of
+// entries keyed by the name of the leaf-list and returns the data, the parser
+// resolves a leafref for every entry of a keyed list but not for the values of
+// a leaf-list
+func (l leafLists) key(x interface{}) interface{} {
+	return l.keyPath(x, "")
+}
+
+func (l leafLists) keyPath(x interface{}, schemaPath string) interface{} {
+	switch x := x.(type) {
+	case map[string]interface{}:
+		for name, v := range x {
+			x[name] = l.keyPath(v, schemaPath+"/"+name)
+		}
+	case []interface{}:
+		if l[schemaPath] && isScalarArray(x) {
+			_, name := path.Split(schemaPath)
+			entries := make([]interface{}, 0, len(x))
+			for _, v := range x {
+				entries = append(entries, map[string]interface{}{name: v})
+			}
+			return entries
+		}
+		for i, v := range x {
+			x[i] = l.keyPath(v, schemaPath)
+		}
+	}
+	return x
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// testLeafLists are the leaf-lists of the test data
+var testLeafLists = newLeafLists("/import/policy", "/export/policy", "/group/import/policy", "/neighbor/import/policy")
+
+func TestMarkLeafLists(t *testing.T) {
+	cases := map[string]struct {
+		data string
+		want string
+	}{
+		"LeafList": {
+			data: `{"import":{"policy":["p1","p2"]}}`,
+			want: `{"import":{"policy":"leaf-list:[\"p1\",\"p2\"]"}}`,
+		},
+		"EmptyLeafList": {
+			data: `{"export":{"policy":[]}}`,
+			want: `{"export":{"policy":"leaf-list:[]"}}`,
+		},
+		"KeyedList": {
+			data: `{"neighbor":[{"ip-address":"10.0.0.1","import":{"policy":["p1"]}}]}`,
+			want: `{"neighbor":[{"ip-address":"10.0.0.1","import":{"policy":"leaf-list:[\"p1\"]"}}]}`,
+		},
+		// the values of a leaf-list are not escaped, the leaf-list is restored
+		// from the marked json string as a whole
+		"LeafListValuesLikeTheMarker": {
+			data: `{"import":{"policy":["leaf-list:p1"]}}`,
+			want: `{"import":{"policy":"leaf-list:[\"leaf-list:p1\"]"}}`,
+		},
+		"EscapedStrings": {
+			data: `{"description":"leaf-list:[1]","group":[{"description":"leaf-list-escape:a"}]}`,
+			want: `{"description":"leaf-list-escape:leaf-list:[1]","group":[{"description":"leaf-list-escape:leaf-list-escape:a"}]}`,
+		},
+		// an array that is not a leaf-list of the schema is not marked, even
+		// when it only holds scalar values
+		"ScalarArrayNotALeafList": {
+			data: `{"description":["p1"],"group":[{"export":{"policy":["p1"]}}]}`,
+			want: `{"description":["p1"],"group":[{"export":{"policy":["p1"]}}]}`,
+		},
+		// a list with an entry that is not an object is processed entry by
+		// entry, it is not marked after a part of its entries changed
+		"ListWithScalarEntry": {
+			data: `{"neighbor":[{"description":"leaf-list:x"},"n1"]}`,
+			want: `{"neighbor":[{"description":"leaf-list-escape:leaf-list:x"},"n1"]}`,
+		},
+		"LeafListWithObject": {
+			data: `{"import":{"policy":[{"description":"leaf-list:x"},"p1"]}}`,
+			want: `{"import":{"policy":[{"description":"leaf-list-escape:leaf-list:x"},"p1"]}}`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := json.Marshal(testLeafLists.mark(unmarshalTestData(t, tc.data)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(unmarshalTestData(t, string(got)), unmarshalTestData(t, tc.want)) {
+				t.Errorf("mark(): got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestRestoreLeafLists marks the data, builds an update from it and restores
+// the leaf-lists, the value of the update is the original data
+func TestRestoreLeafLists(t *testing.T) {
+	cases := map[string]struct {
+		data string
+		json bool
+	}{
+		"LeafList": {
+			data: `{"import":{"policy":["p1","p2"]},"export":{"policy":[]}}`,
+		},
+		"StringsLikeTheMarker": {
+			data: `{"description":"leaf-list:[\"p1\"]","group":[{"group-name":"leaf-list-escape:g1","import":{"policy":["leaf-list:p1"]}}]}`,
+		},
+		"JsonVal": {
+			data: `{"description":"leaf-list:x","import":{"policy":["p1"]}}`,
+			json: true,
+		},
+		"Unmarked": {
+			data: `{"description":"policy"}`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(testLeafLists.mark(unmarshalTestData(t, tc.data)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			v := &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: b}}
+			if tc.json {
+				v = &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: b}}
+			}
+			updates, err := restoreLeafLists([]*gnmi.Update{{Val: v}})
+			if err != nil {
+				t.Fatalf("restoreLeafLists(): %v", err)
+			}
+			got := updates[0].GetVal().GetJsonIetfVal()
+			if tc.json {
+				got = updates[0].GetVal().GetJsonVal()
+			}
+			if got == nil {
+				t.Fatalf("restoreLeafLists(): the encoding of the value changed to %T", updates[0].GetVal().GetValue())
+			}
+			if !reflect.DeepEqual(unmarshalTestData(t, string(got)), unmarshalTestData(t, tc.data)) {
+				t.Errorf("restoreLeafLists(): got %s, want %s", got, tc.data)
+			}
+		})
+	}
+}
+
+func TestRestoreLeafListsInvalidMarker(t *testing.T) {
+	v := &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"policy":"leaf-list:[p1"}`)}}
+	if _, err := restoreLeafLists([]*gnmi.Update{{Val: v}}); err == nil {
+		t.Error("restoreLeafLists(): an invalid marked leaf-list must fail")
+	}
+}
+
+func TestKeyLeafLists(t *testing.T) {
+	got := testLeafLists.key(unmarshalTestData(t, `{"import":{"policy":["p1","p2"]},"neighbor":[{"ip-address":"10.0.0.1","import":{"policy":["p3"]}}],"description":["d1"]}`))
+	want := unmarshalTestData(t, `{"import":{"policy":[{"policy":"p1"},{"policy":"p2"}]},"neighbor":[{"ip-address":"10.0.0.1","import":{"policy":[{"policy":"p3"}]}}],"description":["d1"]}`)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("key(): got %s, want %s", toJSON(t, got), toJSON(t, want))
+	}
+}
@@ -19,16 +19,26 @@ package sros
 import (
 	"context"
 	"encoding/json"
-	"strconv"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	cevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/yndd/ndd-yang/pkg/parser"
 
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
 	"github.com/yndd/ndd-provider-sros/internal/collector"
 
 	"github.com/karimra/gnmic/target"
@@ -40,8 +50,10 @@ import (
 	"github.com/yndd/ndd-runtime/pkg/logging"
 	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
 	"github.com/yndd/ndd-runtime/pkg/resource"
-	"github.com/yndd/ndd-runtime/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
 )
@@ -57,20 +69,26 @@ const (
 	errRegistrationCreate           = "cannot create Registration"
 	errRegistrationUpdate           = "cannot update Registration"
 	errRegistrationDelete           = "cannot delete Registration"
+	errUpdateRegistrationStatus     = "cannot update Registration status"
+	errNetworkNodeSelector          = "invalid network node selector"
+	errSwVersionConstraint          = "invalid software version constraint"
+	errSendTargetUpdate             = "cannot send target update to the deviation server"
 )
 
 // SetupRegistration adds a controller that reconciles Registrations.
-func SetupRegistration(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, namespace string, subChan chan collector.TargetUpdate) error {
+func SetupRegistration(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, namespace string, pool *clientpool.Pool, subChan chan collector.TargetUpdate) (string, error) {
 
 	name := managed.ControllerName(srosv1alpha1.RegistrationGroupKind)
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(srosv1alpha1.RegistrationGroupVersionKind),
 		managed.WithExternalConnecter(&connectorRegistration{
-			log:     l,
-			kube:    mgr.GetClient(),
-			subChan: subChan,
-			usage:   resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
+			log:       l,
+			kube:      mgr.GetClient(),
+			namespace: namespace,
+			pool:      pool,
+			subChan:   subChan,
+			usage:     resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
 			//newClientFn: regclient.NewClient},
 			newClientFn: target.NewTarget},
 		),
@@ -78,16 +96,184 @@ func SetupRegistration(mgr ctrl.Manager, o controller.Options, l logging.Logger,
 		managed.WithLogger(l.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
-	return ctrl.NewControllerManagedBy(mgr).
+	return srosv1alpha1.RegistrationGroupKind, ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o).
-		For(&srosv1alpha1.Registration{}).
-		WithEventFilter(resource.IgnoreUpdateWithoutGenerationChangePredicate()).
-		//Watches(
-		//	&source.Kind{Type: &ndrv1.NetworkNode{}},
-		//	handler.EnqueueRequestsFromMapFunc(r.NetworkNodeMapFunc),
-		//).
-		Complete(r)
+		For(&srosv1alpha1.Registration{}, builder.WithPredicates(resource.IgnoreUpdateWithoutGenerationChangePredicate())).
+		Watches(
+			&source.Kind{Type: &ndrv1.NetworkNode{}},
+			handler.EnqueueRequestsFromMapFunc(networkNodeMapFuncRegistration(mgr.GetClient(), l)),
+			builder.WithPredicates(networkNodeChangedPredicate()),
+		).
+		Complete(&registrationReconciler{
+			Reconciler: r,
+			kube:       mgr.GetClient(),
+			reader:     mgr.GetAPIReader(),
+			log:        l.WithValues("controller", name),
+		})
+}
+
+// registrationReconciler sets the Ready condition of a Registration from the
+// registration status of its network nodes after the managed reconciler ran,
+// since the managed reconciler reports a Registration as available or not
+// without knowing the registration failed on a part of the network nodes
+type registrationReconciler struct {
+	reconcile.Reconciler
+	kube client.Client
+	// reader reads the Registration from the api server, the cache does not
+	// have the status the managed reconciler just updated
+	reader client.Reader
+	log    logging.Logger
+}
+
+// Reconcile a Registration with the managed reconciler and set its Ready
+// condition when the registration failed on network nodes.
+func (r *registrationReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	result, err := r.Reconciler.Reconcile(ctx, req)
+
+	o := &srosv1alpha1.Registration{}
+	if gerr := r.reader.Get(ctx, req.NamespacedName, o); gerr != nil {
+		if kerrors.IsNotFound(gerr) {
+			return result, err
+		}
+		return result, errors.Wrap(gerr, errRegistrationGet)
+	}
+	if o.GetDeletionTimestamp() != nil {
+		return result, err
+	}
+	c, ok := registrationReadyCondition(o.Status.AtNetworkNode.Targets)
+	if !ok || o.GetCondition(nddv1.ConditionKindReady).Equal(c) {
+		return result, err
+	}
+	r.log.Debug("Registration failed on network nodes", "resource", o.GetName(), "message", c.Message)
+	o.SetConditions(c)
+	if uerr := r.kube.Status().Update(ctx, o); uerr != nil {
+		return result, errors.Wrap(uerr, errUpdateRegistrationStatus)
+	}
+	return result, err
+}
+
+// registrationReadyCondition returns the Ready condition of a Registration
+// whose registration failed on network nodes, it is partially available as long
+// as a network node did not fail. False is returned when no network node failed.
+func registrationReadyCondition(targets []srosv1alpha1.RegistrationTargetStatus) (nddv1.Condition, bool) {
+	failed := make([]string, 0)
+	for _, t := range targets {
+		if t.State == srosv1alpha1.RegistrationStateFailed {
+			failed = append(failed, t.Name)
+		}
+	}
+	if len(failed) == 0 {
+		return nddv1.Condition{}, false
+	}
+	msg := fmt.Sprintf("registration failed on %d of %d network nodes: %s", len(failed), len(targets), strings.Join(failed, ", "))
+	if len(failed) == len(targets) {
+		return nddv1.Unavailable().WithMessage(msg), true
+	}
+	return srosv1alpha1.PartiallyAvailable(msg), true
+}
+
+// networkNodeMapFuncRegistration enqueues the Registrations that select a
+// NetworkNode or registered it before when the NetworkNode changes, such that
+// the targets are added or deleted right away instead of on the next poll
+func networkNodeMapFuncRegistration(kube client.Client, l logging.Logger) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		rl := &srosv1alpha1.RegistrationList{}
+		if err := kube.List(context.Background(), rl); err != nil {
+			l.Debug("Cannot list Registrations", "error", err, "networkNode", obj.GetName())
+			return nil
+		}
+		nn, ok := obj.(*ndrv1.NetworkNode)
+		reqs := make([]reconcile.Request, 0, len(rl.Items))
+		for i := range rl.Items {
+			o := &rl.Items[i]
+			if ok {
+				// an invalid selector is reported when the Registration is reconciled
+				selected, err := networkNodeSelected(o, nn)
+				if !selected && err == nil && !hasTarget(o, nn.GetName()) {
+					continue
+				}
+			}
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+		}
+		return reqs
+	}
+}
+
+// networkNodeSelected returns true when the Registration applies to the network
+// node, which is the case when the network node matches the label selector and
+// its software version matches the release constraints of the Registration
+func networkNodeSelected(o *srosv1alpha1.Registration, nn *ndrv1.NetworkNode) (bool, error) {
+	if o.Spec.NetworkNodeSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(o.Spec.NetworkNodeSelector)
+		if err != nil {
+			return false, errors.Wrap(err, errNetworkNodeSelector)
+		}
+		if !selector.Matches(labels.Set(nn.GetLabels())) {
+			return false, nil
+		}
+	}
+	if o.Spec.SwVersion != nil {
+		constraints, err := parseSwVersionConstraints(*o.Spec.SwVersion)
+		if err != nil {
+			return false, errors.Wrap(err, errSwVersionConstraint)
+		}
+		swVersion := getSwVersion(nn)
+		if swVersion == "" {
+			return false, nil
+		}
+		return matchSwVersion(swVersion, constraints), nil
+	}
+	return true, nil
+}
+
+// getSwVersion returns the software version the device driver of the network
+// node discovered or an empty string when it is unknown
+func getSwVersion(nn *ndrv1.NetworkNode) string {
+	if nn.Status.DeviceDetails == nil || nn.Status.DeviceDetails.SwVersion == nil {
+		return ""
+	}
+	return *nn.Status.DeviceDetails.SwVersion
+}
+
+// hasTarget returns true when the network node is a target of the Registration
+func hasTarget(o *srosv1alpha1.Registration, name string) bool {
+	for _, t := range o.Status.Target {
+		if t == name {
+			return true
+		}
+	}
+	for _, t := range o.Status.AtNetworkNode.Targets {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// networkNodeChangedPredicate accepts the creation and deletion of a NetworkNode
+// and the updates that change its spec, its labels, its software version or
+// whether its device driver is configured, other status updates are ignored
+func networkNodeChangedPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e cevent.UpdateEvent) bool {
+			oldNn, ok := e.ObjectOld.(*ndrv1.NetworkNode)
+			if !ok {
+				return false
+			}
+			newNn, ok := e.ObjectNew.(*ndrv1.NetworkNode)
+			if !ok {
+				return false
+			}
+			if oldNn.GetGeneration() != newNn.GetGeneration() ||
+				!reflect.DeepEqual(oldNn.GetLabels(), newNn.GetLabels()) ||
+				getSwVersion(oldNn) != getSwVersion(newNn) {
+				return true
+			}
+			return oldNn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status !=
+				newNn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status
+		},
+	}
 }
 
 type validatorRegistration struct {
@@ -124,6 +310,8 @@ type connectorRegistration struct {
 	log         logging.Logger
 	subChan     chan collector.TargetUpdate
 	kube        client.Client
+	namespace   string
+	pool        *clientpool.Pool
 	usage       resource.Tracker
 	newClientFn func(c *types.TargetConfig) *target.Target
 }
@@ -131,8 +319,8 @@ type connectorRegistration struct {
 // Connect produces an ExternalClient by:
 // 1. Tracking that the managed resource is using a NetworkNode Reference.
 // 2. Getting the managed resource's NetworkNode with connection details
-// For registration we did a trick to use aall network nodes in the system since
-// we want to register to all nodes, this is an exception for registration
+// For registration we did a trick to use all network nodes in the system the
+// Registration selects, this is an exception for registration
 func (c *connectorRegistration) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
 	log := c.log.WithValues("resosurce", mg.GetName())
 	log.Debug("Connect")
@@ -152,24 +340,35 @@ func (c *connectorRegistration) Connect(ctx context.Context, mg resource.Managed
 
 	// find all targets that have are in configured status
 	var ts []*nddv1.Target
+	// network nodes whose device driver is not configured
+	down := make(map[string]struct{})
+	// network nodes whose device driver cannot be used, they are reported in
+	// the status and do not stop the registration on the other network nodes
+	failed := make(map[string]error)
 	for _, nn := range nnl.Items {
+		selected, err := networkNodeSelected(o, &nn)
+		if err != nil {
+			return nil, err
+		}
+		if !selected {
+			continue
+		}
 		log.Debug("Network Node", "Name", nn.GetName(), "Status", nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status)
+		if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
+			down[nn.GetName()] = struct{}{}
+		}
 		if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status == corev1.ConditionTrue {
+			cfg, err := getTargetConfig(ctx, c.kube, &nn, c.namespace)
+			if err != nil {
+				log.Debug("Cannot get target config", "target", nn.GetName(), "error", err)
+				failed[nn.GetName()] = err
+				// a subscription of an earlier config is stopped
+				down[nn.GetName()] = struct{}{}
+				continue
+			}
 			t := &nddv1.Target{
-				Name: nn.GetName(),
-				Config: &types.TargetConfig{
-					Name:       nn.GetName(),
-					Address:    ndrv1.PrefixService + "-" + nn.Name + "." + ndrv1.NamespaceLocalK8sDNS + strconv.Itoa(*nn.Spec.GrpcServerPort),
-					Username:   utils.StringPtr("admin"),
-					Password:   utils.StringPtr("admin"),
-					Timeout:    10 * time.Second,
-					SkipVerify: utils.BoolPtr(true),
-					Insecure:   utils.BoolPtr(true),
-					TLSCA:      utils.StringPtr(""), //TODO TLS
-					TLSCert:    utils.StringPtr(""), //TODO TLS
-					TLSKey:     utils.StringPtr(""),
-					Gzip:       utils.BoolPtr(false),
-				},
+				Name:   nn.GetName(),
+				Config: cfg,
 			}
 			ts = append(ts, t)
 		}
@@ -192,52 +391,56 @@ func (c *connectorRegistration) Connect(ctx context.Context, mg resource.Managed
 				Name:   origTarget,
 				Action: collector.TargetDelete,
 			})
+			delete(down, origTarget)
 		}
 	}
+	// the targets of network nodes that went down are deleted as well, the
+	// status can miss them when a previous connect failed
+	for name := range down {
+		deletedTargets = append(deletedTargets, collector.TargetUpdate{
+			Name:   name,
+			Action: collector.TargetDelete,
+		})
+	}
 	// udate all targets
 	allTargets := make([]collector.TargetUpdate, 0)
 	for _, allTarget := range ts {
 		allTargets = append(allTargets, collector.TargetUpdate{
-			Name:   allTarget.Name,
-			Action: collector.TargetAdd,
-			TargetConfig: &types.TargetConfig{
-				Name:       allTarget.Name,
-				Address:    allTarget.Config.Address,
-				Username:   utils.StringPtr("admin"),
-				Password:   utils.StringPtr("admin"),
-				Timeout:    10 * time.Second,
-				SkipVerify: utils.BoolPtr(true),
-				Insecure:   utils.BoolPtr(true),
-				TLSCA:      utils.StringPtr(""), //TODO TLS
-				TLSCert:    utils.StringPtr(""), //TODO TLS
-				TLSKey:     utils.StringPtr(""),
-				Gzip:       utils.BoolPtr(false),
-			},
+			Name:         allTarget.Name,
+			Action:       collector.TargetAdd,
+			TargetConfig: allTarget.Config,
 		})
 	}
 
 	for _, sub := range deletedTargets {
 		log.Debug("Stop Subscription", "target", sub.Name)
-		c.subChan <- sub
+		if err := sendTargetUpdate(ctx, c.subChan, sub); err != nil {
+			return nil, err
+		}
 	}
 	for _, sub := range allTargets {
 		log.Debug("Start Subscription", "target", sub.Name)
-		c.subChan <- sub
+		if err := sendTargetUpdate(ctx, c.subChan, sub); err != nil {
+			return nil, err
+		}
 	}
 
 	// when no targets are found we return a not found error
 	// this unifies the reconcile code when a dedicate network node is looked up
-	if len(ts) == 0 {
+	if len(ts) == 0 && len(failed) == 0 {
 		return nil, errors.New(errNoTargetFound)
 	}
 
-	//get clients for each target
+	//get clients for each target, the network nodes whose device driver cannot
+	// be reached are handled like the network nodes without target config
 	cls := make([]*target.Target, 0)
 	tns := make([]string, 0)
 	for _, t := range ts {
-		cl := target.NewTarget(t.Config)
-		if err := cl.CreateGNMIClient(ctx); err != nil {
-			return nil, errors.Wrap(err, errNewClient)
+		cl, err := c.pool.Get(ctx, t.Config)
+		if err != nil {
+			log.Debug("Cannot create client", "target", t.Name, "error", err)
+			failed[t.Name] = errors.Wrap(err, errNewClient)
+			continue
 		}
 		cls = append(cls, cl)
 		tns = append(tns, t.Name)
@@ -265,9 +468,19 @@ func (c *connectorRegistration) Connect(ctx context.Context, mg resource.Managed
 		}
 	*/
 
+	if len(cls) == 0 {
+		names := make([]string, 0, len(failed))
+		for name, err := range failed {
+			setTargetFailed(o, name, err)
+			names = append(names, name)
+		}
+		pruneTargetStatus(o, names...)
+		return nil, errors.Wrap(targetErrors(failed), errNewClient)
+	}
+
 	log.Debug("Connect info", "clients", cls, "targets", tns)
 
-	return &externalRegistration{clients: cls, targets: tns, log: log, parser: *parser.NewParser(parser.WithLogger(log))}, nil
+	return &externalRegistration{clients: cls, targets: tns, failed: failed, log: log, parser: *parser.NewParser(parser.WithLogger(log))}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -276,8 +489,14 @@ type externalRegistration struct {
 	//clients []register.RegistrationClient
 	clients []*target.Target
 	targets []string
-	parser  parser.Parser
-	log     logging.Logger
+	// network nodes whose device driver could not be connected to
+	failed map[string]error
+	// indexes of the clients whose registration is not up to date, set by Observe
+	outdated []int
+	// registered is set by Observe when a client has a registration of the device type
+	registered bool
+	parser     parser.Parser
+	log        logging.Logger
 }
 
 func (e *externalRegistration) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -304,60 +523,127 @@ func (e *externalRegistration) Observe(ctx context.Context, mg resource.Managed)
 		Encoding: gnmi.Encoding_JSON,
 	}
 
-	for _, cl := range e.clients {
+	// the network device drivers whose registration is missing or differs from
+	// the spec, they are updated by Update
+	e.outdated = make([]int, 0)
+	e.registered = false
+	// a network device driver that fails is reported in the status and retried
+	// on the next poll, the other network device drivers are still observed
+	failed := make(map[string]error)
+	for name, err := range e.failed {
+		failed[name] = err
+	}
+	for i, cl := range e.clients {
 		rsp, err := cl.Get(ctx, req)
 		if err != nil {
-			// if a single network device driver reports an error this is applicable to all
-			// network devices
-			return managed.ExternalObservation{}, errors.Wrap(err, errRegistrationGet)
-		}
-		// if a network device driver reports a different device type we trigger
-		// a recreation of the configuration on all devices by returning
-		// Exists = false and
-		log.Debug("Observing response", "Response", rsp)
-		if deviceType, ok := rsp.GetNotification()[0].GetUpdate()[0].GetPath().GetElem()[0].GetKey()[nddv1.RegisterPathElemKey]; ok {
-			log.Debug("Observing response", "Data", deviceType)
-			if nddv1.DeviceType(deviceType) != srosv1alpha1.DeviceType {
-				return managed.ExternalObservation{
-					ResourceExists:   false,
-					ResourceUpToDate: false,
-					ResourceHasData:  false,
-				}, nil
-			}
+			log.Debug("Observing failed", "Target", e.targets[i], "error", err)
+			failed[e.targets[i]] = errors.Wrap(err, errRegistrationGet)
+			continue
+		}
+		log.Debug("Observing response", "Target", e.targets[i], "Response", rsp)
+		registered, err := e.getRegistration(rsp)
+		if err != nil {
+			log.Debug("Observing failed", "Target", e.targets[i], "error", err)
+			failed[e.targets[i]] = errors.Wrap(err, errRegistrationGet)
+			continue
+		}
+		if registered != nil {
+			e.registered = true
 		}
+		if registered == nil || !equalParameters(*registered, o.Spec.ForNetworkNode) {
+			log.Debug("Observing response, registration is not up to date", "Target", e.targets[i])
+			e.outdated = append(e.outdated, i)
+			setTargetState(o, e.targets[i], srosv1alpha1.RegistrationStateOutdated, registered)
+			continue
+		}
+		setTargetState(o, e.targets[i], srosv1alpha1.RegistrationStateRegistered, registered)
+	}
+	for name, err := range failed {
+		setTargetFailed(o, name, err)
+	}
+	names := append(make([]string, 0, len(e.targets)+len(e.failed)), e.targets...)
+	for name := range e.failed {
+		names = append(names, name)
+	}
+	pruneTargetStatus(o, names...)
 
+	// when every network device driver fails there is nothing to reconcile
+	observed := len(e.clients) + len(e.failed) - len(failed)
+	if observed == 0 {
+		return managed.ExternalObservation{}, targetErrors(failed)
 	}
-	/*
-		for _, cl := range e.clients {
-			r, err := cl.Get(ctx, &register.DeviceType{
-				DeviceType: string(srosv1alpha1.DeviceType),
-			})
-			if err != nil {
-				// if a single network device driver reports an error this is applicable to all
-				// network devices
-				return managed.ExternalObservation{}, errors.New(errRegistrationGet)
-			}
-			// if a network device driver reports a different device type we trigger
-			// a recreation of the configuration on all devices by returning
-			// Exists = false and
-			if r.DeviceType != string(srosv1alpha1.DeviceType) {
-				return managed.ExternalObservation{
-					ResourceExists:   false,
-					ResourceUpToDate: false,
-					ResourceHasData:  false,
-				}, nil
-			}
-		}
-	*/
 
-	// when all network device driver reports the proper device type
-	// we return exists and up to date
+	// when no network device driver has the registration we trigger the
+	// creation of the registration on all devices that did not fail
+	if len(e.outdated) == observed && !e.registered {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+			ResourceHasData:  false,
+		}, nil
+	}
+
+	// when all network device drivers that did not fail report the registration
+	// of the spec we return exists and up to date
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceUpToDate: len(e.outdated) == 0,
 		ResourceHasData:  true, // we fake that we have data since it is not relevant
 	}, nil
+}
 
+// getRegistration returns the registration of the device type a network device
+// driver reports, nil is returned when the device driver has no registration of
+// the device type
+func (e *externalRegistration) getRegistration(rsp *gnmi.GetResponse) (*srosv1alpha1.RegistrationParameters, error) {
+	if len(rsp.GetNotification()) == 0 || len(rsp.GetNotification()[0].GetUpdate()) == 0 {
+		return nil, nil
+	}
+	u := rsp.GetNotification()[0].GetUpdate()[0]
+	if len(u.GetPath().GetElem()) != 0 {
+		if deviceType, ok := u.GetPath().GetElem()[0].GetKey()[nddv1.RegisterPathElemKey]; ok &&
+			nddv1.DeviceType(deviceType) != srosv1alpha1.DeviceType {
+			return nil, nil
+		}
+	}
+	// a registration of the device type exists
+	registered := &srosv1alpha1.RegistrationParameters{}
+	x, err := e.parser.GetValue(u.GetVal())
+	if err != nil {
+		return nil, err
+	}
+	if x == nil {
+		return registered, nil
+	}
+	d, err := json.Marshal(x)
+	if err != nil {
+		return nil, errors.Wrap(err, errJSONMarshal)
+	}
+	if err := json.Unmarshal(d, registered); err != nil {
+		return nil, errors.Wrap(err, errJSONUnMarshal)
+	}
+	return registered, nil
+}
+
+// equalParameters returns true when both registrations hold the same paths
+func equalParameters(a, b srosv1alpha1.RegistrationParameters) bool {
+	return equalPaths(a.Subscriptions, b.Subscriptions) &&
+		equalPaths(a.ExceptionPaths, b.ExceptionPaths) &&
+		equalPaths(a.ExplicitExceptionPaths, b.ExplicitExceptionPaths)
+}
+
+// equalPaths returns true when both lists hold the same paths in the same
+// order, a nil and an empty list are equal
+func equalPaths(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func (e *externalRegistration) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -385,11 +671,10 @@ func (e *externalRegistration) Create(ctx context.Context, mg resource.Managed)
 			},
 		},
 	}
-	for _, cl := range e.clients {
-		_, err := cl.Set(ctx, req)
-		if err != nil {
-			return managed.ExternalCreation{}, errors.New(errRegistrationCreate)
-		}
+	// the registration is created on the network device drivers that did not
+	// fail during observe, which all miss the registration
+	if err := e.setRegistration(ctx, o, req); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRegistrationCreate)
 	}
 
 	/*
@@ -427,19 +712,19 @@ func (e *externalRegistration) Update(ctx context.Context, mg resource.Managed,
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errJSONMarshal)
 	}
+	// the registration is replaced such that removed paths are removed from the
+	// network device driver as well
 	req := &gnmi.SetRequest{
-		Update: []*gnmi.Update{
+		Replace: []*gnmi.Update{
 			{
 				Path: path,
 				Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: d}},
 			},
 		},
 	}
-	for _, cl := range e.clients {
-		_, err := cl.Set(ctx, req)
-		if err != nil {
-			return managed.ExternalUpdate{}, errors.New(errRegistrationUpdate)
-		}
+	// only the network device drivers whose registration differs are updated
+	if err := e.setRegistration(ctx, o, req); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRegistrationUpdate)
 	}
 
 	/*
@@ -477,12 +762,19 @@ func (e *externalRegistration) Delete(ctx context.Context, mg resource.Managed)
 	req := &gnmi.SetRequest{
 		Delete: paths,
 	}
-	for _, cl := range e.clients {
-		_, err := cl.Set(ctx, req)
-		if err != nil {
-			return errors.New(errRegistrationDelete)
+	// the registration is deleted from every network device driver that can be
+	// reached, a network device driver that fails does not stop the deletion on
+	// the others nor the deletion of the Registration. Its registration is
+	// left behind and replaced when a new Registration is created.
+	for i, cl := range e.clients {
+		if _, err := cl.Set(ctx, req); err != nil {
+			log.Debug("Deleting registration failed", "Target", e.targets[i], "error", err)
+			setTargetFailed(o, e.targets[i], errors.Wrap(err, errRegistrationDelete))
 		}
 	}
+	for name, err := range e.failed {
+		log.Debug("Deleting registration skipped", "Target", name, "error", err)
+	}
 
 	/*
 		for _, cl := range e.clients {
@@ -497,6 +789,113 @@ func (e *externalRegistration) Delete(ctx context.Context, mg resource.Managed)
 	return nil
 }
 
+// setRegistration sends the set request to the network device drivers whose
+// registration is outdated and records the result in the status. Every device
+// driver is tried, the errors of the device drivers that failed are returned
+// together.
+func (e *externalRegistration) setRegistration(ctx context.Context, o *srosv1alpha1.Registration, req *gnmi.SetRequest) error {
+	failed := make(map[string]error)
+	for _, i := range e.outdated {
+		e.log.Debug("Setting registration", "Target", e.targets[i])
+		if _, err := e.clients[i].Set(ctx, req); err != nil {
+			e.log.Debug("Setting registration failed", "Target", e.targets[i], "error", err)
+			failed[e.targets[i]] = err
+			setTargetFailed(o, e.targets[i], err)
+			continue
+		}
+		setTargetState(o, e.targets[i], srosv1alpha1.RegistrationStateRegistered, &o.Spec.ForNetworkNode)
+	}
+	return targetErrors(failed)
+}
+
+// targetErrors returns an error that holds the error of every network node, nil
+// is returned when there are no errors
+func targetErrors(errs map[string]error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(errs))
+	for name, err := range errs {
+		msgs = append(msgs, name+": "+err.Error())
+	}
+	sort.Strings(msgs)
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+// setTargetState sets the registration state and the registered paths of a
+// network node in the status of the Registration
+func setTargetState(o *srosv1alpha1.Registration, name string, state srosv1alpha1.RegistrationState, registered *srosv1alpha1.RegistrationParameters) {
+	s := srosv1alpha1.RegistrationTargetStatus{
+		Name:  name,
+		State: state,
+	}
+	if registered != nil {
+		registered.DeepCopyInto(&s.Registered)
+	}
+	setTargetStatus(o, s)
+}
+
+// setTargetFailed sets the error of a network node in the status of the
+// Registration, the registered paths are kept since they are unknown
+func setTargetFailed(o *srosv1alpha1.Registration, name string, err error) {
+	s := srosv1alpha1.RegistrationTargetStatus{
+		Name:      name,
+		State:     srosv1alpha1.RegistrationStateFailed,
+		LastError: err.Error(),
+	}
+	for _, cur := range o.Status.AtNetworkNode.Targets {
+		if cur.Name == name {
+			cur.Registered.DeepCopyInto(&s.Registered)
+		}
+	}
+	setTargetStatus(o, s)
+}
+
+// setTargetStatus sets the status of a network node in the Registration, the
+// update time only changes when the state, the error or the registered paths
+// change
+func setTargetStatus(o *srosv1alpha1.Registration, s srosv1alpha1.RegistrationTargetStatus) {
+	s.LastUpdateTime = metav1.Now()
+	for i, cur := range o.Status.AtNetworkNode.Targets {
+		if cur.Name != s.Name {
+			continue
+		}
+		if cur.State == s.State && cur.LastError == s.LastError && equalParameters(cur.Registered, s.Registered) {
+			return
+		}
+		o.Status.AtNetworkNode.Targets[i] = s
+		return
+	}
+	o.Status.AtNetworkNode.Targets = append(o.Status.AtNetworkNode.Targets, s)
+}
+
+// pruneTargetStatus removes the status of the network nodes the Registration
+// no longer applies to, which are the network nodes that are not in names
+func pruneTargetStatus(o *srosv1alpha1.Registration, names ...string) {
+	targets := make([]srosv1alpha1.RegistrationTargetStatus, 0, len(o.Status.AtNetworkNode.Targets))
+	for _, t := range o.Status.AtNetworkNode.Targets {
+		for _, name := range names {
+			if t.Name == name {
+				targets = append(targets, t)
+				break
+			}
+		}
+	}
+	o.Status.AtNetworkNode.Targets = targets
+}
+
+// sendTargetUpdate sends the target update to the deviation server, it gives
+// up when the context is done, which is the case when the manager stops while
+// the deviation server no longer receives updates
+func sendTargetUpdate(ctx context.Context, ch chan collector.TargetUpdate, tu collector.TargetUpdate) error {
+	select {
+	case ch <- tu:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), errSendTargetUpdate)
+	}
+}
+
 func (e *externalRegistration) GetTarget() []string {
 	return e.targets
 }
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"testing"
+
+	"github.com/karimra/gnmic/target"
+	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
+	"github.com/yndd/ndd-provider-sros/internal/collector"
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+func testRegistration() *srosv1alpha1.Registration {
+	o := &srosv1alpha1.Registration{ObjectMeta: metav1.ObjectMeta{Name: "sros-registrations"}}
+	o.Spec.ForNetworkNode.Subscriptions = []string{"/configure/port"}
+	return o
+}
+
+// targetStatus returns the status of the network node in the Registration
+func targetStatus(o *srosv1alpha1.Registration, name string) *srosv1alpha1.RegistrationTargetStatus {
+	for i, t := range o.Status.AtNetworkNode.Targets {
+		if t.Name == name {
+			return &o.Status.AtNetworkNode.Targets[i]
+		}
+	}
+	return nil
+}
+
+// TestConnectRegistrationMissingCredentials connects to two network nodes of
+// which one has no credentials, the registration continues on the other
+// network node and the failing one is reported in the status
+func TestConnectRegistrationMissingCredentials(t *testing.T) {
+	ctx := context.Background()
+	s := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, ndrv1.AddToScheme, srosv1alpha1.AddToScheme} {
+		if err := add(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sr1 := testNetworkNode("sr1", "creds", "")
+	sr2 := testNetworkNode("sr2", "", "")
+	for _, nn := range []*ndrv1.NetworkNode{sr1, sr2} {
+		nn.Spec.Target.Insecure = utils.BoolPtr(true)
+		nn.SetConditions(ndrv1.Configured())
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		sr1, sr2,
+		testSecret("creds", map[string]string{credentialsUsername: "admin", credentialsPassword: "admin"}),
+	).Build()
+
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	subChan := make(chan collector.TargetUpdate, 8)
+	c := &connectorRegistration{
+		log:     logging.NewNopLogger(),
+		subChan: subChan,
+		kube:    kube,
+		pool:    clientpool.New(clientpool.WithDialOptions(dd.Dialer())),
+		usage:   resource.TrackerFn(func(context.Context, resource.Managed) error { return nil }),
+	}
+	o := testRegistration()
+	ext, err := c.Connect(ctx, o)
+	if err != nil {
+		t.Fatalf("Connect(): %v", err)
+	}
+	if got := ext.GetTarget(); len(got) != 1 || got[0] != "sr1" {
+		t.Errorf("Connect(): targets %v, want [sr1]", got)
+	}
+
+	updates := map[string]collector.TargetAction{}
+	for len(subChan) > 0 {
+		tu := <-subChan
+		updates[tu.Name] = tu.Action
+	}
+	if updates["sr1"] != collector.TargetAdd || updates["sr2"] != collector.TargetDelete {
+		t.Errorf("Connect(): target updates %v, want sr1 added and sr2 deleted", updates)
+	}
+
+	if _, err := ext.Observe(ctx, o); err != nil {
+		t.Fatalf("Observe(): %v", err)
+	}
+	if s := targetStatus(o, "sr2"); s == nil || s.State != srosv1alpha1.RegistrationStateFailed || s.LastError == "" {
+		t.Errorf("Observe(): status of sr2 %v, want failed with error", s)
+	}
+	if s := targetStatus(o, "sr1"); s == nil || s.State == srosv1alpha1.RegistrationStateFailed {
+		t.Errorf("Observe(): status of sr1 %v, want not failed", s)
+	}
+}
+
+// TestDeleteRegistrationFailingTarget deletes the registration while one
+// network device driver fails, the registration is deleted on the other
+func TestDeleteRegistrationFailingTarget(t *testing.T) {
+	ctx := context.Background()
+	dd1 := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	dd2 := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	e := &externalRegistration{
+		clients:  []*target.Target{newTestClient(t, dd1), newTestClient(t, dd2)},
+		targets:  []string{"sr1", "sr2"},
+		failed:   map[string]error{"sr3": errors.New("unreachable")},
+		outdated: []int{0, 1},
+		log:      logging.NewNopLogger(),
+		parser:   *parser.NewParser(),
+	}
+	o := testRegistration()
+	if _, err := e.Create(ctx, o); err != nil {
+		t.Fatalf("Create(): %v", err)
+	}
+	if len(dd1.Paths()) == 0 || len(dd2.Paths()) == 0 {
+		t.Fatal("Create(): the registration is not set on both device drivers")
+	}
+
+	dd2.FailSets(errors.New("unavailable"))
+	if err := e.Delete(ctx, o); err != nil {
+		t.Fatalf("Delete(): a failing network node must not fail the delete: %v", err)
+	}
+	if got := dd1.Paths(); len(got) != 0 {
+		t.Errorf("Delete(): the registration of sr1 is not deleted: %v", got)
+	}
+	if s := targetStatus(o, "sr2"); s == nil || s.State != srosv1alpha1.RegistrationStateFailed {
+		t.Errorf("Delete(): status of sr2 %v, want failed", s)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"strings"
+
+	"github.com/karimra/gnmic/utils"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+const (
+	// path on which the validating webhook is served
+	validatePathRegistration = "/validate-sros-ndd-yndd-io-v1alpha1-registration"
+)
+
+// +kubebuilder:webhook:path=/validate-sros-ndd-yndd-io-v1alpha1-registration,mutating=false,failurePolicy=fail,sideEffects=None,groups=sros.ndd.yndd.io,resources=registrations,verbs=create;update,versions=v1alpha1,name=vregistration.sros.ndd.yndd.io,admissionReviewVersions=v1
+
+// SetupRegistrationWebhook registers the validating webhook of Registrations
+// with the webhook server of the manager.
+func SetupRegistrationWebhook(mgr ctrl.Manager, l logging.Logger) error {
+	mgr.GetWebhookServer().Register(validatePathRegistration, &webhook.Admission{
+		Handler: &validatingWebhookRegistration{
+			log: l.WithValues("webhook", validatePathRegistration),
+		},
+	})
+	return nil
+}
+
+// validatingWebhookRegistration rejects Registrations with paths the device
+// driver cannot subscribe to or with an invalid network node selection
+type validatingWebhookRegistration struct {
+	log     logging.Logger
+	decoder *admission.Decoder
+}
+
+// InjectDecoder injects the decoder of the webhook server.
+func (w *validatingWebhookRegistration) InjectDecoder(d *admission.Decoder) error {
+	w.decoder = d
+	return nil
+}
+
+// Handle validates that the subscriptions and exception paths of a
+// Registration are valid xpaths and that its network node selector and release
+// constraints can be parsed.
+func (w *validatingWebhookRegistration) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := w.log.WithValues("resource", req.Name, "operation", req.Operation)
+	log.Debug("Validate...")
+
+	o := &srosv1alpha1.Registration{}
+	if resp := decodeRequest(w.decoder, req, o, &srosv1alpha1.Registration{}); resp != nil {
+		return *resp
+	}
+
+	errs := field.ErrorList{}
+	errs = append(errs, validateXPaths(specPath.Child("subscriptions"), o.Spec.ForNetworkNode.Subscriptions)...)
+	errs = append(errs, validateXPaths(specPath.Child("exceptionPaths"), o.Spec.ForNetworkNode.ExceptionPaths)...)
+	errs = append(errs, validateXPaths(specPath.Child("explicitExceptionPaths"), o.Spec.ForNetworkNode.ExplicitExceptionPaths)...)
+	if o.Spec.NetworkNodeSelector != nil {
+		errs = append(errs, metav1validation.ValidateLabelSelector(o.Spec.NetworkNodeSelector, field.NewPath("spec", "networkNodeSelector"))...)
+	}
+	if o.Spec.SwVersion != nil {
+		if _, err := parseSwVersionConstraints(*o.Spec.SwVersion); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "swVersion"), *o.Spec.SwVersion, err.Error()))
+		}
+	}
+
+	if len(errs) != 0 {
+		log.Debug("Validate failed", "errors", errs.ToAggregate().Error())
+		return denied(srosv1alpha1.RegistrationKind, req.Name, errs)
+	}
+	return admission.Allowed("")
+}
+
+// validateXPaths returns a field error for every path that is not an absolute
+// xpath
+func validateXPaths(fp *field.Path, xpaths []string) field.ErrorList {
+	errs := field.ErrorList{}
+	for i, xpath := range xpaths {
+		if !strings.HasPrefix(xpath, "/") {
+			errs = append(errs, field.Invalid(fp.Index(i), xpath, "xpath must start with /"))
+			continue
+		}
+		if _, err := utils.ParsePath(xpath); err != nil {
+			errs = append(errs, field.Invalid(fp.Index(i), xpath, err.Error()))
+		}
+	}
+	return errs
+}
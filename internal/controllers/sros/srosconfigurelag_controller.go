@@ -0,0 +1,1171 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	gnmitypes "github.com/karimra/gnmic/types"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/event"
+	"github.com/yndd/ndd-runtime/pkg/gext"
+	"github.com/yndd/ndd-runtime/pkg/gvk"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	cevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
+)
+
+const (
+	// Errors
+	errUnexpectedConfigureLag       = "the managed resource is not a ConfigureLag resource"
+	errKubeUpdateFailedConfigureLag = "cannot update ConfigureLag"
+	errReadConfigureLag             = "cannot read ConfigureLag"
+	errCreateConfigureLag           = "cannot create ConfigureLag"
+	erreUpdateConfigureLag          = "cannot update ConfigureLag"
+	errDeleteConfigureLag           = "cannot delete ConfigureLag"
+	errLagNameMissing               = "lag-name is mandatory for a ConfigureLag"
+	errValidateParentConfigureLag   = "cannot validate the member ports of ConfigureLag"
+
+	// resource information
+	levelConfigureLag = 2
+
+	// the mode and encap-type of a lag or port when they are not configured
+	defaultModeConfigureLag      = "network"
+	defaultEncapTypeConfigureLag = "null"
+)
+
+var resourceRefPathsConfigureLag = []*gnmi.Path{
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "lag", Key: map[string]string{"lag-name": ""}},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "lag", Key: map[string]string{"lag-name": ""}},
+			{Name: "bfd-liveness"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "lag", Key: map[string]string{"lag-name": ""}},
+			{Name: "bfd-liveness"},
+			{Name: "ipv4"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "lag", Key: map[string]string{"lag-name": ""}},
+			{Name: "bfd-liveness"},
+			{Name: "ipv6"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "lag", Key: map[string]string{"lag-name": ""}},
+			{Name: "lacp"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "lag", Key: map[string]string{"lag-name": ""}},
+			{Name: "port", Key: map[string]string{"port-id": ""}},
+		},
+	},
+}
+
+// dependencyConfigureLag contains the parents a lag can depend on, the keys of
+// the remote paths are populated with the port-id of the member port
+var dependencyConfigureLag = map[string]*parser.LeafRefGnmi{
+	"port": {
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "port", Key: map[string]string{"port-id": ""}},
+			},
+		},
+	},
+}
+
+var localleafRefConfigureLag = []*parser.LeafRefGnmi{}
+var externalLeafRefConfigureLag = []*parser.LeafRefGnmi{
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "lag"},
+				{Name: "apply-groups"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "lag"},
+				{Name: "apply-groups-exclude"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+}
+
+// constraintsConfigureLag contains the range, length, pattern and enum
+// constraints of the leafs of the lag, indexed by schema path
+var constraintsConfigureLag = map[string]leafConstraint{
+	"/lag/bfd-liveness/ipv4/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 20}}},
+	},
+	"/lag/bfd-liveness/ipv4/receive-interval": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 100000}}},
+	},
+	"/lag/bfd-liveness/ipv4/transmit-interval": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 100000}}},
+	},
+	"/lag/bfd-liveness/ipv6/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 20}}},
+	},
+	"/lag/bfd-liveness/ipv6/receive-interval": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 100000}}},
+	},
+	"/lag/bfd-liveness/ipv6/transmit-interval": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 100000}}},
+	},
+	"/lag/encap-type": {
+		{kind: leafKindEnum, enums: []string{"dot1q", "null", "qinq"}},
+	},
+	"/lag/lacp/administrative-key": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 65535}}},
+	},
+	"/lag/lacp/mode": {
+		{kind: leafKindEnum, enums: []string{"active", "passive"}},
+	},
+	"/lag/lacp/system-id": {
+		{kind: leafKindString, patterns: []string{`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`}},
+	},
+	"/lag/lacp/system-priority": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 65535}}},
+	},
+	"/lag/lacp-xmit-interval": {
+		{kind: leafKindEnum, enums: []string{"fast", "slow"}},
+	},
+	"/lag/max-ports": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 64}}},
+	},
+	"/lag/mode": {
+		{kind: leafKindEnum, enums: []string{"access", "hybrid", "network"}},
+	},
+	"/lag/port/hash-weight": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100000}}},
+		{kind: leafKindEnum, enums: []string{"port-speed"}},
+	},
+	"/lag/port/priority": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 65535}}},
+	},
+	"/lag/port/sub-group": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
+	},
+	"/lag/standby-signaling": {
+		{kind: leafKindEnum, enums: []string{"lacp", "power-off"}},
+	},
+}
+
+// defaultsConfigureLag contains the yang defaults of the leafs of the lag as
+// json values, indexed by schema path
+var defaultsConfigureLag = map[string]string{
+	"/lag/bfd-liveness/ipv4/multiplier":        `3`,
+	"/lag/bfd-liveness/ipv4/receive-interval":  `100`,
+	"/lag/bfd-liveness/ipv4/transmit-interval": `100`,
+	"/lag/bfd-liveness/ipv6/multiplier":        `3`,
+	"/lag/bfd-liveness/ipv6/receive-interval":  `100`,
+	"/lag/bfd-liveness/ipv6/transmit-interval": `100`,
+	"/lag/lacp-xmit-interval":                  `"fast"`,
+	"/lag/lacp-xmit-stdby":                     `true`,
+	"/lag/port/priority":                       `32768`,
+	"/lag/standby-signaling":                   `"lacp"`,
+}
+
+var defaulterConfigureLag = newDefaulter(defaultsConfigureLag, []string{})
+
+// defaultConfigureLag sets the yang defaults in the json data of a lag, it is
+// used for the spec and the data of the device such that both are normalized
+// the same way before they are compared
+func defaultConfigureLag(x interface{}) interface{} {
+	return defaulterConfigureLag.apply(x)
+}
+
+// validateConfigureLag returns the paths of all leafs in the data that violate
+// the constraints of the yang model
+func validateConfigureLag(p *parser.Parser, x1 interface{}) []constraintViolation {
+	// the lag is a list entry, by adding it to a list the lag-name is reported
+	// in the path of the violations
+	x, err := p.AddJSONDataToList(x1)
+	if err != nil {
+		return []constraintViolation{{detail: err.Error()}}
+	}
+	return validateConstraints(x, constraintsConfigureLag, resourceRefPathsConfigureLag)
+}
+
+// getRootPathConfigureLag returns the root path of the resource, the lag is
+// keyed by its lag-name such that every resource owns exactly one lag
+func getRootPathConfigureLag(o *srosv1alpha1.SrosConfigureLag) ([]*gnmi.Path, error) {
+	lagName := getLagName(o)
+	if lagName == "" {
+		return nil, errors.New(errLagNameMissing)
+	}
+	return []*gnmi.Path{
+		{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "lag", Key: map[string]string{"lag-name": lagName}},
+			},
+		},
+	}, nil
+}
+
+// getLagName returns the lag-name of the ConfigureLag or an empty string when
+// it is not set
+func getLagName(o *srosv1alpha1.SrosConfigureLag) string {
+	l := o.Spec.ForNetworkNode.SrosConfigureLag
+	if l == nil || l.LagName == nil {
+		return ""
+	}
+	return *l.LagName
+}
+
+// getMemberPortIdsConfigureLag returns the port-ids of the member ports of the
+// ConfigureLag
+func getMemberPortIdsConfigureLag(o *srosv1alpha1.SrosConfigureLag) []string {
+	l := o.Spec.ForNetworkNode.SrosConfigureLag
+	if l == nil {
+		return nil
+	}
+	portIds := make([]string, 0, len(l.Port))
+	for _, p := range l.Port {
+		if p != nil && p.PortId != nil {
+			portIds = append(portIds, *p.PortId)
+		}
+	}
+	return portIds
+}
+
+// getModeAndEncapTypeConfigureLag returns the effective mode and encap-type of
+// the ConfigureLag
+func getModeAndEncapTypeConfigureLag(o *srosv1alpha1.SrosConfigureLag) (string, string) {
+	mode, encapType := defaultModeConfigureLag, defaultEncapTypeConfigureLag
+	l := o.Spec.ForNetworkNode.SrosConfigureLag
+	if l == nil {
+		return mode, encapType
+	}
+	if l.Mode != nil {
+		mode = *l.Mode
+	}
+	if l.EncapType != nil {
+		encapType = *l.EncapType
+	}
+	return mode, encapType
+}
+
+// getPortModeAndEncapTypeConfigureLag returns the effective ethernet mode and
+// encap-type of a port in the configuration of the device, false is returned
+// when the port is not in the configuration
+func getPortModeAndEncapTypeConfigureLag(x interface{}, portId string) (string, string, bool) {
+	ports, _ := stateValue(x, "configure", "port").([]interface{})
+	for _, p := range ports {
+		if id := stateString(p, "port-id"); id == nil || *id != portId {
+			continue
+		}
+		mode, encapType := defaultModeConfigureLag, defaultEncapTypeConfigureLag
+		if m := stateString(p, "ethernet", "mode"); m != nil {
+			mode = *m
+		}
+		if e := stateString(p, "ethernet", "encap-type"); e != nil {
+			encapType = *e
+		}
+		return mode, encapType, true
+	}
+	return "", "", false
+}
+
+// SetupConfigureLag adds a controller that reconciles ConfigureLags.
+func SetupConfigureLag(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, namespace string, pool *clientpool.Pool) (string, chan cevent.GenericEvent, error) {
+
+	name := managed.ControllerName(srosv1alpha1.ConfigureLagGroupKind)
+
+	events := make(chan cevent.GenericEvent)
+
+	v := &validatorConfigureLag{log: l, parser: *parser.NewParser(parser.WithLogger(l))}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(srosv1alpha1.ConfigureLagGroupVersionKind),
+		managed.WithExternalConnecter(&connectorConfigureLag{
+			log:         l,
+			kube:        mgr.GetClient(),
+			namespace:   namespace,
+			pool:        pool,
+			usage:       resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
+			validator:   v,
+			states:      newStateCache(defaultStateTTL),
+			newClientFn: target.NewTarget},
+		),
+		managed.WithParser(l),
+		managed.WithValidator(v),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return srosv1alpha1.ConfigureLagGroupKind, events, ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srosv1alpha1.SrosConfigureLag{}).
+		WithEventFilter(resource.IgnoreUpdateWithoutGenerationChangePredicate()).
+		Watches(
+			&source.Channel{Source: events},
+			&handler.EnqueueRequestForObject{},
+		).
+		//Watches(
+		//	&source.Kind{Type: &ndrv1.NetworkNode{}},
+		//	handler.EnqueueRequestsFromMapFunc(r.NetworkNodeMapFunc),
+		//).
+		Complete(r)
+}
+
+type validatorConfigureLag struct {
+	log    logging.Logger
+	parser parser.Parser
+}
+
+// ValidateValues validates the leaf values against the constraints of the
+// yang model, such that invalid values are reported before anything is sent
+// to the device
+func (v *validatorConfigureLag) ValidateValues(ctx context.Context, mg resource.Managed) ([]string, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return nil, errors.New(errUnexpectedConfigureLag)
+	}
+	x1, err := getSpecData(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return nil, err
+	}
+	return constraintViolationMessages(validateConfigureLag(&v.parser, x1)), nil
+}
+
+func (v *validatorConfigureLag) ValidateLocalleafRef(ctx context.Context, mg resource.Managed) (managed.ValidateLocalleafRefObservation, error) {
+	log := v.log.WithValues("resource", mg.GetName())
+	log.Debug("ValidateLocalleafRef...")
+
+	// json unmarshal the resource
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return managed.ValidateLocalleafRefObservation{}, errors.New(errUnexpectedConfigureLag)
+	}
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ValidateLocalleafRefObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+	var x1 interface{}
+	json.Unmarshal(d, &x1)
+
+	// For local leafref validation we dont need to supply the external data so we use nil
+	success, resultleafRefValidation, err := v.parser.ValidateLeafRefGnmi(
+		parser.LeafRefValidationLocal, x1, nil, localleafRefConfigureLag, log)
+	if err != nil {
+		return managed.ValidateLocalleafRefObservation{
+			Success: false,
+		}, nil
+	}
+	if !success {
+		log.Debug("ValidateLocalleafRef failed", "resultleafRefValidation", resultleafRefValidation)
+		return managed.ValidateLocalleafRefObservation{
+			Success:          false,
+			ResolvedLeafRefs: resultleafRefValidation}, nil
+	}
+	log.Debug("ValidateLocalleafRef success", "resultleafRefValidation", resultleafRefValidation)
+	return managed.ValidateLocalleafRefObservation{
+		Success:          true,
+		ResolvedLeafRefs: resultleafRefValidation}, nil
+}
+
+func (v *validatorConfigureLag) ValidateExternalleafRef(ctx context.Context, mg resource.Managed, cfg []byte) (managed.ValidateExternalleafRefObservation, error) {
+	log := v.log.WithValues("resource", mg.GetName())
+	log.Debug("ValidateExternalleafRef...")
+
+	// json unmarshal the resource
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return managed.ValidateExternalleafRefObservation{}, errors.New(errUnexpectedConfigureLag)
+	}
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ValidateExternalleafRefObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+	var x1 interface{}
+	json.Unmarshal(d, &x1)
+
+	// json unmarshal the external data
+	var x2 interface{}
+	json.Unmarshal(cfg, &x2)
+
+	// For local external leafref validation we need to supply the external
+	// data to validate the remote leafref, we use x2 for this
+	success, resultleafRefValidation, err := v.parser.ValidateLeafRefGnmi(
+		parser.LeafRefValidationExternal, x1, x2, externalLeafRefConfigureLag, log)
+	if err != nil {
+		return managed.ValidateExternalleafRefObservation{
+			Success: false,
+		}, nil
+	}
+	if !success {
+		log.Debug("ValidateExternalleafRef failed", "resultleafRefValidation", resultleafRefValidation)
+		return managed.ValidateExternalleafRefObservation{
+			Success:          false,
+			ResolvedLeafRefs: resultleafRefValidation}, nil
+	}
+	log.Debug("ValidateExternalleafRef success", "resultleafRefValidation", resultleafRefValidation)
+	return managed.ValidateExternalleafRefObservation{
+		Success:          true,
+		ResolvedLeafRefs: resultleafRefValidation}, nil
+}
+
+func (v *validatorConfigureLag) ValidateParentDependency(ctx context.Context, mg resource.Managed, cfg []byte) (managed.ValidateParentDependencyObservation, error) {
+	log := v.log.WithValues("resource", mg.GetName())
+	log.Debug("ValidateParentDependency...")
+
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return managed.ValidateParentDependencyObservation{}, errors.New(errUnexpectedConfigureLag)
+	}
+	lagName := getLagName(o)
+	mode, encapType := getModeAndEncapTypeConfigureLag(o)
+
+	// json unmarshal the external data
+	var x2 interface{}
+	if len(cfg) != 0 {
+		if err := json.Unmarshal(cfg, &x2); err != nil {
+			return managed.ValidateParentDependencyObservation{}, errors.Wrap(err, errJSONUnMarshal)
+		}
+	}
+
+	// a member port that does not exist or does not match the lag is reported
+	// in the member validation condition, the lag itself is not deleted from
+	// the device over its members
+	resultleafRefValidation := make([]*parser.ResolvedLeafRefGnmi, 0)
+	msgs := make([]string, 0)
+	for _, portId := range getMemberPortIdsConfigureLag(o) {
+		dep := dependencyConfigureLag["port"]
+		success, result, err := v.parser.ValidateParentDependencyGnmi(x2, portId, []*parser.LeafRefGnmi{dep}, log)
+		if err != nil {
+			return managed.ValidateParentDependencyObservation{
+				Success: false,
+			}, errors.Wrap(err, errValidateParentConfigureLag)
+		}
+		resultleafRefValidation = append(resultleafRefValidation, result...)
+
+		if !success {
+			msgs = append(msgs, fmt.Sprintf("member port %s of lag %s does not exist: %s", portId, lagName,
+				*v.parser.GnmiPathToXPath(result[0].RemotePath, true)))
+			continue
+		}
+		portMode, portEncapType, found := getPortModeAndEncapTypeConfigureLag(x2, portId)
+		switch {
+		case !found:
+			msgs = append(msgs, fmt.Sprintf("member port %s of lag %s is not in the configuration of the device", portId, lagName))
+		case portMode != mode:
+			msgs = append(msgs, fmt.Sprintf("ethernet mode %s of member port %s does not match mode %s of lag %s",
+				portMode, portId, mode, lagName))
+		case portEncapType != encapType:
+			msgs = append(msgs, fmt.Sprintf("ethernet encap-type %s of member port %s does not match encap-type %s of lag %s",
+				portEncapType, portId, encapType, lagName))
+		}
+	}
+	if len(msgs) != 0 {
+		log.Debug("ValidateParentDependency member validation failed", "members", msgs)
+		o.SetConditions(srosv1alpha1.MemberValidationFailure(strings.Join(msgs, "; ")))
+	} else {
+		o.SetConditions(srosv1alpha1.MemberValidationSuccess())
+	}
+	log.Debug("ValidateParentDependency success", "resultParentValidation", resultleafRefValidation)
+	return managed.ValidateParentDependencyObservation{
+		Success:          true,
+		ResolvedLeafRefs: resultleafRefValidation}, nil
+}
+
+// ValidateResourceIndexes validates if the indexes of a resource got changed
+// if so we need to delete the original resource, because it will be dangling if we dont delete it
+func (v *validatorConfigureLag) ValidateResourceIndexes(ctx context.Context, mg resource.Managed) (managed.ValidateResourceIndexesObservation, error) {
+	log := v.log.WithValues("resosurce", mg.GetName())
+
+	// json unmarshal the resource
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return managed.ValidateResourceIndexesObservation{}, errors.New(errUnexpectedConfigureLag)
+	}
+	log.Debug("ValidateResourceIndexes", "Spec", o.Spec)
+
+	rootPath, err := getRootPathConfigureLag(o)
+	if err != nil {
+		return managed.ValidateResourceIndexesObservation{}, err
+	}
+
+	origResourceIndex := mg.GetResourceIndexes()
+	// we call the CompareConfigPathsWithResourceKeys irrespective is the get resource index returns nil
+	changed, deletPaths, newResourceIndex := v.parser.CompareGnmiPathsWithResourceKeys(rootPath[0], origResourceIndex)
+	if changed {
+		log.Debug("ValidateResourceIndexes changed", "deletPaths", deletPaths[0])
+		return managed.ValidateResourceIndexesObservation{Changed: true, ResourceDeletes: deletPaths, ResourceIndexes: newResourceIndex}, nil
+	}
+
+	log.Debug("ValidateResourceIndexes success")
+	return managed.ValidateResourceIndexesObservation{Changed: false, ResourceIndexes: newResourceIndex}, nil
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connectorConfigureLag struct {
+	log         logging.Logger
+	kube        client.Client
+	namespace   string
+	pool        *clientpool.Pool
+	usage       resource.Tracker
+	validator   valueValidator
+	states      *stateCache
+	newClientFn func(c *gnmitypes.TargetConfig) *target.Target
+	//newClientFn func(ctx context.Context, cfg ndd.Config) (config.ConfigurationClient, error)
+}
+
+// Connect produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a NetworkNode.
+// 2. Getting the managed resource's NetworkNode with connection details
+// A resource is mapped to a single target
+func (c *connectorConfigureLag) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	log := c.log.WithValues("resource", mg.GetName())
+	log.Debug("Connect")
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return nil, errors.New(errUnexpectedConfigureLag)
+	}
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackTCUsage)
+	}
+
+	// find network node that is configured status
+	nn := &ndrv1.NetworkNode{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: o.GetNetworkNodeReference().Name}, nn); err != nil {
+		return nil, errors.Wrap(err, errGetNetworkNode)
+	}
+
+	if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
+		return nil, errors.New(targetNotConfigured)
+	}
+	cfg, err := getResourceTargetConfig(ctx, c.kube, nn, c.namespace, o)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := c.pool.Get(ctx, cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	// we make a string here since we use a trick in registration to go to multiple targets
+	// while here the object is mapped to a single target/network node
+	tns := make([]string, 0)
+	tns = append(tns, nn.GetName())
+
+	return withValueValidation(&externalConfigureLag{client: cl, states: c.states, targets: tns, log: log, parser: *parser.NewParser(parser.WithLogger(log))}, c.validator), nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type externalConfigureLag struct {
+	//client  config.ConfigurationClient
+	client  *target.Target
+	states  *stateCache
+	targets []string
+	log     logging.Logger
+	parser  parser.Parser
+}
+
+func (e *externalConfigureLag) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedConfigureLag)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Observing ...")
+
+	// rootpath of the resource
+	rootPath, err := getRootPathConfigureLag(o)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// gvk: group, version, kind, name, namespace of the resource
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// gext: gni extension information for the resource: action, gvk name and level
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionGet,
+		Name:   gvkstring,
+		Level:  levelConfigureLag,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetGextInfo)
+	}
+
+	// gnmi get request
+	req := &gnmi.GetRequest{
+		Path:     rootPath,
+		Encoding: gnmi.Encoding_JSON,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	// gnmi get response
+	resp, err := e.client.Get(ctx, req)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errReadConfigureLag)
+	}
+
+	// validate if the extension matches or not
+	if resp.GetExtension()[0].GetRegisteredExt().GetId() != gnmi_ext.ExtensionID_EID_EXPERIMENTAL {
+		log.Debug("Observe response GNMI Extension mismatch", "Extension Info", resp.GetExtension()[0])
+		return managed.ExternalObservation{}, errors.New(errGnmiExtensionMismatch)
+	}
+
+	// get gnmi extension metadata
+	meta := resp.GetExtension()[0].GetRegisteredExt().GetMsg()
+	respMeta := &gext.GEXT{}
+	if err := json.Unmarshal(meta, &respMeta); err != nil {
+		log.Debug("Observe response gext unmarshal issue", "Extension Info", meta)
+		return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+
+	// prepare the input data to compare against the response data
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+	var x1 interface{}
+	if err := json.Unmarshal(d, &x1); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	// remove the hierarchical elements for data processing, comparison, etc
+	// they are used in the provider for parent dependency resolution
+	// but are not relevant in the data, they are referenced in the rootPath
+	// when interacting with the device driver
+	hids := make([]string, 0)
+	x1 = e.parser.RemoveLeafsFromJSONData(x1, hids)
+
+	// the device reports leafs with their default value, the spec is defaulted
+	// the same way to avoid a difference for leafs that are not in the spec
+	x1 = defaultConfigureLag(x1)
+
+	// the resource is a list entry keyed by lag-name, for lists with keys we need to
+	// create a list before calulating the paths such that the key ends up in the path
+	x1, err = e.parser.AddJSONDataToList(x1)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errWrongInputdata)
+	}
+
+	// validate gnmi resp information
+	var x2 interface{}
+	if len(resp.GetNotification()) != 0 {
+		if len(resp.GetNotification()[0].GetUpdate()) != 0 {
+			// get value from gnmi get response
+			x2, err = e.parser.GetValue(resp.GetNotification()[0].GetUpdate()[0].Val)
+			if err != nil {
+				log.Debug("Observe response get value issue")
+				return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
+			}
+			x2 = defaultConfigureLag(x2)
+		}
+	}
+
+	// logging information that will be used to provide the response
+	log.Debug("Observer Response", "Meta", string(meta))
+	log.Debug("Spec Data", "X1", x1)
+	log.Debug("Resp Data", "X2", x2)
+
+	// if the cache is not ready we back off and return
+	if !respMeta.CacheReady {
+		log.Debug("Cache Not Ready ...")
+		return managed.ExternalObservation{
+			Ready:            false,
+			ResourceExists:   false,
+			ResourceHasData:  true,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	if !respMeta.Exists {
+		// Resource Does not Exists
+		if respMeta.HasData {
+			// this is an umnaged resource which has data and will be moved to a managed resource
+
+			updatesx1 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigureLag)
+			for _, update := range updatesx1 {
+				log.Debug("Observe Fine Grane Updates X1", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+			// for lists with keys we need to create a list before calulating the paths since this is what
+			// the object eventually happens to be based upon. We avoid having multiple entries in a list object
+			// and hence we have to add this step
+			x2, err = e.parser.AddJSONDataToList(x2)
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errWrongInputdata)
+			}
+			updatesx2 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x2, resourceRefPathsConfigureLag)
+			for _, update := range updatesx2 {
+				log.Debug("Observe Fine Grane Updates X2", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+
+			deletes, updates, err := e.parser.FindResourceDeltaGnmi(updatesx1, updatesx2, log)
+			if err != nil {
+				return managed.ExternalObservation{}, err
+			}
+			if len(deletes) != 0 || len(updates) != 0 {
+				// UMR -> MR with data, which is NOT up to date
+				log.Debug("Observing Response: resource NOT up to date", "Exists", false, "HasData", true, "UpToDate", false, "Response", resp, "Updates", updates, "Deletes", deletes)
+				for _, del := range deletes {
+					log.Debug("Observing Response: resource NOT up to date, deletes", "path", e.parser.GnmiPathToXPath(del, true))
+				}
+				for _, upd := range updates {
+					val, _ := e.parser.GetValue(upd.GetVal())
+					log.Debug("Observing Response: resource NOT up to date, updates", "path", e.parser.GnmiPathToXPath(upd.GetPath(), true), "data", val)
+				}
+				return managed.ExternalObservation{
+					Ready:            true,
+					ResourceExists:   false,
+					ResourceHasData:  true,
+					ResourceUpToDate: false,
+					ResourceDeletes:  deletes,
+					ResourceUpdates:  updates,
+				}, nil
+			}
+			// UMR -> MR with data, which is up to date
+			log.Debug("Observing Response: resource up to date", "Exists", false, "HasData", true, "UpToDate", true, "Response", resp)
+			return managed.ExternalObservation{
+				Ready:            true,
+				ResourceExists:   false,
+				ResourceHasData:  true,
+				ResourceUpToDate: true,
+			}, nil
+		}
+		// UMR -> MR without data
+		log.Debug("Observing Response:", "Exists", false, "HasData", false, "UpToDate", false, "Response", resp)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   false,
+			ResourceHasData:  false,
+			ResourceUpToDate: false,
+		}, nil
+
+	}
+	// Resource Exists
+	switch respMeta.Status {
+	case gext.ResourceStatusSuccess:
+		// the oper state of the lag is reported in the status
+		e.observeState(ctx, o)
+
+		if respMeta.HasData {
+			// data is present
+
+			// the response data is a single list entry, for lists with keys we need to
+			// create a list before calulating the paths
+			x2, err = e.parser.AddJSONDataToList(x2)
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errWrongInputdata)
+			}
+			updatesx1 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigureLag)
+			for _, update := range updatesx1 {
+				log.Debug("Observe Fine Grane Updates X1", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+			updatesx2 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x2, resourceRefPathsConfigureLag)
+			for _, update := range updatesx2 {
+				log.Debug("Observe Fine Grane Updates X2", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+
+			deletes, updates, err := e.parser.FindResourceDeltaGnmi(updatesx1, updatesx2, log)
+			if err != nil {
+				return managed.ExternalObservation{}, err
+			}
+			// MR -> MR, resource is NOT up to date
+			if len(deletes) != 0 || len(updates) != 0 {
+				// resource is NOT up to date
+				log.Debug("Observing Response: resource NOT up to date", "Exists", true, "HasData", true, "UpToDate", false, "Response", resp, "Updates", updates, "Deletes", deletes)
+				for _, del := range deletes {
+					log.Debug("Observing Response: resource NOT up to date, deletes", "path", e.parser.GnmiPathToXPath(del, true))
+				}
+				for _, upd := range updates {
+					val, _ := e.parser.GetValue(upd.GetVal())
+					log.Debug("Observing Response: resource NOT up to date, updates", "path", e.parser.GnmiPathToXPath(upd.GetPath(), true), "data", val)
+				}
+				return managed.ExternalObservation{
+					Ready:            true,
+					ResourceExists:   true,
+					ResourceHasData:  true,
+					ResourceUpToDate: false,
+					ResourceDeletes:  deletes,
+					ResourceUpdates:  updates,
+				}, nil
+			}
+			// MR -> MR, resource is up to date
+			log.Debug("Observing Response: resource up to date", "Exists", true, "HasData", true, "UpToDate", true, "Response", resp)
+			return managed.ExternalObservation{
+				Ready:            true,
+				ResourceExists:   true,
+				ResourceHasData:  true,
+				ResourceUpToDate: true,
+			}, nil
+		}
+		// MR -> MR, resource has no data, strange, someone could have deleted the resource
+		log.Debug("Observing Response", "Exists", true, "HasData", false, "UpToDate", false, "Status", respMeta.Status)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   true,
+			ResourceHasData:  false,
+			ResourceUpToDate: false,
+		}, nil
+
+	default:
+		// MR -> MR, resource is not in a success state, so the object might still be in creation phase
+		log.Debug("Observing Response", "Exists", true, "HasData", false, "UpToDate", false, "Status", respMeta.Status)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   true,
+			ResourceHasData:  false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+}
+
+func (e *externalConfigureLag) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedConfigureLag)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Creating ...")
+
+	rootPath, err := getRootPathConfigureLag(o)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJSONMarshal)
+	}
+
+	var x1 interface{}
+	if err := json.Unmarshal(d, &x1); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	// remove the hierarchical elements for data processing, comparison, etc
+	// they are used in the provider for parent dependency resolution
+	// but are not relevant in the data, they are referenced in the rootPath
+	// when interacting with the device driver
+	hids := make([]string, 0)
+	x1 = e.parser.RemoveLeafsFromJSONData(x1, hids)
+
+	// the resource is a list entry keyed by lag-name, for lists with keys we need to
+	// create a list before calulating the paths such that the key ends up in the path
+	x1, err = e.parser.AddJSONDataToList(x1)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errWrongInputdata)
+	}
+
+	updates := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigureLag)
+	for _, update := range updates {
+		log.Debug("Create Fine Grane Updates", "Path", update.Path, "Value", update.GetVal())
+	}
+
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	gextInfo := &gext.GEXT{
+		Action:   gext.GEXTActionCreate,
+		Name:     gvkstring,
+		Level:    levelConfigureLag,
+		RootPath: rootPath[0],
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetGextInfo)
+	}
+
+	if len(updates) == 0 {
+		log.Debug("cannot create object since there are no updates present")
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateObject)
+	}
+
+	req := &gnmi.SetRequest{
+		Replace: updates,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	_, err = e.client.Set(ctx, req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errReadConfigureLag)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *externalConfigureLag) Update(ctx context.Context, mg resource.Managed, obs managed.ExternalObservation) (managed.ExternalUpdate, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedConfigureLag)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Updating ...")
+
+	for _, u := range obs.ResourceUpdates {
+		log.Debug("Update -> Update", "Path", u.Path, "Value", u.GetVal())
+	}
+	for _, d := range obs.ResourceDeletes {
+		log.Debug("Update -> Delete", "Path", d)
+	}
+
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionUpdate,
+		Name:   gvkstring,
+		Level:  levelConfigureLag,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetGextInfo)
+	}
+
+	req := &gnmi.SetRequest{
+		Update: obs.ResourceUpdates,
+		Delete: obs.ResourceDeletes,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	_, err = e.client.Set(ctx, req)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errReadConfigureLag)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *externalConfigureLag) Delete(ctx context.Context, mg resource.Managed) error {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureLag)
+	if !ok {
+		return errors.New(errUnexpectedConfigureLag)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Deleting ...")
+
+	rootPath, err := getRootPathConfigureLag(o)
+	if err != nil {
+		// without lag-name the resource was never created on the device
+		log.Debug("Delete without lag-name", "error", err)
+		return nil
+	}
+
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return err
+	}
+
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionDelete,
+		Name:   gvkstring,
+		Level:  levelConfigureLag,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return errors.Wrap(err, errGetGextInfo)
+	}
+
+	req := gnmi.SetRequest{
+		Delete: rootPath,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	_, err = e.client.Set(ctx, &req)
+	if err != nil {
+		return errors.Wrap(err, errDeleteConfigureLag)
+	}
+
+	return nil
+}
+
+func (e *externalConfigureLag) GetTarget() []string {
+	return e.targets
+}
+
+func (e *externalConfigureLag) GetConfig(ctx context.Context) ([]byte, error) {
+	e.log.Debug("Get Config ...")
+	req := &gnmi.GetRequest{
+		Path:     []*gnmi.Path{},
+		Encoding: gnmi.Encoding_JSON,
+	}
+
+	resp, err := e.client.Get(ctx, req)
+	if err != nil {
+		return make([]byte, 0), errors.Wrap(err, errGetConfig)
+	}
+
+	if len(resp.GetNotification()) != 0 {
+		if len(resp.GetNotification()[0].GetUpdate()) != 0 {
+			x2, err := e.parser.GetValue(resp.GetNotification()[0].GetUpdate()[0].Val)
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, errGetConfig)
+			}
+
+			data, err := json.Marshal(x2)
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, errJSONMarshal)
+			}
+			return data, nil
+		}
+	}
+	e.log.Debug("Get Config Empty response")
+	return nil, nil
+}
+
+func (e *externalConfigureLag) GetResourceName(ctx context.Context, path []*gnmi.Path) (string, error) {
+	e.log.Debug("Get ResourceName ...")
+
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionGetResourceName,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return "", errors.Wrap(err, errGetGextInfo)
+	}
+
+	req := &gnmi.GetRequest{
+		Path:     path,
+		Encoding: gnmi.Encoding_JSON,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	resp, err := e.client.Get(ctx, req)
+	if err != nil {
+		return "", errors.Wrap(err, errGetResourceName)
+	}
+
+	x2, err := e.parser.GetValue(resp.GetNotification()[0].GetUpdate()[0].Val)
+	if err != nil {
+		return "", errors.Wrap(err, errJSONMarshal)
+	}
+
+	d, err := json.Marshal(x2)
+	if err != nil {
+		return "", errors.Wrap(err, errJSONMarshal)
+	}
+
+	var resourceName nddv1.ResourceName
+	if err := json.Unmarshal(d, &resourceName); err != nil {
+		return "", errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	e.log.Debug("Get ResourceName Response", "ResourceName", resourceName)
+
+	return resourceName.Name, nil
+}
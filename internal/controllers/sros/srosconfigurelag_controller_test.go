@@ -0,0 +1,126 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+func testConfigureLag(name, mode string, portIds ...string) *srosv1alpha1.SrosConfigureLag {
+	o := &srosv1alpha1.SrosConfigureLag{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	o.SetGroupVersionKind(srosv1alpha1.ConfigureLagGroupVersionKind)
+	l := &srosv1alpha1.ConfigureLag{LagName: utils.StringPtr(name)}
+	if mode != "" {
+		l.Mode = utils.StringPtr(mode)
+	}
+	for _, portId := range portIds {
+		l.Port = append(l.Port, &srosv1alpha1.ConfigureLagPort{PortId: utils.StringPtr(portId)})
+	}
+	o.Spec.ForNetworkNode.SrosConfigureLag = l
+	return o
+}
+
+// TestValidateParentDependencyConfigureLag checks that member ports that do not
+// exist or do not match the lag are reported in the member validation
+// condition, without failing the validation which deletes the lag
+func TestValidateParentDependencyConfigureLag(t *testing.T) {
+	v := &validatorConfigureLag{log: logging.NewNopLogger(), parser: *parser.NewParser()}
+	cases := map[string]struct {
+		o      *srosv1alpha1.SrosConfigureLag
+		cfg    string
+		msg    string
+		errMsg string
+	}{
+		"MembersMatch": {
+			o:   testConfigureLag("lag-1", "access", "1/1/1", "1/1/2"),
+			cfg: `{"configure":{"port":[{"port-id":"1/1/1","ethernet":{"mode":"access"}},{"port-id":"1/1/2","ethernet":{"mode":"access","encap-type":"null"}}]}}`,
+		},
+		"DefaultModes": {
+			o:   testConfigureLag("lag-1", "", "1/1/1"),
+			cfg: `{"configure":{"port":[{"port-id":"1/1/1","ethernet":{}}]}}`,
+		},
+		"MemberPortMissing": {
+			o:   testConfigureLag("lag-1", "", "1/1/1", "1/1/3"),
+			cfg: `{"configure":{"port":[{"port-id":"1/1/1"}]}}`,
+			msg: "member port 1/1/3 of lag lag-1 does not exist",
+		},
+		"ModeMismatch": {
+			o:   testConfigureLag("lag-1", "access", "1/1/1"),
+			cfg: `{"configure":{"port":[{"port-id":"1/1/1","ethernet":{"mode":"network"}}]}}`,
+			msg: "ethernet mode network of member port 1/1/1 does not match mode access",
+		},
+		"EncapTypeMismatch": {
+			o:   testConfigureLag("lag-1", "access", "1/1/1"),
+			cfg: `{"configure":{"port":[{"port-id":"1/1/1","ethernet":{"mode":"access","encap-type":"dot1q"}}]}}`,
+			msg: "ethernet encap-type dot1q of member port 1/1/1 does not match encap-type null",
+		},
+		"AllMembersReported": {
+			o:   testConfigureLag("lag-1", "access", "1/1/1", "1/1/2"),
+			cfg: `{"configure":{"port":[{"port-id":"1/1/1","ethernet":{"mode":"network"}}]}}`,
+			msg: "ethernet mode network of member port 1/1/1 does not match mode access of lag lag-1; member port 1/1/2 of lag lag-1 does not exist",
+		},
+		"InvalidConfig": {
+			o:      testConfigureLag("lag-1", "", "1/1/1"),
+			cfg:    `{"configure":`,
+			errMsg: errJSONUnMarshal,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := v.ValidateParentDependency(context.Background(), tc.o, []byte(tc.cfg))
+			if tc.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+					t.Errorf("ValidateParentDependency(): got error %v, want %q", err, tc.errMsg)
+				}
+				return
+			}
+			if err != nil || !obs.Success {
+				t.Fatalf("ValidateParentDependency(): got success %t, error %v, the member ports must not fail the validation", obs.Success, err)
+			}
+			c := tc.o.GetCondition(srosv1alpha1.ConditionKindMemberValidation)
+			if tc.msg == "" && c.Status != corev1.ConditionTrue {
+				t.Errorf("ValidateParentDependency(): got member condition %s: %s", c.Status, c.Message)
+			}
+			if tc.msg != "" && (c.Status != corev1.ConditionFalse || !strings.Contains(c.Message, tc.msg)) {
+				t.Errorf("ValidateParentDependency(): got member condition %s: %s, want %q", c.Status, c.Message, tc.msg)
+			}
+		})
+	}
+}
+
+func TestGetPortModeAndEncapTypeConfigureLag(t *testing.T) {
+	x := unmarshalTestData(t, `{"configure":{"port":[{"port-id":"1/1/1","ethernet":{"mode":"hybrid","encap-type":"qinq"}},{"port-id":"1/1/2"}]}}`)
+	if mode, encapType, ok := getPortModeAndEncapTypeConfigureLag(x, "1/1/1"); !ok || mode != "hybrid" || encapType != "qinq" {
+		t.Errorf("getPortModeAndEncapTypeConfigureLag(1/1/1): got %s, %s, %t", mode, encapType, ok)
+	}
+	if mode, encapType, ok := getPortModeAndEncapTypeConfigureLag(x, "1/1/2"); !ok || mode != defaultModeConfigureLag || encapType != defaultEncapTypeConfigureLag {
+		t.Errorf("getPortModeAndEncapTypeConfigureLag(1/1/2): got %s, %s, %t, want the defaults", mode, encapType, ok)
+	}
+	if _, _, ok := getPortModeAndEncapTypeConfigureLag(x, "1/1/3"); ok {
+		t.Error("getPortModeAndEncapTypeConfigureLag(1/1/3): an absent port must not be found")
+	}
+}
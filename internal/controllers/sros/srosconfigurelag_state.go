@@ -0,0 +1,93 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"sort"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+const (
+	// Errors
+	errReadStateConfigureLag = "cannot read state of ConfigureLag"
+)
+
+// observeState reads the state of the lag from the network node into the
+// status of the ConfigureLag.
+func (e *externalConfigureLag) observeState(ctx context.Context, o *srosv1alpha1.SrosConfigureLag) {
+	log := e.log.WithValues("Resource", o.GetName())
+	lagName := getLagName(o)
+	if lagName == "" {
+		return
+	}
+
+	x, err := e.states.get(ctx, e.client, &e.parser, &gnmi.Path{
+		Elem: []*gnmi.PathElem{
+			{Name: "state"},
+			{Name: "lag", Key: map[string]string{"lag-name": lagName}},
+		},
+	})
+	if err != nil {
+		log.Debug(errReadStateConfigureLag, "error", err)
+		return
+	}
+	if x == nil {
+		log.Debug(errReadStateConfigureLag, "error", "no state data")
+		return
+	}
+	o.Status.AtNetworkNode = getObservationConfigureLag(x)
+}
+
+// getObservationConfigureLag returns the observation of a lag from its state
+// data, which is either the lag or the lag list of the state tree. Member ports
+// that are active carry traffic, the other member ports are standby.
+func getObservationConfigureLag(x interface{}) srosv1alpha1.ConfigureLagObservation {
+	if l := stateValue(x, "lag"); l != nil {
+		x = l
+	}
+	if l, ok := x.([]interface{}); ok {
+		if len(l) == 0 {
+			return srosv1alpha1.ConfigureLagObservation{}
+		}
+		x = l[0]
+	}
+
+	obs := srosv1alpha1.ConfigureLagObservation{
+		OperState:      stateString(x, "oper-state"),
+		ActiveMembers:  make([]string, 0),
+		StandbyMembers: make([]string, 0),
+	}
+	ports, _ := stateValue(x, "port").([]interface{})
+	for _, p := range ports {
+		portId := stateString(p, "port-id")
+		if portId == nil {
+			continue
+		}
+		if active, ok := stateValue(p, "active").(bool); ok && active {
+			obs.ActiveMembers = append(obs.ActiveMembers, *portId)
+		} else {
+			obs.StandbyMembers = append(obs.StandbyMembers, *portId)
+		}
+	}
+	sort.Strings(obs.ActiveMembers)
+	sort.Strings(obs.StandbyMembers)
+	return obs
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yndd/ndd-runtime/pkg/utils"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+func TestGetObservationConfigureLag(t *testing.T) {
+	cases := map[string]struct {
+		state string
+		want  srosv1alpha1.ConfigureLagObservation
+	}{
+		"Empty": {
+			state: `{"lag":[]}`,
+			want:  srosv1alpha1.ConfigureLagObservation{},
+		},
+		"ActiveAndStandbyMembers": {
+			state: `{"lag":[{"lag-name":"lag-1","oper-state":"up","port":[
+				{"port-id":"1/1/3","active":true},
+				{"port-id":"1/1/1","active":true},
+				{"port-id":"1/1/2","active":false},
+				{"port-id":"1/1/4"},
+				{"active":true}
+			]}]}`,
+			want: srosv1alpha1.ConfigureLagObservation{
+				OperState:      utils.StringPtr("up"),
+				ActiveMembers:  []string{"1/1/1", "1/1/3"},
+				StandbyMembers: []string{"1/1/2", "1/1/4"},
+			},
+		},
+		"ModulePrefixes": {
+			state: `{"nokia-state:lag":{"lag-name":"lag-1","oper-state":"down"}}`,
+			want: srosv1alpha1.ConfigureLagObservation{
+				OperState:      utils.StringPtr("down"),
+				ActiveMembers:  []string{},
+				StandbyMembers: []string{},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := getObservationConfigureLag(unmarshalTestData(t, tc.state)); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("getObservationConfigureLag(): got %s, want %s", toJSON(t, got), toJSON(t, tc.want))
+			}
+		})
+	}
+}
@@ -19,7 +19,9 @@ package sros
 import (
 	"context"
 	"encoding/json"
-	"strconv"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/karimra/gnmic/target"
@@ -35,7 +37,6 @@ import (
 	"github.com/yndd/ndd-runtime/pkg/logging"
 	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
 	"github.com/yndd/ndd-runtime/pkg/resource"
-	"github.com/yndd/ndd-runtime/pkg/utils"
 	"github.com/yndd/ndd-yang/pkg/parser"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -47,6 +48,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
 )
 
 const (
@@ -57,3404 +59,2657 @@ const (
 	errCreateConfigurePort           = "cannot create ConfigurePort"
 	erreUpdateConfigurePort          = "cannot update ConfigurePort"
 	errDeleteConfigurePort           = "cannot delete ConfigurePort"
+	errPortIdMissing                 = "port-id is mandatory for a ConfigurePort"
 
 	// resource information
 	levelConfigurePort = 2
 	// resourcePrefixConfigurePort = "sros.ndd.yndd.io.v1alpha1.ConfigurePort"
 )
 
-var resourceRefPathsConfigurePort = []*gnmi.Path{
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "access"},
+// resourceRefPathsConfigurePort is generated from the SR OS yang in the
+// directory SROS_YANG_DIR, run make refpaths after a model update
+//go:generate go run ../../../hack/refpaths -yang ${SROS_YANG_DIR} -module nokia-conf -path /configure/port -var resourceRefPathsConfigurePort -header-file ../../../hack/boilerplate.go.txt -out srosconfigureport_refpaths.go
+
+// dependencyConfigurePort contains the parents a port can depend on, the keys of
+// the remote paths are populated with the dot separated value derived from the
+// port-id
+var dependencyConfigurePort = map[string]*parser.LeafRefGnmi{
+	"card": {
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "card", Key: map[string]string{"slot-number": ""}},
+			},
 		},
 	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "access"},
-			{Name: "egress"},
+	"mda": {
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "card", Key: map[string]string{"slot-number": ""}},
+				{Name: "mda", Key: map[string]string{"mda-slot": ""}},
+			},
 		},
 	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "pool", Key: map[string]string{"name": ""}},
+	"xiom": {
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "card", Key: map[string]string{"slot-number": ""}},
+				{Name: "xiom", Key: map[string]string{"xiom-slot": ""}},
+			},
 		},
 	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "pool", Key: map[string]string{"name": ""}},
-			{Name: "resv-cbs"},
+	"xiom mda": {
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "card", Key: map[string]string{"slot-number": ""}},
+				{Name: "xiom", Key: map[string]string{"xiom-slot": ""}},
+				{Name: "mda", Key: map[string]string{"mda-slot": ""}},
+			},
 		},
 	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "pool", Key: map[string]string{"name": ""}},
-			{Name: "resv-cbs"},
-			{Name: "amber-alarm-action"},
+	"connector": {
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "port", Key: map[string]string{"port-id": ""}},
+				{Name: "connector"},
+				{Name: "breakout"},
+			},
 		},
 	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "access"},
-			{Name: "ingress"},
+	"pxc": {
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "port-xc"},
+				{Name: "pxc", Key: map[string]string{"pxc-id": ""}},
+			},
 		},
 	},
+}
+
+var (
+	// <slot>/<mda>/<port> or <slot>/<mda>/c<connector>
+	portIdMdaRegexp = regexp.MustCompile(`^(\d+)/(\d+)/c?\d+$`)
+	// <slot>/<mda>/c<connector>/<port>
+	portIdMdaConnectorRegexp = regexp.MustCompile(`^((\d+)/(\d+)/c\d+)/\d+$`)
+	// <slot>/x<xiom>/<mda>/<port> or <slot>/x<xiom>/<mda>/c<connector>
+	portIdXiomRegexp = regexp.MustCompile(`^(\d+)/(x\d+)/(\d+)/c?\d+$`)
+	// <slot>/x<xiom>/<mda>/c<connector>/<port>
+	portIdXiomConnectorRegexp = regexp.MustCompile(`^((\d+)/(x\d+)/(\d+)/c\d+)/\d+$`)
+	// pxc-<pxc-id>.<a|b>
+	portIdPxcRegexp = regexp.MustCompile(`^pxc-(\d+)\.[ab]$`)
+)
+
+// parentDependency is a parent of a resource together with the value that
+// populates the keys of its remote path
+type parentDependency struct {
+	kind    string
+	value   string
+	leafRef *parser.LeafRefGnmi
+}
+
+// getParentDependenciesConfigurePort returns the parents of the port derived
+// from its port-id, ordered from the outermost parent. A port depends on its
+// card and mda, an mda in an xiom on the xiom and a breakout port on the
+// connector. The sub-ports of a port cross-connect depend on the pxc of the
+// port-xc. Other ports that do not reside on an mda, like satellite or lag
+// ports, have no parent dependencies.
+func getParentDependenciesConfigurePort(portId string) []parentDependency {
+	deps := make([]parentDependency, 0)
+	add := func(kind string, keys ...string) {
+		deps = append(deps, parentDependency{
+			kind:    kind,
+			value:   strings.Join(keys, "."),
+			leafRef: dependencyConfigurePort[kind],
+		})
+	}
+	if m := portIdMdaConnectorRegexp.FindStringSubmatch(portId); m != nil {
+		add("card", m[2])
+		add("mda", m[2], m[3])
+		add("connector", m[1])
+	} else if m := portIdMdaRegexp.FindStringSubmatch(portId); m != nil {
+		add("card", m[1])
+		add("mda", m[1], m[2])
+	} else if m := portIdXiomConnectorRegexp.FindStringSubmatch(portId); m != nil {
+		add("card", m[2])
+		add("xiom", m[2], m[3])
+		add("xiom mda", m[2], m[3], m[4])
+		add("connector", m[1])
+	} else if m := portIdXiomRegexp.FindStringSubmatch(portId); m != nil {
+		add("card", m[1])
+		add("xiom", m[1], m[2])
+		add("xiom mda", m[1], m[2], m[3])
+	} else if m := portIdPxcRegexp.FindStringSubmatch(portId); m != nil {
+		add("pxc", m[1])
+	}
+	return deps
+}
+
+var localleafRefConfigurePort = []*parser.LeafRefGnmi{
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "pool", Key: map[string]string{"name": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group"},
+				{Name: "hsmda-queue-overrides"},
+				{Name: "secondary-shaper"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "pool", Key: map[string]string{"name": ""}},
-			{Name: "resv-cbs"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "pool", Key: map[string]string{"name": ""}},
-			{Name: "resv-cbs"},
-			{Name: "amber-alarm-action"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "radius-server-policy-config"},
+				{Name: "common"},
+				{Name: "radius-server-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "connector"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "aaa"},
+				{Name: "radius"},
+				{Name: "server-policy", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "dist-cpu-protection"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "radius-server-policy-config"},
+				{Name: "split"},
+				{Name: "radius-server-policy-acct"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "dwdm"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "aaa"},
+				{Name: "radius"},
+				{Name: "server-policy", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "dwdm"},
-			{Name: "coherent"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "radius-server-policy-config"},
+				{Name: "split"},
+				{Name: "radius-server-policy-auth"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "dwdm"},
-			{Name: "coherent"},
-			{Name: "report-alarm"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "aaa"},
+				{Name: "radius"},
+				{Name: "server-policy", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
+}
+var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "dwdm"},
-			{Name: "coherent"},
-			{Name: "sweep"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "access"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "dwdm"},
-			{Name: "wavetracker"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "dwdm"},
-			{Name: "wavetracker"},
-			{Name: "encode"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "access"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "dwdm"},
-			{Name: "wavetracker"},
-			{Name: "power-control"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "dwdm"},
-			{Name: "wavetracker"},
-			{Name: "report-alarm"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "pool", Key: map[string]string{"name": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "pool", Key: map[string]string{"name": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "pool", Key: map[string]string{"name": ""}},
+				{Name: "slope-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "aggregate-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "slope-policy", Key: map[string]string{"slope-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "host-match"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "pool", Key: map[string]string{"name": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "host-match"},
-			{Name: "int-dest-id", Key: map[string]string{"destination-string": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "hsmda-queue-overrides"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "pool", Key: map[string]string{"name": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "hsmda-queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "pool", Key: map[string]string{"name": ""}},
+				{Name: "slope-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "slope-policy", Key: map[string]string{"slope-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "adaptation-rule"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "drop-tail"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "drop-tail"},
-			{Name: "low"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "monitor-queue-depth"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "parent"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
-			{Name: "percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
-			{Name: "percent-rate"},
-			{Name: "percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
-			{Name: "rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "connector"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
-			{Name: "rate"},
-			{Name: "rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "connector"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
-			{Name: "overrides"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "dist-cpu-protection"},
+				{Name: "policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
-			{Name: "parent"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "system"},
+				{Name: "security"},
+				{Name: "dist-cpu-protection"},
+				{Name: "policy", Key: map[string]string{"policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
-			{Name: "rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "dwdm"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
-			{Name: "aggregate-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "dwdm"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
-			{Name: "host-match"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "egress"},
-			{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
-			{Name: "host-match"},
-			{Name: "int-dest-id", Key: map[string]string{"destination-string": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "dwdm"},
+				{Name: "coherent"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "dwdm"},
+				{Name: "coherent"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "dwdm"},
+				{Name: "wavetracker"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "adaptation-rule"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "drop-tail"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "dwdm"},
+				{Name: "wavetracker"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "drop-tail"},
-			{Name: "low"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "monitor-queue-depth"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "accounting-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "log"},
+				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
-			{Name: "overrides"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
-			{Name: "parent"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "access"},
-			{Name: "ingress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
-			{Name: "rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "accounting-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "crc-monitor"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "log"},
+				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "crc-monitor"},
-			{Name: "signal-degrade"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "crc-monitor"},
-			{Name: "signal-failure"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dampening"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "macsec"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "macsec"},
-			{Name: "exclude-protocol"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "hsmda-queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "macsec"},
-			{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "macsec"},
-			{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
-			{Name: "encap-match"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "hsmda-queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "macsec"},
-			{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
-			{Name: "encap-match"},
-			{Name: "encap"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "macsec"},
-			{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
-			{Name: "encap-match"},
-			{Name: "encap"},
-			{Name: "all-match"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "hsmda-queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "queue-id"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "macsec"},
-			{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
-			{Name: "encap-match"},
-			{Name: "encap"},
-			{Name: "double-tag"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "qos"},
+				{Name: "queue-group-templates"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
+				{Name: "queue-group-name]"},
+				{Name: "hsmda-queues"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "macsec"},
-			{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
-			{Name: "encap-match"},
-			{Name: "encap"},
-			{Name: "single-tag"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "hsmda-queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "slope-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "macsec"},
-			{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
-			{Name: "encap-match"},
-			{Name: "encap"},
-			{Name: "untagged"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "hsmda-slope-policy", Key: map[string]string{"hsmda-slope-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "per-host-authentication"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "hsmda-queue-overrides"},
+				{Name: "wrr-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "per-host-authentication"},
-			{Name: "allowed-source-macs"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "hsmda-wrr-policy", Key: map[string]string{"hsmda-wrr-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "per-host-authentication"},
-			{Name: "allowed-source-macs"},
-			{Name: "mac-address", Key: map[string]string{"mac": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "queue-group-name"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "radius-server-policy-config"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "queue-group-templates"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "radius-server-policy-config"},
-			{Name: "common"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "radius-server-policy-config"},
-			{Name: "split"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "dot1x"},
-			{Name: "re-authentication"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "down-on-internal-error"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "down-when-looped"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "queue-id"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "qos"},
+				{Name: "queue-group-templates"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
+				{Name: "queue-group-name]"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "discovery"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "discovery"},
-			{Name: "advertise-capabilities"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "link-monitoring"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "link-monitoring"},
-			{Name: "errored-frame"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "link-monitoring"},
-			{Name: "errored-frame-period"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "scheduler-policy"},
+				{Name: "policy-name"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "link-monitoring"},
-			{Name: "errored-frame-seconds"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "scheduler-policy", Key: map[string]string{"scheduler-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "link-monitoring"},
-			{Name: "errored-symbols"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "link-monitoring"},
-			{Name: "local-sf-action"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "link-monitoring"},
-			{Name: "local-sf-action"},
-			{Name: "info-notification"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "efm-oam"},
-			{Name: "peer-rdi-rx"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
+				{Name: "hw-agg-shaper-scheduler-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
-			{Name: "aggregate-burst"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "hw-agg-shaper-scheduler-policy", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
-			{Name: "class", Key: map[string]string{"class-number": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
+				{Name: "port-scheduler-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "hs-scheduler-policy"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "hs-scheduler-policy"},
-			{Name: "overrides"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "egress"},
+				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
+				{Name: "scheduler-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "hs-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "group", Key: map[string]string{"group-id": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "scheduler-policy", Key: map[string]string{"scheduler-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "hs-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "accounting-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "log"},
+				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
-			{Name: "aggregate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
-			{Name: "class", Key: map[string]string{"class-number": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-qos-policy"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "queue-group-name"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "queue-group-templates"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"ingress-queue-group-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-			{Name: "percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-			{Name: "rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "queue-id"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "qos"},
+				{Name: "queue-group-templates"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"ingress-queue-group-name": ""}},
+				{Name: "queue-group-name]"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+				{Name: "apply-groups-exclude"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "elmi"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "access"},
+				{Name: "ingress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "scheduler-policy"},
+				{Name: "policy-name"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "scheduler-policy", Key: map[string]string{"scheduler-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "accounting-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "log"},
+				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
-			{Name: "ais"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
-			{Name: "alarm-notification"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
-			{Name: "csf"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
-			{Name: "eth-bn"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
-			{Name: "eth-test"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dampening"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
-			{Name: "eth-test"},
-			{Name: "test-pattern"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
-			{Name: "grace"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dampening"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
-			{Name: "grace"},
-			{Name: "eth-ed"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "eth-cfm"},
-			{Name: "mep", Key: map[string]string{"md-admin-name": ""}},
-			{Name: "grace"},
-			{Name: "eth-vsm-grace"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "hold-time"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "hsmda-scheduler-overrides"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "hsmda-scheduler-overrides"},
-			{Name: "group", Key: map[string]string{"group-id": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "hsmda-scheduler-overrides"},
-			{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "macsec"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "ingress"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "lldp"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "macsec"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "lldp"},
-			{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "lldp"},
-			{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
-			{Name: "tx-mgmt-address", Key: map[string]string{"mgmt-address-system-type": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "macsec"},
+				{Name: "exclude-mac-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "lldp"},
-			{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
-			{Name: "tx-tlvs"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "macsec"},
+				{Name: "mac-policy", Key: map[string]string{"mac-policy-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "loopback"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "macsec"},
+				{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "macsec"},
+				{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "port-queues"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "port-queues"},
-			{Name: "overrides"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "macsec"},
+				{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
+				{Name: "ca-name"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "port-queues"},
-			{Name: "overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "macsec"},
+				{Name: "connectivity-association", Key: map[string]string{"ca-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "port-queues"},
-			{Name: "overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "monitor-queue-depth"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "dot1x"},
+				{Name: "radius-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "system"},
+				{Name: "security"},
+				{Name: "dot1x"},
+				{Name: "radius-policy", Key: map[string]string{"policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "aggregate-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "efm-oam"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "efm-oam"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "adaptation-rule"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "drop-tail"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "efm-oam"},
+				{Name: "link-monitoring"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "drop-tail"},
-			{Name: "low"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "monitor-queue-depth"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "efm-oam"},
+				{Name: "link-monitoring"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
-			{Name: "percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
-			{Name: "percent-rate"},
-			{Name: "percent-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
-			{Name: "rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-			{Name: "queue-overrides"},
-			{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			{Name: "queue-override-rate"},
-			{Name: "rate"},
-			{Name: "rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "report-alarm"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "ssm"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "symbol-monitor"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "symbol-monitor"},
-			{Name: "signal-degrade"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "ethernet"},
-			{Name: "symbol-monitor"},
-			{Name: "signal-failure"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "class", Key: map[string]string{"class-number": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "gnss"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "gnss"},
-			{Name: "constellation"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "class", Key: map[string]string{"class-number": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "hybrid-buffer-allocation"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "hybrid-buffer-allocation"},
-			{Name: "egress-weight"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-port-pool-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "hybrid-buffer-allocation"},
-			{Name: "ingress-weight"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "hs-port-pool-policy", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "modify-buffer-allocation"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "modify-buffer-allocation"},
-			{Name: "percentage-of-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "network"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "network"},
-			{Name: "egress"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "pool", Key: map[string]string{"name": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "group", Key: map[string]string{"group-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "pool", Key: map[string]string{"name": ""}},
-			{Name: "resv-cbs"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "network"},
-			{Name: "egress"},
-			{Name: "pool", Key: map[string]string{"name": ""}},
-			{Name: "resv-cbs"},
-			{Name: "amber-alarm-action"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "group", Key: map[string]string{"group-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "fine-granularity-ber"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "fine-granularity-ber"},
-			{Name: "signal-degrade"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "fine-granularity-ber"},
-			{Name: "signal-degrade"},
-			{Name: "clear"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "fine-granularity-ber"},
-			{Name: "signal-degrade"},
-			{Name: "raise"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "fine-granularity-ber"},
-			{Name: "signal-failure"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-scheduler-policy"},
+				{Name: "policy-name"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "hs-scheduler-policy", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "fine-granularity-ber"},
-			{Name: "signal-failure"},
-			{Name: "clear"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "fine-granularity-ber"},
-			{Name: "signal-failure"},
-			{Name: "raise"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
-			{Name: "expected"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "class", Key: map[string]string{"class-number": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
-			{Name: "expected"},
-			{Name: "auto-generated"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
-			{Name: "expected"},
-			{Name: "bytes"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "class", Key: map[string]string{"class-number": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
-			{Name: "expected"},
-			{Name: "string"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "hsmda-scheduler-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
-			{Name: "transmit"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "hsmda-scheduler-policy", Key: map[string]string{"hsmda-scheduler-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
-			{Name: "transmit"},
-			{Name: "auto-generated"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "port-qos-policy"},
+				{Name: "policy-name"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
-			{Name: "transmit"},
-			{Name: "bytes"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "port-qos-policy", Key: map[string]string{"port-qos-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "path-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
-			{Name: "transmit"},
-			{Name: "string"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "payload-structure-identifier"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "payload-structure-identifier"},
-			{Name: "payload"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "report-alarm"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
-			{Name: "expected"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
-			{Name: "expected"},
-			{Name: "auto-generated"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "policy-name"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
-			{Name: "expected"},
-			{Name: "bytes"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "expected"},
-			{Name: "expected"},
-			{Name: "string"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "elmi"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
-			{Name: "transmit"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "elmi"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
-			{Name: "transmit"},
-			{Name: "auto-generated"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
-			{Name: "transmit"},
-			{Name: "bytes"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "eth-cfm"},
+				{Name: "mep", Key: map[string]string{"md-admin-name ma-admin-name mep-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "otu"},
-			{Name: "section-monitoring"},
-			{Name: "trail-trace-identifier"},
-			{Name: "transmit"},
-			{Name: "transmit"},
-			{Name: "string"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "eth-cfm"},
+				{Name: "mep", Key: map[string]string{"md-admin-name ma-admin-name mep-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "group", Key: map[string]string{"group-index": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "hold-time"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "eth-cfm"},
+				{Name: "mep", Key: map[string]string{"md-admin-name ma-admin-name mep-id": ""}},
+				{Name: "ma-admin-name"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "eth-cfm"},
+				{Name: "domain", Key: map[string]string{"md-admin-name": ""}},
+				{Name: "md-admin-name]"},
+				{Name: "association", Key: map[string]string{"ma-admin-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "eth-cfm"},
+				{Name: "mep", Key: map[string]string{"md-admin-name ma-admin-name mep-id": ""}},
+				{Name: "md-admin-name"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "eth-cfm"},
+				{Name: "domain", Key: map[string]string{"md-admin-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "hsmda-scheduler-overrides"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "hsmda-scheduler-overrides"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-			{Name: "percent-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "hsmda-scheduler-overrides"},
+				{Name: "group", Key: map[string]string{"group-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-			{Name: "rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "hsmda-scheduler-overrides"},
+				{Name: "group", Key: map[string]string{"group-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "hsmda-scheduler-overrides"},
+				{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "network"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "hsmda-scheduler-overrides"},
+				{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "ppp"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "ppp"},
-			{Name: "keepalive"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "lldp"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "path", Key: map[string]string{"path-index": ""}},
-			{Name: "report-alarm"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "report-alarm"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "lldp"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "section-trace"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "section-trace"},
-			{Name: "section-trace"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "lldp"},
+				{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "section-trace"},
-			{Name: "section-trace"},
-			{Name: "byte"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "section-trace"},
-			{Name: "section-trace"},
-			{Name: "increment-z0"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "lldp"},
+				{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "sonet-sdh"},
-			{Name: "section-trace"},
-			{Name: "section-trace"},
-			{Name: "string"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "lldp"},
+				{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
+				{Name: "tx-mgmt-address", Key: map[string]string{"mgmt-address-system-type": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "ber-threshold"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "lldp"},
+				{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
+				{Name: "tx-mgmt-address", Key: map[string]string{"mgmt-address-system-type": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "loopback"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "loopback"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "accounting-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "log"},
+				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-			{Name: "percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-			{Name: "rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "port-queues"},
+				{Name: "overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-payload-fill"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-payload-fill"},
-			{Name: "idle-payload-fill-choice"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "port-queues"},
+				{Name: "overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-payload-fill"},
-			{Name: "idle-payload-fill-choice"},
-			{Name: "all-ones"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-payload-fill"},
-			{Name: "idle-payload-fill-choice"},
-			{Name: "pattern"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "accounting-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-signal-fill"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "log"},
+				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-signal-fill"},
-			{Name: "idle-signal-fill-choice"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-signal-fill"},
-			{Name: "idle-signal-fill-choice"},
-			{Name: "all-ones"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-signal-fill"},
-			{Name: "idle-signal-fill-choice"},
-			{Name: "pattern"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "network"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "ppp"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "policer-control-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "ppp"},
-			{Name: "compress"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "policer-control-policy", Key: map[string]string{"policer-control-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "ppp"},
-			{Name: "keepalive"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "queue-group-name"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "hold-time"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "queue-group-templates"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-			{Name: "report-alarm"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "apply-groups-exclude"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "queue-overrides"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "queue-id"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "qos"},
+				{Name: "queue-group-templates"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
+				{Name: "queue-group-name]"},
+				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "scheduler-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "scheduler-policy", Key: map[string]string{"scheduler-policy-name": ""}},
+			},
 		},
 	},
 	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-			{Name: "percent-rate"},
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "ethernet"},
+				{Name: "network"},
+				{Name: "egress"},
+				{Name: "queue-policy"},
+			},
 		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
+			},
 		},
 	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-			{Name: "rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "maintenance-data-link"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "maintenance-data-link"},
-			{Name: "transmit-message-type"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "network"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "ppp"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "ppp"},
-			{Name: "keepalive"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "report-alarm"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-			{Name: "subrate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "ber-threshold"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-			{Name: "percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-			{Name: "rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-payload-fill"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-payload-fill"},
-			{Name: "idle-payload-fill-choice"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-payload-fill"},
-			{Name: "idle-payload-fill-choice"},
-			{Name: "all-ones"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-payload-fill"},
-			{Name: "idle-payload-fill-choice"},
-			{Name: "pattern"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-signal-fill"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-signal-fill"},
-			{Name: "idle-signal-fill-choice"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-signal-fill"},
-			{Name: "idle-signal-fill-choice"},
-			{Name: "all-ones"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "idle-signal-fill"},
-			{Name: "idle-signal-fill-choice"},
-			{Name: "pattern"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "network"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "ppp"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "ppp"},
-			{Name: "compress"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-			{Name: "ppp"},
-			{Name: "keepalive"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "hold-time"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "national-bits"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e1", Key: map[string]string{"e1-index": ""}},
-			{Name: "report-alarm"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-			{Name: "percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "level", Key: map[string]string{"priority-level": ""}},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-			{Name: "rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "percent-rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "egress"},
-			{Name: "port-scheduler-policy"},
-			{Name: "overrides"},
-			{Name: "max-rate"},
-			{Name: "rate-or-percent-rate"},
-			{Name: "rate"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "network"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "ppp"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "ppp"},
-			{Name: "keepalive"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "e3", Key: map[string]string{"e3-index": ""}},
-			{Name: "report-alarm"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "tdm"},
-			{Name: "hold-time"},
-		},
-	},
-	{
-		Elem: []*gnmi.PathElem{
-			{Name: "port-xc"},
-			{Name: "transceiver"},
-		},
-	},
-}
-var dependencyConfigurePort = []*parser.LeafRefGnmi{}
-var localleafRefConfigurePort = []*parser.LeafRefGnmi{
 	{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
 				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group"},
-				{Name: "hsmda-queue-overrides"},
-				{Name: "secondary-shaper"},
+				{Name: "ssm"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -3463,19 +2718,15 @@ var localleafRefConfigurePort = []*parser.LeafRefGnmi{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
 				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "radius-server-policy-config"},
-				{Name: "common"},
-				{Name: "radius-server-policy"},
+				{Name: "ssm"},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "aaa"},
-				{Name: "radius"},
-				{Name: "server-policy", Key: map[string]string{"name": ""}},
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -3484,19 +2735,15 @@ var localleafRefConfigurePort = []*parser.LeafRefGnmi{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
 				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "radius-server-policy-config"},
-				{Name: "split"},
-				{Name: "radius-server-policy-acct"},
+				{Name: "symbol-monitor"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "aaa"},
-				{Name: "radius"},
-				{Name: "server-policy", Key: map[string]string{"name": ""}},
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -3505,29 +2752,23 @@ var localleafRefConfigurePort = []*parser.LeafRefGnmi{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
 				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "radius-server-policy-config"},
-				{Name: "split"},
-				{Name: "radius-server-policy-auth"},
+				{Name: "symbol-monitor"},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "aaa"},
-				{Name: "radius"},
-				{Name: "server-policy", Key: map[string]string{"name": ""}},
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
-}
-var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 	{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "access"},
+				{Name: "gnss"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -3543,7 +2784,7 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "access"},
+				{Name: "gnss"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -3559,9 +2800,7 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "pool", Key: map[string]string{"name": ""}},
+				{Name: "network"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -3577,9 +2816,7 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "pool", Key: map[string]string{"name": ""}},
+				{Name: "network"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -3595,17 +2832,17 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "access"},
+				{Name: "network"},
 				{Name: "egress"},
 				{Name: "pool", Key: map[string]string{"name": ""}},
-				{Name: "slope-policy"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "slope-policy", Key: map[string]string{"slope-policy-name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -3613,10 +2850,10 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "access"},
-				{Name: "ingress"},
+				{Name: "network"},
+				{Name: "egress"},
 				{Name: "pool", Key: map[string]string{"name": ""}},
-				{Name: "apply-groups"},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -3631,17 +2868,17 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "access"},
-				{Name: "ingress"},
+				{Name: "network"},
+				{Name: "egress"},
 				{Name: "pool", Key: map[string]string{"name": ""}},
-				{Name: "apply-groups-exclude"},
+				{Name: "slope-policy"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
+				{Name: "qos"},
+				{Name: "slope-policy", Key: map[string]string{"slope-policy-name": ""}},
 			},
 		},
 	},
@@ -3649,17 +2886,15 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "pool", Key: map[string]string{"name": ""}},
-				{Name: "slope-policy"},
+				{Name: "otu"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "slope-policy", Key: map[string]string{"slope-policy-name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -3667,7 +2902,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "apply-groups"},
+				{Name: "otu"},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -3682,7 +2918,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "apply-groups-exclude"},
+				{Name: "sonet-sdh"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -3697,8 +2934,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "connector"},
-				{Name: "apply-groups"},
+				{Name: "sonet-sdh"},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -3713,8 +2950,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "connector"},
-				{Name: "apply-groups-exclude"},
+				{Name: "sonet-sdh"},
+				{Name: "group", Key: map[string]string{"group-index": ""}},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -3729,17 +2967,16 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "dist-cpu-protection"},
-				{Name: "policy"},
+				{Name: "sonet-sdh"},
+				{Name: "group", Key: map[string]string{"group-index": ""}},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "system"},
-				{Name: "security"},
-				{Name: "dist-cpu-protection"},
-				{Name: "policy", Key: map[string]string{"policy-name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -3747,7 +2984,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "dwdm"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
 				{Name: "apply-groups"},
 			},
 		},
@@ -3763,7 +3001,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "dwdm"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -3779,8 +3018,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "dwdm"},
-				{Name: "coherent"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -3796,8 +3038,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "dwdm"},
-				{Name: "coherent"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -3813,8 +3058,12 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "dwdm"},
-				{Name: "wavetracker"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
 				{Name: "apply-groups"},
 			},
 		},
@@ -3830,8 +3079,12 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "dwdm"},
-				{Name: "wavetracker"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -3847,8 +3100,28 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "policy-name"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "network"},
 				{Name: "accounting-policy"},
 			},
 		},
@@ -3864,8 +3137,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "network"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -3881,8 +3155,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "network"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -3898,18 +3173,17 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "accounting-policy"},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "network"},
+				{Name: "queue-policy"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "log"},
-				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
+				{Name: "qos"},
+				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
 			},
 		},
 	},
@@ -3917,10 +3191,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "ppp"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -3936,10 +3209,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
+				{Name: "sonet-sdh"},
+				{Name: "path", Key: map[string]string{"path-index": ""}},
+				{Name: "ppp"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -3955,12 +3227,7 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "hsmda-queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "tdm"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -3976,12 +3243,7 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "hsmda-queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "tdm"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -3997,24 +3259,16 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "hsmda-queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-				{Name: "queue-id"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
-				{Name: "qos"},
-				{Name: "queue-group-templates"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
-				{Name: "queue-group-name]"},
-				{Name: "hsmda-queues"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4022,20 +3276,16 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "hsmda-queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-				{Name: "slope-policy"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "hsmda-slope-policy", Key: map[string]string{"hsmda-slope-policy-name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4043,19 +3293,17 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "hsmda-queue-overrides"},
-				{Name: "wrr-policy"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "hsmda-wrr-policy", Key: map[string]string{"hsmda-wrr-policy-name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4063,20 +3311,17 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "queue-group-name"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "queue-group-templates"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4084,12 +3329,12 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
 				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4105,12 +3350,12 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
 				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4126,37 +3371,13 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-				{Name: "queue-id"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "qos"},
-				{Name: "queue-group-templates"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
-				{Name: "queue-group-name]"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			},
-		},
-	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
 				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "scheduler-policy"},
+				{Name: "port-scheduler-policy"},
 				{Name: "overrides"},
-				{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4172,13 +3393,13 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
 				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "scheduler-policy"},
+				{Name: "port-scheduler-policy"},
 				{Name: "overrides"},
-				{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4194,11 +3415,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
 				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "scheduler-policy"},
+				{Name: "port-scheduler-policy"},
 				{Name: "policy-name"},
 			},
 		},
@@ -4206,7 +3427,7 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
 				{Name: "qos"},
-				{Name: "scheduler-policy", Key: map[string]string{"scheduler-policy-name": ""}},
+				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4214,18 +3435,18 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
-				{Name: "apply-groups"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "network"},
+				{Name: "accounting-policy"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
+				{Name: "log"},
+				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
 			},
 		},
 	},
@@ -4233,11 +3454,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
-				{Name: "apply-groups-exclude"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "network"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -4252,18 +3473,18 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
-				{Name: "hw-agg-shaper-scheduler-policy"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "network"},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "hw-agg-shaper-scheduler-policy", Key: map[string]string{"name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4271,18 +3492,18 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
-				{Name: "port-scheduler-policy"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "network"},
+				{Name: "queue-policy"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
 				{Name: "qos"},
-				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
+				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
 			},
 		},
 	},
@@ -4290,18 +3511,18 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "egress"},
-				{Name: "virtual-port", Key: map[string]string{"vport-name": ""}},
-				{Name: "scheduler-policy"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "ppp"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "scheduler-policy", Key: map[string]string{"scheduler-policy-name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4309,18 +3530,18 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-				{Name: "accounting-policy"},
+				{Name: "tdm"},
+				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "ppp"},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "log"},
-				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4328,10 +3549,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4347,10 +3566,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4366,33 +3583,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-				{Name: "queue-group-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "queue-group-templates"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"ingress-queue-group-name": ""}},
-			},
-		},
-	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-				{Name: "queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4408,12 +3603,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-				{Name: "queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4429,37 +3623,12 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-				{Name: "queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-				{Name: "queue-id"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "qos"},
-				{Name: "queue-group-templates"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"ingress-queue-group-name": ""}},
-				{Name: "queue-group-name]"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			},
-		},
-	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-				{Name: "scheduler-policy"},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
 				{Name: "overrides"},
-				{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4475,13 +3644,12 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-				{Name: "scheduler-policy"},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
 				{Name: "overrides"},
-				{Name: "scheduler", Key: map[string]string{"scheduler-name": ""}},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4497,11 +3665,10 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "access"},
-				{Name: "ingress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name": ""}},
-				{Name: "scheduler-policy"},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
 				{Name: "policy-name"},
 			},
 		},
@@ -4509,7 +3676,7 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
 				{Name: "qos"},
-				{Name: "scheduler-policy", Key: map[string]string{"scheduler-policy-name": ""}},
+				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4517,7 +3684,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "network"},
 				{Name: "accounting-policy"},
 			},
 		},
@@ -4533,7 +3702,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "network"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4549,7 +3720,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "network"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4565,8 +3738,27 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dampening"},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "network"},
+				{Name: "queue-policy"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "port"},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "ppp"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4582,8 +3774,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dampening"},
+				{Name: "tdm"},
+				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
+				{Name: "ppp"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4599,8 +3792,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dot1x"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4616,8 +3809,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dot1x"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4633,9 +3826,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "macsec"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4651,9 +3844,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "macsec"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4669,17 +3862,20 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "macsec"},
-				{Name: "exclude-mac-policy"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "macsec"},
-				{Name: "mac-policy", Key: map[string]string{"mac-policy-id": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4687,11 +3883,13 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "macsec"},
-				{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
-				{Name: "apply-groups"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -4706,11 +3904,14 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "macsec"},
-				{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
-				{Name: "apply-groups-exclude"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -4725,18 +3926,21 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "macsec"},
-				{Name: "sub-port", Key: map[string]string{"sub-port-id": ""}},
-				{Name: "ca-name"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "macsec"},
-				{Name: "connectivity-association", Key: map[string]string{"ca-name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4744,18 +3948,19 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "dot1x"},
-				{Name: "radius-policy"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "egress"},
+				{Name: "port-scheduler-policy"},
+				{Name: "policy-name"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "system"},
-				{Name: "security"},
-				{Name: "dot1x"},
-				{Name: "radius-policy", Key: map[string]string{"policy-name": ""}},
+				{Name: "qos"},
+				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4763,16 +3968,18 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "efm-oam"},
-				{Name: "apply-groups"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "network"},
+				{Name: "accounting-policy"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
+				{Name: "log"},
+				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
 			},
 		},
 	},
@@ -4780,9 +3987,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "efm-oam"},
-				{Name: "apply-groups-exclude"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "network"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -4797,10 +4006,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "efm-oam"},
-				{Name: "link-monitoring"},
-				{Name: "apply-groups"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "network"},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -4815,17 +4025,18 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "efm-oam"},
-				{Name: "link-monitoring"},
-				{Name: "apply-groups-exclude"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "network"},
+				{Name: "queue-policy"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
+				{Name: "qos"},
+				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
 			},
 		},
 	},
@@ -4833,8 +4044,10 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "ppp"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4850,8 +4063,10 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
+				{Name: "tdm"},
+				{Name: "e1", Key: map[string]string{"e1-index": ""}},
+				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
+				{Name: "ppp"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4867,9 +4082,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4885,9 +4099,8 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4903,10 +4116,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
 				{Name: "egress"},
-				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
-				{Name: "class", Key: map[string]string{"class-number": ""}},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -4922,10 +4136,11 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
 				{Name: "egress"},
-				{Name: "expanded-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
-				{Name: "class", Key: map[string]string{"class-number": ""}},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -4941,16 +4156,20 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
 				{Name: "egress"},
-				{Name: "hs-port-pool-policy"},
+				{Name: "port-scheduler-policy"},
+				{Name: "overrides"},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "hs-port-pool-policy", Key: map[string]string{"name": ""}},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4958,11 +4177,13 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
 				{Name: "egress"},
-				{Name: "hs-scheduler-policy"},
+				{Name: "port-scheduler-policy"},
 				{Name: "overrides"},
-				{Name: "apply-groups"},
+				{Name: "level", Key: map[string]string{"priority-level": ""}},
+				{Name: "apply-groups-exclude"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -4977,18 +4198,18 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
 				{Name: "egress"},
-				{Name: "hs-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups-exclude"},
+				{Name: "port-scheduler-policy"},
+				{Name: "policy-name"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
+				{Name: "qos"},
+				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
 			},
 		},
 	},
@@ -4996,19 +4217,17 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hs-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "group", Key: map[string]string{"group-id": ""}},
-				{Name: "apply-groups"},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
+				{Name: "network"},
+				{Name: "accounting-policy"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
+				{Name: "log"},
+				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
 			},
 		},
 	},
@@ -5016,12 +4235,10 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hs-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "group", Key: map[string]string{"group-id": ""}},
-				{Name: "apply-groups-exclude"},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
+				{Name: "network"},
+				{Name: "apply-groups"},
 			},
 		},
 		RemotePath: &gnmi.Path{
@@ -5036,31 +4253,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hs-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
-	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hs-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
+				{Name: "network"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -5076,17 +4271,17 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hs-scheduler-policy"},
-				{Name: "policy-name"},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
+				{Name: "network"},
+				{Name: "queue-policy"},
 			},
 		},
 		RemotePath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
 				{Name: "qos"},
-				{Name: "hs-scheduler-policy", Key: map[string]string{"name": ""}},
+				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
 			},
 		},
 	},
@@ -5094,9 +4289,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
+				{Name: "ppp"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -5112,9 +4307,9 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
+				{Name: "tdm"},
+				{Name: "e3", Key: map[string]string{"e3-index": ""}},
+				{Name: "ppp"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -5130,10 +4325,7 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
-				{Name: "class", Key: map[string]string{"class-number": ""}},
+				{Name: "transceiver"},
 				{Name: "apply-groups"},
 			},
 		},
@@ -5149,10 +4341,7 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 		LocalPath: &gnmi.Path{
 			Elem: []*gnmi.PathElem{
 				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hs-secondary-shaper", Key: map[string]string{"secondary-shaper-name": ""}},
-				{Name: "class", Key: map[string]string{"class-number": ""}},
+				{Name: "transceiver"},
 				{Name: "apply-groups-exclude"},
 			},
 		},
@@ -5164,2583 +4353,1241 @@ var externalLeafRefConfigurePort = []*parser.LeafRefGnmi{
 			},
 		},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "hsmda-scheduler-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "hsmda-scheduler-policy", Key: map[string]string{"hsmda-scheduler-policy-name": ""}},
-			},
-		},
+}
+
+// constraintsConfigurePort contains the range, length, pattern and enum
+// constraints of the leafs of the port, indexed by schema path. The generated
+// api types carry no validation markers, the values are only validated here.
+var constraintsConfigurePort = map[string]leafConstraint{
+	"/port/access/egress/pool/amber-alarm-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "port-qos-policy"},
-				{Name: "policy-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "port-qos-policy", Key: map[string]string{"port-qos-policy-name": ""}},
-			},
-		},
+	"/port/access/egress/pool/red-alarm-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/access/egress/pool/resv-cbs/amber-alarm-action/max": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/access/egress/pool/resv-cbs/amber-alarm-action/step": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/access/ingress/pool/amber-alarm-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/access/ingress/pool/red-alarm-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "policy-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/access/ingress/pool/resv-cbs/amber-alarm-action/max": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "elmi"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/access/ingress/pool/resv-cbs/amber-alarm-action/step": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "elmi"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/connector/breakout": {
+		{kind: leafKindEnum, enums: []string{"c1-100g", "c1-10g", "c1-25g", "c1-400g", "c1-40g", "c1-50g", "c10-10g", "c2-100g", "c4-100g", "c4-10g", "c4-25g", "c8-50g"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "eth-cfm"},
-				{Name: "mep", Key: map[string]string{"md-admin-name ma-admin-name mep-id": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/connector/rs-fec-mode": {
+		{kind: leafKindEnum, enums: []string{"cl91-514-528", "cl91-514-544"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "eth-cfm"},
-				{Name: "mep", Key: map[string]string{"md-admin-name ma-admin-name mep-id": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/dwdm/coherent/cpr-window-size": {
+		{kind: leafKindInteger, ranges: []valueRange{{2, 4294967295}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "eth-cfm"},
-				{Name: "mep", Key: map[string]string{"md-admin-name ma-admin-name mep-id": ""}},
-				{Name: "ma-admin-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "eth-cfm"},
-				{Name: "domain", Key: map[string]string{"md-admin-name": ""}},
-				{Name: "md-admin-name]"},
-				{Name: "association", Key: map[string]string{"ma-admin-name": ""}},
-			},
-		},
+	"/port/dwdm/coherent/dispersion": {
+		{kind: leafKindInteger, ranges: []valueRange{{-50000, 50000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "eth-cfm"},
-				{Name: "mep", Key: map[string]string{"md-admin-name ma-admin-name mep-id": ""}},
-				{Name: "md-admin-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "eth-cfm"},
-				{Name: "domain", Key: map[string]string{"md-admin-name": ""}},
-			},
-		},
+	"/port/dwdm/coherent/rx-los-thresh": {
+		{kind: leafKindDecimal, ranges: []valueRange{{-30, -13}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "hsmda-scheduler-overrides"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/dwdm/coherent/sweep/end": {
+		{kind: leafKindInteger, ranges: []valueRange{{-50000, 50000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "hsmda-scheduler-overrides"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/dwdm/coherent/sweep/start": {
+		{kind: leafKindInteger, ranges: []valueRange{{-50000, 50000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "hsmda-scheduler-overrides"},
-				{Name: "group", Key: map[string]string{"group-id": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/dwdm/coherent/target-power": {
+		{kind: leafKindDecimal, ranges: []valueRange{{-20, 3}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "hsmda-scheduler-overrides"},
-				{Name: "group", Key: map[string]string{"group-id": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/dwdm/wavetracker/encode/key1": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 4095}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "hsmda-scheduler-overrides"},
-				{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/dwdm/wavetracker/encode/key2": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 4095}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "hsmda-scheduler-overrides"},
-				{Name: "scheduling-class", Key: map[string]string{"class-number": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/dwdm/wavetracker/power-control/target-power": {
+		{kind: leafKindDecimal, ranges: []valueRange{{-22, 3}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "lldp"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/access/bandwidth": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 6400000000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "lldp"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/access/booking-factor": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "lldp"},
-				{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/access/egress/queue-group/hsmda-queue-overrides/queue/wrr-weight": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 32}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "lldp"},
-				{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/access/egress/queue-group/instance-id": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 65535}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "lldp"},
-				{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
-				{Name: "tx-mgmt-address", Key: map[string]string{"mgmt-address-system-type": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/access/egress/queue-group/queue-overrides/queue/monitor-queue-depth/violation-threshold": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 99.99}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "lldp"},
-				{Name: "dest-mac", Key: map[string]string{"mac-type": ""}},
-				{Name: "tx-mgmt-address", Key: map[string]string{"mgmt-address-system-type": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/autonegotiate": {
+		{kind: leafKindEnum, enums: []string{"false", "limited", "true"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "loopback"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/crc-monitor/signal-degrade/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 9}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "loopback"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/crc-monitor/signal-degrade/threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 9}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "accounting-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "log"},
-				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
-			},
-		},
+	"/port/ethernet/crc-monitor/signal-failure/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 9}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/crc-monitor/signal-failure/threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 9}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/crc-monitor/window-size": {
+		{kind: leafKindInteger, ranges: []valueRange{{5, 60}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "port-queues"},
-				{Name: "overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/dampening/half-life": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 2000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "port-queues"},
-				{Name: "overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/dampening/max-suppress-time": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 43200}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "accounting-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "log"},
-				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
-			},
-		},
+	"/port/ethernet/dampening/reuse-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 20000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/dampening/suppress-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 20000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/dot1x/macsec/sub-port/eapol-destination-address": {
+		{kind: leafKindString, patterns: []string{`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "policer-control-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "policer-control-policy", Key: map[string]string{"policer-control-policy-name": ""}},
-			},
-		},
+	"/port/ethernet/dot1x/macsec/sub-port/max-peers": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 32}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "queue-group-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "queue-group-templates"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
-			},
-		},
+	"/port/ethernet/dot1x/macsec/sub-port/sub-port-id": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1023}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/dot1x/max-authentication-requests": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 10}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/dot1x/per-host-authentication/allowed-source-macs/mac-address/mac": {
+		{kind: leafKindString, patterns: []string{`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "queue-overrides"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-				{Name: "queue-id"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "qos"},
-				{Name: "queue-group-templates"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"egress-queue-group-name": ""}},
-				{Name: "queue-group-name]"},
-				{Name: "queue", Key: map[string]string{"queue-id": ""}},
-			},
-		},
+	"/port/ethernet/dot1x/quiet-period": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 3600}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-group", Key: map[string]string{"queue-group-name instance-id": ""}},
-				{Name: "scheduler-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "scheduler-policy", Key: map[string]string{"scheduler-policy-name": ""}},
-			},
-		},
+	"/port/ethernet/dot1x/re-authentication/period": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 9000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "queue-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
-			},
-		},
+	"/port/ethernet/dot1x/server-timeout": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 300}}},
+	},
+	"/port/ethernet/dot1x/supplicant-timeout": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 300}}},
+	},
+	"/port/ethernet/dot1x/transmit-period": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 3600}}},
+	},
+	"/port/ethernet/down-on-internal-error/tx-laser": {
+		{kind: leafKindEnum, enums: []string{"off", "on"}},
+	},
+	"/port/ethernet/down-when-looped/keep-alive": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 120}}},
+	},
+	"/port/ethernet/down-when-looped/retry-timeout": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 0}, {10, 160}}},
+	},
+	"/port/ethernet/efm-oam/hold-time": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 50}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame/sd-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000000}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame/sf-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000000}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame/window": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 600}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-period/sd-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000000}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-period/sf-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000000}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-period/window": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 4294967295}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-seconds/sd-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 900}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-seconds/sf-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 900}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-seconds/window": {
+		{kind: leafKindInteger, ranges: []valueRange{{100, 9000}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-symbols/sd-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000000}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-symbols/sf-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000000}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/errored-symbols/window": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 600}}},
+	},
+	"/port/ethernet/efm-oam/link-monitoring/local-sf-action/event-notification-burst": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 5}}},
+	},
+	"/port/ethernet/efm-oam/mode": {
+		{kind: leafKindEnum, enums: []string{"active", "passive"}},
+	},
+	"/port/ethernet/efm-oam/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{2, 5}}},
+	},
+	"/port/ethernet/efm-oam/transmit-interval": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 600}}},
+	},
+	"/port/ethernet/efm-oam/trigger-fault": {
+		{kind: leafKindEnum, enums: []string{"critical-event", "dying-gasp"}},
+	},
+	"/port/ethernet/egress/expanded-secondary-shaper/aggregate-burst/high-burst-increase": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 65528}}},
+	},
+	"/port/ethernet/egress/expanded-secondary-shaper/class/class-number": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
+	},
+	"/port/ethernet/egress/expanded-secondary-shaper/low-burst-max-class": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
+	},
+	"/port/ethernet/egress/hs-scheduler-policy/overrides/group/group-id": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1}}},
+	},
+	"/port/ethernet/egress/hs-scheduler-policy/overrides/scheduling-class/class-number": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 6}}},
+	},
+	"/port/ethernet/egress/hs-secondary-shaper/aggregate/low-burst-max-class": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 6}}},
+	},
+	"/port/ethernet/egress/hs-secondary-shaper/class/class-number": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 6}}},
+	},
+	"/port/ethernet/egress/port-scheduler-policy/overrides/level/priority-level": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
+	},
+	"/port/ethernet/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
+	},
+	"/port/ethernet/egress/rate": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 400000000}}},
+	},
+	"/port/ethernet/elmi/mode": {
+		{kind: leafKindEnum, enums: []string{"uni-n"}},
+	},
+	"/port/ethernet/elmi/n393": {
+		{kind: leafKindInteger, ranges: []valueRange{{2, 10}}},
+	},
+	"/port/ethernet/elmi/t391": {
+		{kind: leafKindInteger, ranges: []valueRange{{5, 30}}},
+	},
+	"/port/ethernet/elmi/t392": {
+		{kind: leafKindInteger, ranges: []valueRange{{5, 30}}},
+	},
+	"/port/ethernet/eth-cfm/mep/ais/client-meg-level": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 7}}},
+	},
+	"/port/ethernet/eth-cfm/mep/ais/interval": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1}, {60, 60}}},
+	},
+	"/port/ethernet/eth-cfm/mep/ais/low-priority-defect": {
+		{kind: leafKindEnum, enums: []string{"all-def", "mac-rem-err-xcon"}},
+	},
+	"/port/ethernet/eth-cfm/mep/alarm-notification/fng-alarm-time": {
+		{kind: leafKindInteger, ranges: []valueRange{{250, 250}, {350, 350}, {500, 500}, {1000, 1000}}},
+	},
+	"/port/ethernet/eth-cfm/mep/alarm-notification/fng-reset-time": {
+		{kind: leafKindInteger, ranges: []valueRange{{250, 250}, {350, 350}, {500, 500}, {1000, 1000}}},
+	},
+	"/port/ethernet/eth-cfm/mep/ccm-padding-size": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 1500}}},
+	},
+	"/port/ethernet/eth-cfm/mep/csf/multiplier": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0, 0}, {2, 30}}},
+	},
+	"/port/ethernet/eth-cfm/mep/eth-bn/rx-update-pacing": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 600}}},
+	},
+	"/port/ethernet/eth-cfm/mep/eth-test/bit-error-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 11840}}},
+	},
+	"/port/ethernet/eth-cfm/mep/eth-test/test-pattern/pattern": {
+		{kind: leafKindEnum, enums: []string{"all-ones", "all-zeros"}},
+	},
+	"/port/ethernet/eth-cfm/mep/grace/eth-ed/max-rx-defect-window": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 86400}}},
+	},
+	"/port/ethernet/eth-cfm/mep/grace/eth-ed/priority": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 7}}},
+	},
+	"/port/ethernet/eth-cfm/mep/one-way-delay-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 600}}},
+	},
+	"/port/ethernet/hold-time/down": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 3600000}}},
+	},
+	"/port/ethernet/hold-time/units": {
+		{kind: leafKindEnum, enums: []string{"centiseconds", "seconds"}},
+	},
+	"/port/ethernet/hold-time/up": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 3600000}}},
+	},
+	"/port/ethernet/hsmda-scheduler-overrides/group/group-id": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 2}}},
+	},
+	"/port/ethernet/hsmda-scheduler-overrides/scheduling-class/class-number": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
+	},
+	"/port/ethernet/hsmda-scheduler-overrides/scheduling-class/weight-in-group": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100}}},
+	},
+	"/port/ethernet/ingress/rate": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 400000}}},
+	},
+	"/port/ethernet/lldp/dest-mac/port-id-subtype": {
+		{kind: leafKindEnum, enums: []string{"tx-if-alias", "tx-if-name", "tx-local"}},
+	},
+	"/port/ethernet/lldp/dest-mac/tx-mgmt-address/mgmt-address-system-type": {
+		{kind: leafKindEnum, enums: []string{"oob", "oob-ipv6", "system", "system-ipv6"}},
+	},
+	"/port/ethernet/loopback/direction": {
+		{kind: leafKindEnum, enums: []string{"internal", "line"}},
+	},
+	"/port/ethernet/mac-address": {
+		{kind: leafKindString, patterns: []string{`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`}},
+	},
+	"/port/ethernet/min-frame-length": {
+		{kind: leafKindInteger, ranges: []valueRange{{64, 4294967295}}},
+	},
+	"/port/ethernet/mtu": {
+		{kind: leafKindInteger, ranges: []valueRange{{512, 9800}}},
+	},
+	"/port/ethernet/network/egress/port-queues/overrides/queue/monitor-queue-depth/violation-threshold": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 99.99}}},
+	},
+	"/port/ethernet/network/egress/queue-group/instance-id": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 65535}}},
+	},
+	"/port/ethernet/network/egress/queue-group/queue-overrides/queue/monitor-queue-depth/violation-threshold": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 99.99}}},
+	},
+	"/port/ethernet/ptp-asymmetry": {
+		{kind: leafKindInteger, ranges: []valueRange{{-2147483648, 2147483647}}},
+	},
+	"/port/ethernet/rs-fec-mode": {
+		{kind: leafKindEnum, enums: []string{"cl108", "cl74", "cl91-514-528"}},
+	},
+	"/port/ethernet/speed": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 10}, {100, 100}, {1000, 1000}, {10000, 10000}, {25000, 25000}, {40000, 40000}, {50000, 50000}, {100000, 100000}, {200000, 200000}, {400000, 400000}}},
+	},
+	"/port/ethernet/ssm/code-type": {
+		{kind: leafKindEnum, enums: []string{"sdh", "sonet"}},
+	},
+	"/port/ethernet/symbol-monitor/signal-degrade/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 9}}},
+	},
+	"/port/ethernet/symbol-monitor/signal-degrade/threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 9}}},
+	},
+	"/port/ethernet/symbol-monitor/signal-failure/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 9}}},
+	},
+	"/port/ethernet/symbol-monitor/signal-failure/threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 9}}},
+	},
+	"/port/ethernet/symbol-monitor/window-size": {
+		{kind: leafKindInteger, ranges: []valueRange{{5, 60}}},
+	},
+	"/port/ethernet/util-stats-interval": {
+		{kind: leafKindInteger, ranges: []valueRange{{30, 600}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "ssm"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/ethernet/xgig": {
+		{kind: leafKindEnum, enums: []string{"lan", "wan"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "ssm"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/gnss/antenna-cable-delay": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 32767}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "symbol-monitor"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/gnss/elevation-mask-angle": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 89}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "ethernet"},
-				{Name: "symbol-monitor"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/hybrid-buffer-allocation/egress-weight/access": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "gnss"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/hybrid-buffer-allocation/egress-weight/network": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "gnss"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/hybrid-buffer-allocation/ingress-weight/access": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "network"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/hybrid-buffer-allocation/ingress-weight/network": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "network"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/modify-buffer-allocation/percentage-of-rate/egress": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "pool", Key: map[string]string{"name": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/modify-buffer-allocation/percentage-of-rate/ingress": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "pool", Key: map[string]string{"name": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/network/egress/pool/amber-alarm-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "network"},
-				{Name: "egress"},
-				{Name: "pool", Key: map[string]string{"name": ""}},
-				{Name: "slope-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "slope-policy", Key: map[string]string{"slope-policy-name": ""}},
-			},
-		},
+	"/port/network/egress/pool/red-alarm-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 1000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "otu"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/network/egress/pool/resv-cbs/amber-alarm-action/max": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "otu"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/network/egress/pool/resv-cbs/amber-alarm-action/step": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/fine-granularity-ber/signal-degrade/clear/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 99}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/fine-granularity-ber/signal-degrade/clear/threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 10}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "group", Key: map[string]string{"group-index": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/fine-granularity-ber/signal-degrade/raise/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 99}}},
+	},
+	"/port/otu/fine-granularity-ber/signal-degrade/raise/threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 9}}},
+	},
+	"/port/otu/fine-granularity-ber/signal-failure/clear/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 99}}},
+	},
+	"/port/otu/fine-granularity-ber/signal-failure/clear/threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 9}}},
+	},
+	"/port/otu/fine-granularity-ber/signal-failure/raise/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 99}}},
+	},
+	"/port/otu/fine-granularity-ber/signal-failure/raise/threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 8}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "group", Key: map[string]string{"group-index": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/path-monitoring/trail-trace-identifier/expected/expected/bytes/bytes": {
+		{kind: leafKindString, lengths: []valueRange{{0, 192}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/path-monitoring/trail-trace-identifier/expected/expected/string/string": {
+		{kind: leafKindString, lengths: []valueRange{{0, 64}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/path-monitoring/trail-trace-identifier/transmit/transmit/bytes/bytes": {
+		{kind: leafKindString, lengths: []valueRange{{0, 192}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/path-monitoring/trail-trace-identifier/transmit/transmit/string/string": {
+		{kind: leafKindString, lengths: []valueRange{{0, 64}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/sd-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{5, 9}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/section-monitoring/trail-trace-identifier/expected/expected/bytes/bytes": {
+		{kind: leafKindString, lengths: []valueRange{{0, 192}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/section-monitoring/trail-trace-identifier/expected/expected/string/string": {
+		{kind: leafKindString, lengths: []valueRange{{0, 64}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "policy-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/section-monitoring/trail-trace-identifier/transmit/transmit/bytes/bytes": {
+		{kind: leafKindString, lengths: []valueRange{{0, 192}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "network"},
-				{Name: "accounting-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "log"},
-				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
-			},
-		},
+	"/port/otu/section-monitoring/trail-trace-identifier/transmit/transmit/string/string": {
+		{kind: leafKindString, lengths: []valueRange{{0, 64}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/otu/sf-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 6}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/sonet-sdh/clock-source": {
+		{kind: leafKindEnum, enums: []string{"loop-timed", "node-timed"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "network"},
-				{Name: "queue-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
-			},
-		},
+	"/port/sonet-sdh/framing": {
+		{kind: leafKindEnum, enums: []string{"sdh", "sonet"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/sonet-sdh/hold-time/down": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "sonet-sdh"},
-				{Name: "path", Key: map[string]string{"path-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/sonet-sdh/hold-time/up": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
+	},
+	"/port/sonet-sdh/loopback": {
+		{kind: leafKindEnum, enums: []string{"internal", "line"}},
+	},
+	"/port/sonet-sdh/path/crc": {
+		{kind: leafKindInteger, ranges: []valueRange{{16, 16}, {32, 32}}},
+	},
+	"/port/sonet-sdh/path/egress/port-scheduler-policy/overrides/level/priority-level": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
+	},
+	"/port/sonet-sdh/path/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0, 100}}},
+	},
+	"/port/sonet-sdh/path/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
+	},
+	"/port/sonet-sdh/path/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
+	},
+	"/port/sonet-sdh/path/mac-address": {
+		{kind: leafKindString, patterns: []string{`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`}},
+	},
+	"/port/sonet-sdh/path/mode": {
+		{kind: leafKindEnum, enums: []string{"access", "network"}},
+	},
+	"/port/sonet-sdh/path/mtu": {
+		{kind: leafKindInteger, ranges: []valueRange{{512, 9208}}},
+	},
+	"/port/sonet-sdh/path/ppp/keepalive/drop-count": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 255}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/sonet-sdh/path/signal-label": {
+		{kind: leafKindString, lengths: []valueRange{{1, 4}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/sonet-sdh/sd-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 9}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/sonet-sdh/section-trace/section-trace/byte/byte": {
+		{kind: leafKindString, lengths: []valueRange{{1, 4}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/sonet-sdh/section-trace/section-trace/string/string": {
+		{kind: leafKindString, lengths: []valueRange{{0, 16}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/sonet-sdh/sf-threshold": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 6}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/sonet-sdh/speed": {
+		{kind: leafKindEnum, enums: []string{"oc1", "oc12", "oc192", "oc3", "oc48", "oc768"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/buildout": {
+		{kind: leafKindEnum, enums: []string{"long", "short"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/ber-threshold/signal-degrade": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 5000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/ber-threshold/signal-failure": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 5000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/channel-group/crc": {
+		{kind: leafKindInteger, ranges: []valueRange{{16, 16}, {32, 32}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "policy-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/channel-group/ds0-index": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 24}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "network"},
-				{Name: "accounting-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "log"},
-				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
-			},
-		},
+	"/port/tdm/ds1/channel-group/egress/port-scheduler-policy/overrides/level/priority-level": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/channel-group/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/channel-group/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
+	},
+	"/port/tdm/ds1/channel-group/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
+	},
+	"/port/tdm/ds1/channel-group/idle-payload-fill/idle-payload-fill-choice/pattern/pattern": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 255}}},
+	},
+	"/port/tdm/ds1/channel-group/idle-signal-fill/idle-signal-fill-choice/pattern/pattern": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 15}}},
+	},
+	"/port/tdm/ds1/channel-group/mac-address": {
+		{kind: leafKindString, patterns: []string{`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`}},
+	},
+	"/port/tdm/ds1/channel-group/mode": {
+		{kind: leafKindEnum, enums: []string{"access", "network"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "network"},
-				{Name: "queue-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
-			},
-		},
+	"/port/tdm/ds1/channel-group/mtu": {
+		{kind: leafKindInteger, ranges: []valueRange{{512, 9208}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/channel-group/ppp/keepalive/drop-count": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 255}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds1", Key: map[string]string{"ds1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/channel-group/speed": {
+		{kind: leafKindInteger, ranges: []valueRange{{56, 56}, {64, 64}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/channel-group/timeslot": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 24}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/clock-source": {
+		{kind: leafKindEnum, enums: []string{"adaptive", "differential", "loop-timed", "node-timed"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/framing": {
+		{kind: leafKindEnum, enums: []string{"ds1-unframed", "extended-super-frame", "super-frame"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/hold-time/down": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/hold-time/up": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/loopback": {
+		{kind: leafKindEnum, enums: []string{"fdl-ansi", "fdl-bellcore", "inband-ansi", "inband-bellcore", "internal", "line", "payload-ansi"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "policy-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds1/signal-mode": {
+		{kind: leafKindEnum, enums: []string{"channel-associated-signaling"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "network"},
-				{Name: "accounting-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "log"},
-				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
-			},
-		},
+	"/port/tdm/ds3/channelized": {
+		{kind: leafKindEnum, enums: []string{"ds1", "e1"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/clock-source": {
+		{kind: leafKindEnum, enums: []string{"loop-timed", "node-timed"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/crc": {
+		{kind: leafKindInteger, ranges: []valueRange{{16, 16}, {32, 32}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "network"},
-				{Name: "queue-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
-			},
-		},
+	"/port/tdm/ds3/egress/port-scheduler-policy/overrides/level/priority-level": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
+	},
+	"/port/tdm/ds3/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0, 100}}},
+	},
+	"/port/tdm/ds3/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
+	},
+	"/port/tdm/ds3/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
+	},
+	"/port/tdm/ds3/framing": {
+		{kind: leafKindEnum, enums: []string{"c-bit", "ds3-unframed", "m23"}},
+	},
+	"/port/tdm/ds3/loopback": {
+		{kind: leafKindEnum, enums: []string{"internal", "line", "remote"}},
+	},
+	"/port/tdm/ds3/mac-address": {
+		{kind: leafKindString, patterns: []string{`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/maintenance-data-link/equipment-id-code": {
+		{kind: leafKindString, lengths: []valueRange{{0, 10}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "ds3", Key: map[string]string{"ds3-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/maintenance-data-link/facility-id-code": {
+		{kind: leafKindString, lengths: []valueRange{{0, 38}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/maintenance-data-link/frame-id-code": {
+		{kind: leafKindString, lengths: []valueRange{{0, 10}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/maintenance-data-link/generator-string": {
+		{kind: leafKindString, lengths: []valueRange{{0, 38}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/maintenance-data-link/location-id-code": {
+		{kind: leafKindString, lengths: []valueRange{{0, 11}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/maintenance-data-link/port-string": {
+		{kind: leafKindString, lengths: []valueRange{{0, 38}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/maintenance-data-link/unit-id-code": {
+		{kind: leafKindString, lengths: []valueRange{{0, 6}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/mode": {
+		{kind: leafKindEnum, enums: []string{"access", "network"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/mtu": {
+		{kind: leafKindInteger, ranges: []valueRange{{512, 9208}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/ppp/keepalive/drop-count": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 255}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "policy-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/ds3/subrate/csu-mode": {
+		{kind: leafKindEnum, enums: []string{"digital-link", "larscom"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "network"},
-				{Name: "accounting-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "log"},
-				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
-			},
-		},
+	"/port/tdm/ds3/subrate/rate-step": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 147}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/ber-threshold/signal-degrade": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 5000}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/ber-threshold/signal-failure": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 5000}}},
+	},
+	"/port/tdm/e1/channel-group/crc": {
+		{kind: leafKindInteger, ranges: []valueRange{{16, 16}, {32, 32}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "network"},
-				{Name: "queue-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/ds0-index": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 32}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/egress/port-scheduler-policy/overrides/level/priority-level": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e1", Key: map[string]string{"e1-index": ""}},
-				{Name: "channel-group", Key: map[string]string{"ds0-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/idle-payload-fill/idle-payload-fill-choice/pattern/pattern": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 255}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/idle-signal-fill/idle-signal-fill-choice/pattern/pattern": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 15}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/mac-address": {
+		{kind: leafKindString, patterns: []string{`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "overrides"},
-				{Name: "level", Key: map[string]string{"priority-level": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/mode": {
+		{kind: leafKindEnum, enums: []string{"access", "network"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "egress"},
-				{Name: "port-scheduler-policy"},
-				{Name: "policy-name"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/mtu": {
+		{kind: leafKindInteger, ranges: []valueRange{{512, 9208}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "network"},
-				{Name: "accounting-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "log"},
-				{Name: "accounting-policy", Key: map[string]string{"policy-id": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/ppp/keepalive/drop-count": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 255}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/speed": {
+		{kind: leafKindInteger, ranges: []valueRange{{56, 56}, {64, 64}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "network"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e1/channel-group/timeslot": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 32}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "network"},
-				{Name: "queue-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "network-queue", Key: map[string]string{"network-queue-policy": ""}},
-			},
-		},
+	"/port/tdm/e1/clock-source": {
+		{kind: leafKindEnum, enums: []string{"adaptive", "differential", "loop-timed", "node-timed"}},
+	},
+	"/port/tdm/e1/framing": {
+		{kind: leafKindEnum, enums: []string{"e1-unframed", "g704", "no-crc-g704"}},
+	},
+	"/port/tdm/e1/hold-time/down": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
+	},
+	"/port/tdm/e1/hold-time/up": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
+	},
+	"/port/tdm/e1/loopback": {
+		{kind: leafKindEnum, enums: []string{"internal", "line"}},
+	},
+	"/port/tdm/e1/signal-mode": {
+		{kind: leafKindEnum, enums: []string{"channel-associated-signaling"}},
+	},
+	"/port/tdm/e3/clock-source": {
+		{kind: leafKindEnum, enums: []string{"loop-timed", "node-timed"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/crc": {
+		{kind: leafKindInteger, ranges: []valueRange{{16, 16}, {32, 32}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "tdm"},
-				{Name: "e3", Key: map[string]string{"e3-index": ""}},
-				{Name: "ppp"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/egress/port-scheduler-policy/overrides/level/priority-level": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 8}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "transceiver"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port"},
-				{Name: "transceiver"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port-policy"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate": {
+		{kind: leafKindDecimal, ranges: []valueRange{{0.01, 100}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port-policy"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/framing": {
+		{kind: leafKindEnum, enums: []string{"e3-unframed", "g751", "g832"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port-policy"},
-				{Name: "egress-port-scheduler-policy"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "qos"},
-				{Name: "port-scheduler-policy", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/loopback": {
+		{kind: leafKindEnum, enums: []string{"internal", "line"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port-xc"},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/mac-address": {
+		{kind: leafKindString, patterns: []string{`[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}`}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port-xc"},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/mode": {
+		{kind: leafKindEnum, enums: []string{"access", "network"}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port-xc"},
-				{Name: "pxc", Key: map[string]string{"pxc-id": ""}},
-				{Name: "apply-groups"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/mtu": {
+		{kind: leafKindInteger, ranges: []valueRange{{512, 9208}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port-xc"},
-				{Name: "pxc", Key: map[string]string{"pxc-id": ""}},
-				{Name: "apply-groups-exclude"},
-			},
-		},
-		RemotePath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "groups"},
-				{Name: "group", Key: map[string]string{"name": ""}},
-			},
-		},
+	"/port/tdm/e3/ppp/keepalive/drop-count": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 255}}},
 	},
-	{
-		LocalPath: &gnmi.Path{
-			Elem: []*gnmi.PathElem{
-				{Name: "port-xc"},
-				{Name: "pxc", Key: map[string]string{"pxc-id": ""}},
-				{Name: "port-id"},
-			},
-		},
-		RemotePath: &gnmi.Path{
+	"/port/tdm/encoding": {
+		{kind: leafKindEnum, enums: []string{"ami", "b8zs", "hdb3"}},
+	},
+	"/port/tdm/hold-time/down": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 100}}},
+	},
+	"/port/tdm/hold-time/up": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 100}}},
+	},
+	"/port/tdm/line-impedance": {
+		{kind: leafKindInteger, ranges: []valueRange{{75, 75}, {100, 100}, {120, 120}}},
+	},
+}
+
+// defaultsConfigurePort contains the yang defaults of the leafs of the port as
+// json values, indexed by schema path
+var defaultsConfigurePort = map[string]string{
+	"/port/access/egress/pool/resv-cbs/cbs":                                                                                `"auto"`,
+	"/port/access/ingress/pool/resv-cbs/cbs":                                                                               `"auto"`,
+	"/port/ddm-events":                                                                                                     `true`,
+	"/port/dwdm/coherent/cpr-window-size":                                                                                  `32`,
+	"/port/dwdm/coherent/report-alarm/hosttx":                                                                              `true`,
+	"/port/dwdm/coherent/report-alarm/mod":                                                                                 `true`,
+	"/port/dwdm/coherent/report-alarm/modflt":                                                                              `true`,
+	"/port/dwdm/coherent/report-alarm/netrx":                                                                               `true`,
+	"/port/dwdm/coherent/report-alarm/nettx":                                                                               `true`,
+	"/port/dwdm/coherent/rx-los-thresh":                                                                                    `"-23"`,
+	"/port/dwdm/coherent/sweep/end":                                                                                        `2000`,
+	"/port/dwdm/coherent/sweep/start":                                                                                      `-25500`,
+	"/port/dwdm/coherent/target-power":                                                                                     `"1"`,
+	"/port/dwdm/wavetracker/power-control/target-power":                                                                    `"-20"`,
+	"/port/dwdm/wavetracker/report-alarm/encoder-degrade":                                                                  `true`,
+	"/port/dwdm/wavetracker/report-alarm/encoder-failure":                                                                  `true`,
+	"/port/dwdm/wavetracker/report-alarm/missing-pluggable-voa":                                                            `true`,
+	"/port/dwdm/wavetracker/report-alarm/power-control-degrade":                                                            `true`,
+	"/port/dwdm/wavetracker/report-alarm/power-control-failure":                                                            `true`,
+	"/port/dwdm/wavetracker/report-alarm/power-control-high-limit":                                                         `true`,
+	"/port/dwdm/wavetracker/report-alarm/power-control-low-limit":                                                          `true`,
+	"/port/ethernet/access/booking-factor":                                                                                 `100`,
+	"/port/ethernet/access/collect-stats":                                                                                  `false`,
+	"/port/ethernet/access/egress/queue-group/aggregate-rate/limit-unused-bandwidth":                                       `false`,
+	"/port/ethernet/access/egress/queue-group/aggregate-rate/queue-frame-based-accounting":                                 `false`,
+	"/port/ethernet/access/egress/queue-group/aggregate-rate/rate":                                                         `"max"`,
+	"/port/ethernet/access/egress/queue-group/collect-stats":                                                               `false`,
+	"/port/ethernet/access/egress/queue-group/hs-turbo":                                                                    `false`,
+	"/port/ethernet/access/egress/queue-group/queue-overrides/queue/monitor-depth":                                         `false`,
+	"/port/ethernet/access/egress/queue-group/queue-overrides/queue/monitor-queue-depth/fast-polling":                      `false`,
+	"/port/ethernet/access/egress/virtual-port/aggregate-rate/limit-unused-bandwidth":                                      `false`,
+	"/port/ethernet/access/egress/virtual-port/aggregate-rate/rate":                                                        `"max"`,
+	"/port/ethernet/access/egress/virtual-port/monitor-hw-agg-shaper-scheduler":                                            `false`,
+	"/port/ethernet/access/egress/virtual-port/monitor-port-scheduler":                                                     `false`,
+	"/port/ethernet/access/egress/virtual-port/multicast-hqos-adjustment":                                                  `false`,
+	"/port/ethernet/access/ingress/queue-group/collect-stats":                                                              `false`,
+	"/port/ethernet/access/ingress/queue-group/queue-overrides/queue/monitor-depth":                                        `false`,
+	"/port/ethernet/access/ingress/queue-group/queue-overrides/queue/monitor-queue-depth/fast-polling":                     `false`,
+	"/port/ethernet/collect-stats":                                                                                         `false`,
+	"/port/ethernet/crc-monitor/signal-degrade/multiplier":                                                                 `1`,
+	"/port/ethernet/crc-monitor/signal-failure/multiplier":                                                                 `1`,
+	"/port/ethernet/crc-monitor/window-size":                                                                               `10`,
+	"/port/ethernet/dampening/half-life":                                                                                   `5`,
+	"/port/ethernet/dampening/max-suppress-time":                                                                           `20`,
+	"/port/ethernet/dampening/reuse-threshold":                                                                             `1000`,
+	"/port/ethernet/dampening/suppress-threshold":                                                                          `2000`,
+	"/port/ethernet/discard-rx-pause-frames":                                                                               `false`,
+	"/port/ethernet/dot1x/macsec/exclude-protocol/cdp":                                                                     `false`,
+	"/port/ethernet/dot1x/macsec/exclude-protocol/eapol-start":                                                             `false`,
+	"/port/ethernet/dot1x/macsec/exclude-protocol/efm-oam":                                                                 `false`,
+	"/port/ethernet/dot1x/macsec/exclude-protocol/eth-cfm":                                                                 `false`,
+	"/port/ethernet/dot1x/macsec/exclude-protocol/lacp":                                                                    `false`,
+	"/port/ethernet/dot1x/macsec/exclude-protocol/lldp":                                                                    `false`,
+	"/port/ethernet/dot1x/macsec/exclude-protocol/ptp":                                                                     `false`,
+	"/port/ethernet/dot1x/macsec/exclude-protocol/ubfd":                                                                    `false`,
+	"/port/ethernet/dot1x/macsec/rx-must-be-encrypted":                                                                     `false`,
+	"/port/ethernet/dot1x/macsec/sub-port/encap-match/encap/all-match/all-match":                                           `true`,
+	"/port/ethernet/dot1x/max-authentication-requests":                                                                     `2`,
+	"/port/ethernet/dot1x/per-host-authentication/authenticator-init":                                                      `true`,
+	"/port/ethernet/dot1x/quiet-period":                                                                                    `60`,
+	"/port/ethernet/dot1x/re-authentication/period":                                                                        `3600`,
+	"/port/ethernet/dot1x/server-timeout":                                                                                  `30`,
+	"/port/ethernet/dot1x/supplicant-timeout":                                                                              `30`,
+	"/port/ethernet/dot1x/transmit-period":                                                                                 `30`,
+	"/port/ethernet/dot1x/tunnel-dot1q":                                                                                    `true`,
+	"/port/ethernet/dot1x/tunnel-qinq":                                                                                     `true`,
+	"/port/ethernet/dot1x/tunneling":                                                                                       `false`,
+	"/port/ethernet/down-on-internal-error/tx-laser":                                                                       `"on"`,
+	"/port/ethernet/down-when-looped/keep-alive":                                                                           `10`,
+	"/port/ethernet/down-when-looped/retry-timeout":                                                                        `120`,
+	"/port/ethernet/down-when-looped/use-broadcast-address":                                                                `false`,
+	"/port/ethernet/efm-oam/accept-remote-loopback":                                                                        `false`,
+	"/port/ethernet/efm-oam/discovery/advertise-capabilities/link-monitoring":                                              `true`,
+	"/port/ethernet/efm-oam/dying-gasp-tx-on-reset":                                                                        `true`,
+	"/port/ethernet/efm-oam/grace-vendor-oui":                                                                              `"00:16:4D"`,
+	"/port/ethernet/efm-oam/ignore-efm-state":                                                                              `false`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame/event-notification":                                              `true`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame/sf-threshold":                                                    `1`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame/window":                                                          `10`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-period/event-notification":                                       `true`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-period/sf-threshold":                                             `1`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-period/window":                                                   `1488095`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-seconds/event-notification":                                      `true`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-seconds/sf-threshold":                                            `1`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-seconds/window":                                                  `600`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-symbols/event-notification":                                            `true`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-symbols/sf-threshold":                                                  `1`,
+	"/port/ethernet/efm-oam/link-monitoring/errored-symbols/window":                                                        `10`,
+	"/port/ethernet/efm-oam/link-monitoring/local-sf-action/event-notification-burst":                                      `1`,
+	"/port/ethernet/efm-oam/link-monitoring/local-sf-action/info-notification/critical-event":                              `false`,
+	"/port/ethernet/efm-oam/link-monitoring/local-sf-action/info-notification/dying-gasp":                                  `false`,
+	"/port/ethernet/efm-oam/mode":                                                                                          `"active"`,
+	"/port/ethernet/efm-oam/multiplier":                                                                                    `5`,
+	"/port/ethernet/efm-oam/transmit-interval":                                                                             `10`,
+	"/port/ethernet/efm-oam/tunneling":                                                                                     `false`,
+	"/port/ethernet/egress/eth-bn-rate-changes":                                                                            `false`,
+	"/port/ethernet/egress/expanded-secondary-shaper/low-burst-max-class":                                                  `8`,
+	"/port/ethernet/egress/hs-secondary-shaper/aggregate/low-burst-max-class":                                              `6`,
+	"/port/ethernet/egress/hs-secondary-shaper/aggregate/rate":                                                             `"max"`,
+	"/port/ethernet/egress/hs-secondary-shaper/class/rate":                                                                 `"max"`,
+	"/port/ethernet/egress/monitor-port-scheduler":                                                                         `false`,
+	"/port/ethernet/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate":        `"100"`,
+	"/port/ethernet/elmi/n393":                                                                                             `4`,
+	"/port/ethernet/elmi/t391":                                                                                             `10`,
+	"/port/ethernet/elmi/t392":                                                                                             `15`,
+	"/port/ethernet/eth-cfm/mep/ais/interface-support":                                                                     `false`,
+	"/port/ethernet/eth-cfm/mep/ais/interval":                                                                              `1`,
+	"/port/ethernet/eth-cfm/mep/ais/low-priority-defect":                                                                   `"all-def"`,
+	"/port/ethernet/eth-cfm/mep/ccm":                                                                                       `false`,
+	"/port/ethernet/eth-cfm/mep/collect-lmm-stats":                                                                         `false`,
+	"/port/ethernet/eth-cfm/mep/csf/multiplier":                                                                            `"3.5"`,
+	"/port/ethernet/eth-cfm/mep/eth-bn/receive":                                                                            `false`,
+	"/port/ethernet/eth-cfm/mep/eth-bn/rx-update-pacing":                                                                   `5`,
+	"/port/ethernet/eth-cfm/mep/eth-test/bit-error-threshold":                                                              `1`,
+	"/port/ethernet/eth-cfm/mep/eth-test/test-pattern/crc-tlv":                                                             `false`,
+	"/port/ethernet/eth-cfm/mep/eth-test/test-pattern/pattern":                                                             `"all-zeros"`,
+	"/port/ethernet/eth-cfm/mep/facility-fault":                                                                            `false`,
+	"/port/ethernet/eth-cfm/mep/grace/eth-ed/rx-eth-ed":                                                                    `true`,
+	"/port/ethernet/eth-cfm/mep/grace/eth-ed/tx-eth-ed":                                                                    `false`,
+	"/port/ethernet/eth-cfm/mep/grace/eth-vsm-grace/rx-eth-vsm-grace":                                                      `true`,
+	"/port/ethernet/eth-cfm/mep/grace/eth-vsm-grace/tx-eth-vsm-grace":                                                      `true`,
+	"/port/ethernet/eth-cfm/mep/install-mep":                                                                               `false`,
+	"/port/ethernet/eth-cfm/mep/one-way-delay-threshold":                                                                   `3`,
+	"/port/ethernet/hold-time/units":                                                                                       `"seconds"`,
+	"/port/ethernet/lacp-tunnel":                                                                                           `false`,
+	"/port/ethernet/lldp/dest-mac/notification":                                                                            `false`,
+	"/port/ethernet/lldp/dest-mac/port-id-subtype":                                                                         `"tx-local"`,
+	"/port/ethernet/lldp/dest-mac/receive":                                                                                 `false`,
+	"/port/ethernet/lldp/dest-mac/transmit":                                                                                `false`,
+	"/port/ethernet/lldp/dest-mac/tunnel-nearest-bridge":                                                                   `false`,
+	"/port/ethernet/lldp/dest-mac/tx-tlvs/port-desc":                                                                       `false`,
+	"/port/ethernet/lldp/dest-mac/tx-tlvs/sys-cap":                                                                         `false`,
+	"/port/ethernet/lldp/dest-mac/tx-tlvs/sys-desc":                                                                        `false`,
+	"/port/ethernet/lldp/dest-mac/tx-tlvs/sys-name":                                                                        `false`,
+	"/port/ethernet/loopback/swap-src-dst-mac":                                                                             `false`,
+	"/port/ethernet/mac-address":                                                                                           `"00:00:00:00:00:00"`,
+	"/port/ethernet/min-frame-length":                                                                                      `64`,
+	"/port/ethernet/network/collect-stats":                                                                                 `false`,
+	"/port/ethernet/network/egress/port-queues/overrides/queue/monitor-queue-depth/fast-polling":                           `false`,
+	"/port/ethernet/network/egress/queue-group/aggregate-rate/limit-unused-bandwidth":                                      `false`,
+	"/port/ethernet/network/egress/queue-group/aggregate-rate/queue-frame-based-accounting":                                `false`,
+	"/port/ethernet/network/egress/queue-group/aggregate-rate/rate":                                                        `"max"`,
+	"/port/ethernet/network/egress/queue-group/collect-stats":                                                              `false`,
+	"/port/ethernet/network/egress/queue-group/hs-turbo":                                                                   `false`,
+	"/port/ethernet/network/egress/queue-group/queue-overrides/queue/monitor-depth":                                        `false`,
+	"/port/ethernet/network/egress/queue-group/queue-overrides/queue/monitor-queue-depth/fast-polling":                     `false`,
+	"/port/ethernet/single-fiber":                                                                                          `false`,
+	"/port/ethernet/ssm/code-type":                                                                                         `"sdh"`,
+	"/port/ethernet/ssm/esmc-tunnel":                                                                                       `false`,
+	"/port/ethernet/symbol-monitor/signal-degrade/multiplier":                                                              `1`,
+	"/port/ethernet/symbol-monitor/signal-failure/multiplier":                                                              `1`,
+	"/port/ethernet/symbol-monitor/window-size":                                                                            `10`,
+	"/port/ethernet/util-stats-interval":                                                                                   `300`,
+	"/port/gnss/antenna-cable-delay":                                                                                       `0`,
+	"/port/gnss/constellation/glonass":                                                                                     `false`,
+	"/port/gnss/constellation/gps":                                                                                         `true`,
+	"/port/gnss/elevation-mask-angle":                                                                                      `10`,
+	"/port/hybrid-buffer-allocation/egress-weight/access":                                                                  `50`,
+	"/port/hybrid-buffer-allocation/egress-weight/network":                                                                 `50`,
+	"/port/hybrid-buffer-allocation/ingress-weight/access":                                                                 `50`,
+	"/port/hybrid-buffer-allocation/ingress-weight/network":                                                                `50`,
+	"/port/modify-buffer-allocation/percentage-of-rate/ingress":                                                            `100`,
+	"/port/monitor-agg-egress-queue-stats":                                                                                 `false`,
+	"/port/network/egress/pool/resv-cbs/cbs":                                                                               `"auto"`,
+	"/port/otu/fine-granularity-ber/signal-degrade/clear/multiplier":                                                       `10`,
+	"/port/otu/fine-granularity-ber/signal-degrade/clear/threshold":                                                        `8`,
+	"/port/otu/fine-granularity-ber/signal-degrade/raise/multiplier":                                                       `10`,
+	"/port/otu/fine-granularity-ber/signal-degrade/raise/threshold":                                                        `7`,
+	"/port/otu/fine-granularity-ber/signal-failure/clear/multiplier":                                                       `10`,
+	"/port/otu/fine-granularity-ber/signal-failure/clear/threshold":                                                        `6`,
+	"/port/otu/fine-granularity-ber/signal-failure/raise/multiplier":                                                       `10`,
+	"/port/otu/fine-granularity-ber/signal-failure/raise/threshold":                                                        `5`,
+	"/port/otu/report-alarm/fec-fail":                                                                                      `false`,
+	"/port/otu/report-alarm/fec-sd":                                                                                        `false`,
+	"/port/otu/report-alarm/fec-sf":                                                                                        `true`,
+	"/port/otu/report-alarm/fec-uncorr":                                                                                    `false`,
+	"/port/otu/report-alarm/loc":                                                                                           `true`,
+	"/port/otu/report-alarm/lof":                                                                                           `true`,
+	"/port/otu/report-alarm/lom":                                                                                           `true`,
+	"/port/otu/report-alarm/los":                                                                                           `true`,
+	"/port/otu/report-alarm/odu-ais":                                                                                       `false`,
+	"/port/otu/report-alarm/odu-bdi":                                                                                       `false`,
+	"/port/otu/report-alarm/odu-lck":                                                                                       `false`,
+	"/port/otu/report-alarm/odu-oci":                                                                                       `false`,
+	"/port/otu/report-alarm/odu-tim":                                                                                       `false`,
+	"/port/otu/report-alarm/opu-plm":                                                                                       `false`,
+	"/port/otu/report-alarm/otu-ais":                                                                                       `false`,
+	"/port/otu/report-alarm/otu-bdi":                                                                                       `true`,
+	"/port/otu/report-alarm/otu-ber-sd":                                                                                    `false`,
+	"/port/otu/report-alarm/otu-ber-sf":                                                                                    `true`,
+	"/port/otu/report-alarm/otu-biae":                                                                                      `false`,
+	"/port/otu/report-alarm/otu-iae":                                                                                       `false`,
+	"/port/otu/report-alarm/otu-tim":                                                                                       `false`,
+	"/port/otu/sd-threshold":                                                                                               `7`,
+	"/port/otu/sf-threshold":                                                                                               `5`,
+	"/port/sonet-sdh/framing":                                                                                              `"sonet"`,
+	"/port/sonet-sdh/hold-time/up":                                                                                         `5`,
+	"/port/sonet-sdh/path/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": `"100"`,
+	"/port/sonet-sdh/path/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": `"100"`,
+	"/port/sonet-sdh/path/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate":  `"100"`,
+	"/port/sonet-sdh/path/mac-address":                                                                                     `"00:00:00:00:00:00"`,
+	"/port/sonet-sdh/path/network/collect-stats":                                                                           `false`,
+	"/port/sonet-sdh/path/ppp/keepalive/drop-count":                                                                        `3`,
+	"/port/sonet-sdh/path/ppp/keepalive/interval":                                                                          `"10"`,
+	"/port/sonet-sdh/path/report-alarm/pais":                                                                               `false`,
+	"/port/sonet-sdh/path/report-alarm/plcd":                                                                               `false`,
+	"/port/sonet-sdh/path/report-alarm/plop":                                                                               `true`,
+	"/port/sonet-sdh/path/report-alarm/pplm":                                                                               `true`,
+	"/port/sonet-sdh/path/report-alarm/prdi":                                                                               `false`,
+	"/port/sonet-sdh/path/report-alarm/prei":                                                                               `false`,
+	"/port/sonet-sdh/path/report-alarm/puneq":                                                                              `true`,
+	"/port/sonet-sdh/report-alarm/lais":                                                                                    `false`,
+	"/port/sonet-sdh/report-alarm/lb2er-sd":                                                                                `false`,
+	"/port/sonet-sdh/report-alarm/lb2er-sf":                                                                                `true`,
+	"/port/sonet-sdh/report-alarm/loc":                                                                                     `true`,
+	"/port/sonet-sdh/report-alarm/lrdi":                                                                                    `true`,
+	"/port/sonet-sdh/report-alarm/lrei":                                                                                    `false`,
+	"/port/sonet-sdh/report-alarm/slof":                                                                                    `true`,
+	"/port/sonet-sdh/report-alarm/slos":                                                                                    `true`,
+	"/port/sonet-sdh/report-alarm/ss1f":                                                                                    `false`,
+	"/port/sonet-sdh/reset-port-on-path-down":                                                                              `false`,
+	"/port/sonet-sdh/sd-threshold":                                                                                         `6`,
+	"/port/sonet-sdh/section-trace/section-trace/byte/byte":                                                                `"1"`,
+	"/port/sonet-sdh/sf-threshold":                                                                                         `3`,
+	"/port/sonet-sdh/single-fiber":                                                                                         `false`,
+	"/port/sonet-sdh/suppress-low-order-alarms":                                                                            `false`,
+	"/port/sonet-sdh/tx-dus":                                                                                               `false`,
+	"/port/tdm/buildout":                                                                                                   `"short"`,
+	"/port/tdm/ds1/ber-threshold/signal-degrade":                                                                           `5`,
+	"/port/tdm/ds1/ber-threshold/signal-failure":                                                                           `50`,
+	"/port/tdm/ds1/channel-group/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": `"100"`,
+	"/port/tdm/ds1/channel-group/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": `"100"`,
+	"/port/tdm/ds1/channel-group/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate":  `"100"`,
+	"/port/tdm/ds1/channel-group/mac-address":              `"00:00:00:00:00:00"`,
+	"/port/tdm/ds1/channel-group/network/collect-stats":    `false`,
+	"/port/tdm/ds1/channel-group/ppp/ber-sf-link-down":     `false`,
+	"/port/tdm/ds1/channel-group/ppp/compress/acfc":        `false`,
+	"/port/tdm/ds1/channel-group/ppp/compress/pfc":         `false`,
+	"/port/tdm/ds1/channel-group/ppp/keepalive/drop-count": `3`,
+	"/port/tdm/ds1/channel-group/ppp/keepalive/interval":   `"10"`,
+	"/port/tdm/ds1/channel-group/speed":                    `64`,
+	"/port/tdm/ds1/framing":                                `"extended-super-frame"`,
+	"/port/tdm/ds1/hold-time/down":                         `0`,
+	"/port/tdm/ds1/hold-time/up":                           `0`,
+	"/port/tdm/ds1/remote-loop-respond":                    `false`,
+	"/port/tdm/ds1/report-alarm/ais":                       `true`,
+	"/port/tdm/ds1/report-alarm/ber-sd":                    `false`,
+	"/port/tdm/ds1/report-alarm/ber-sf":                    `false`,
+	"/port/tdm/ds1/report-alarm/looped":                    `false`,
+	"/port/tdm/ds1/report-alarm/los":                       `true`,
+	"/port/tdm/ds1/report-alarm/oof":                       `false`,
+	"/port/tdm/ds1/report-alarm/rai":                       `false`,
+	"/port/tdm/ds3/clock-source":                           `"node-timed"`,
+	"/port/tdm/ds3/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": `"100"`,
+	"/port/tdm/ds3/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": `"100"`,
+	"/port/tdm/ds3/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate":  `"100"`,
+	"/port/tdm/ds3/feac-loop-respond": `false`,
+	"/port/tdm/ds3/framing":           `"c-bit"`,
+	"/port/tdm/ds3/mac-address":       `"00:00:00:00:00:00"`,
+	"/port/tdm/ds3/maintenance-data-link/transmit-message-type/idle-signal": `false`,
+	"/port/tdm/ds3/maintenance-data-link/transmit-message-type/path":        `false`,
+	"/port/tdm/ds3/maintenance-data-link/transmit-message-type/test-signal": `false`,
+	"/port/tdm/ds3/network/collect-stats":                                   `false`,
+	"/port/tdm/ds3/ppp/keepalive/drop-count":                                `3`,
+	"/port/tdm/ds3/ppp/keepalive/interval":                                  `"10"`,
+	"/port/tdm/ds3/report-alarm/ais":                                        `true`,
+	"/port/tdm/ds3/report-alarm/looped":                                     `false`,
+	"/port/tdm/ds3/report-alarm/los":                                        `true`,
+	"/port/tdm/ds3/report-alarm/oof":                                        `false`,
+	"/port/tdm/ds3/report-alarm/rai":                                        `false`,
+	"/port/tdm/e1/ber-threshold/signal-degrade":                             `5`,
+	"/port/tdm/e1/ber-threshold/signal-failure":                             `50`,
+	"/port/tdm/e1/channel-group/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": `"100"`,
+	"/port/tdm/e1/channel-group/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": `"100"`,
+	"/port/tdm/e1/channel-group/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate":  `"100"`,
+	"/port/tdm/e1/channel-group/mac-address":              `"00:00:00:00:00:00"`,
+	"/port/tdm/e1/channel-group/network/collect-stats":    `false`,
+	"/port/tdm/e1/channel-group/ppp/ber-sf-link-down":     `false`,
+	"/port/tdm/e1/channel-group/ppp/compress/acfc":        `false`,
+	"/port/tdm/e1/channel-group/ppp/compress/pfc":         `false`,
+	"/port/tdm/e1/channel-group/ppp/keepalive/drop-count": `3`,
+	"/port/tdm/e1/channel-group/ppp/keepalive/interval":   `"10"`,
+	"/port/tdm/e1/channel-group/speed":                    `64`,
+	"/port/tdm/e1/framing":                                `"g704"`,
+	"/port/tdm/e1/hold-time/down":                         `0`,
+	"/port/tdm/e1/hold-time/up":                           `0`,
+	"/port/tdm/e1/national-bits/sa4":                      `false`,
+	"/port/tdm/e1/national-bits/sa5":                      `false`,
+	"/port/tdm/e1/national-bits/sa6":                      `false`,
+	"/port/tdm/e1/national-bits/sa7":                      `false`,
+	"/port/tdm/e1/national-bits/sa8":                      `false`,
+	"/port/tdm/e1/report-alarm/ais":                       `true`,
+	"/port/tdm/e1/report-alarm/ber-sd":                    `false`,
+	"/port/tdm/e1/report-alarm/ber-sf":                    `false`,
+	"/port/tdm/e1/report-alarm/looped":                    `false`,
+	"/port/tdm/e1/report-alarm/los":                       `true`,
+	"/port/tdm/e1/report-alarm/oof":                       `false`,
+	"/port/tdm/e1/report-alarm/rai":                       `false`,
+	"/port/tdm/e3/clock-source":                           `"node-timed"`,
+	"/port/tdm/e3/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/cir": `"100"`,
+	"/port/tdm/e3/egress/port-scheduler-policy/overrides/level/rate-or-percent-rate/percent-rate/percent-rate/pir": `"100"`,
+	"/port/tdm/e3/egress/port-scheduler-policy/overrides/max-rate/rate-or-percent-rate/percent-rate/percent-rate":  `"100"`,
+	"/port/tdm/e3/framing":                      `"g751"`,
+	"/port/tdm/e3/mac-address":                  `"00:00:00:00:00:00"`,
+	"/port/tdm/e3/network/collect-stats":        `false`,
+	"/port/tdm/e3/ppp/keepalive/drop-count":     `3`,
+	"/port/tdm/e3/ppp/keepalive/interval":       `"10"`,
+	"/port/tdm/e3/report-alarm/ais":             `true`,
+	"/port/tdm/e3/report-alarm/looped":          `false`,
+	"/port/tdm/e3/report-alarm/los":             `true`,
+	"/port/tdm/e3/report-alarm/oof":             `false`,
+	"/port/tdm/e3/report-alarm/rai":             `false`,
+	"/port/tdm/hold-time/down":                  `5`,
+	"/port/transceiver/digital-coherent-optics": `false`,
+}
+
+// defaultContainersConfigurePort contains the containers of the port that hold
+// defaulted leafs and can be reported by the device together with their parent.
+// They are only removed before comparison when they hold nothing but defaults,
+// they are never added to the spec. Lists and choices are not part of it, as
+// their presence depends on the intent.
+var defaultContainersConfigurePort = []string{
+	"/port/dwdm/coherent/report-alarm",
+	"/port/dwdm/coherent/sweep",
+	"/port/dwdm/wavetracker/power-control",
+	"/port/dwdm/wavetracker/report-alarm",
+	"/port/ethernet/access/egress/queue-group/aggregate-rate",
+	"/port/ethernet/access/egress/queue-group/queue-overrides/queue/monitor-queue-depth",
+	"/port/ethernet/access/egress/virtual-port/aggregate-rate",
+	"/port/ethernet/access/ingress/queue-group/queue-overrides/queue/monitor-queue-depth",
+	"/port/ethernet/crc-monitor/signal-degrade",
+	"/port/ethernet/crc-monitor/signal-failure",
+	"/port/ethernet/dampening",
+	"/port/ethernet/dot1x/macsec/exclude-protocol",
+	"/port/ethernet/dot1x/macsec/sub-port/encap-match/encap/all-match",
+	"/port/ethernet/dot1x/re-authentication",
+	"/port/ethernet/down-on-internal-error",
+	"/port/ethernet/down-when-looped",
+	"/port/ethernet/efm-oam/discovery/advertise-capabilities",
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame",
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-period",
+	"/port/ethernet/efm-oam/link-monitoring/errored-frame-seconds",
+	"/port/ethernet/efm-oam/link-monitoring/errored-symbols",
+	"/port/ethernet/efm-oam/link-monitoring/local-sf-action/info-notification",
+	"/port/ethernet/egress/hs-secondary-shaper/aggregate",
+	"/port/ethernet/elmi",
+	"/port/ethernet/eth-cfm/mep/ais",
+	"/port/ethernet/eth-cfm/mep/csf",
+	"/port/ethernet/eth-cfm/mep/eth-bn",
+	"/port/ethernet/eth-cfm/mep/eth-test/test-pattern",
+	"/port/ethernet/eth-cfm/mep/grace/eth-ed",
+	"/port/ethernet/eth-cfm/mep/grace/eth-vsm-grace",
+	"/port/ethernet/hold-time",
+	"/port/ethernet/lldp/dest-mac/tx-tlvs",
+	"/port/ethernet/loopback",
+	"/port/ethernet/network/egress/port-queues/overrides/queue/monitor-queue-depth",
+	"/port/ethernet/network/egress/queue-group/aggregate-rate",
+	"/port/ethernet/network/egress/queue-group/queue-overrides/queue/monitor-queue-depth",
+	"/port/ethernet/ssm",
+	"/port/ethernet/symbol-monitor/signal-degrade",
+	"/port/ethernet/symbol-monitor/signal-failure",
+	"/port/gnss/constellation",
+	"/port/hybrid-buffer-allocation/egress-weight",
+	"/port/hybrid-buffer-allocation/ingress-weight",
+	"/port/modify-buffer-allocation/percentage-of-rate",
+	"/port/otu/fine-granularity-ber/signal-degrade/clear",
+	"/port/otu/fine-granularity-ber/signal-degrade/raise",
+	"/port/otu/fine-granularity-ber/signal-failure/clear",
+	"/port/otu/fine-granularity-ber/signal-failure/raise",
+	"/port/otu/report-alarm",
+	"/port/sonet-sdh/hold-time",
+	"/port/sonet-sdh/path/network",
+	"/port/sonet-sdh/path/ppp/keepalive",
+	"/port/sonet-sdh/path/report-alarm",
+	"/port/sonet-sdh/report-alarm",
+	"/port/sonet-sdh/section-trace/section-trace/byte",
+	"/port/tdm/ds1/ber-threshold",
+	"/port/tdm/ds1/channel-group/network",
+	"/port/tdm/ds1/channel-group/ppp/compress",
+	"/port/tdm/ds1/channel-group/ppp/keepalive",
+	"/port/tdm/ds1/hold-time",
+	"/port/tdm/ds1/report-alarm",
+	"/port/tdm/ds3/maintenance-data-link/transmit-message-type",
+	"/port/tdm/ds3/network",
+	"/port/tdm/ds3/ppp/keepalive",
+	"/port/tdm/ds3/report-alarm",
+	"/port/tdm/e1/ber-threshold",
+	"/port/tdm/e1/channel-group/network",
+	"/port/tdm/e1/channel-group/ppp/compress",
+	"/port/tdm/e1/channel-group/ppp/keepalive",
+	"/port/tdm/e1/hold-time",
+	"/port/tdm/e1/national-bits",
+	"/port/tdm/e1/report-alarm",
+	"/port/tdm/e3/network",
+	"/port/tdm/e3/ppp/keepalive",
+	"/port/tdm/e3/report-alarm",
+	"/port/tdm/hold-time",
+	"/port/transceiver",
+}
+
+var defaulterConfigurePort = newDefaulter(defaultsConfigurePort, defaultContainersConfigurePort)
+
+// defaultConfigurePort sets the yang defaults in the containers that are
+// present in the json data of a port
+func defaultConfigurePort(x interface{}) interface{} {
+	return defaulterConfigurePort.apply(x)
+}
+
+// normalizeConfigurePort defaults the json data of a port and removes the
+// containers that only hold defaults, it is used for the spec and the data of
+// the device such that both are normalized the same way before they are
+// compared
+func normalizeConfigurePort(x interface{}) interface{} {
+	return defaulterConfigurePort.normalize(x)
+}
+
+// validateConfigurePort returns the paths of all leafs in the data that violate
+// the constraints of the yang model
+func validateConfigurePort(p *parser.Parser, x1 interface{}) []constraintViolation {
+	// the port is a list entry, by adding it to a list the port-id is reported
+	// in the path of the violations
+	x, err := p.AddJSONDataToList(x1)
+	if err != nil {
+		return []constraintViolation{{detail: err.Error()}}
+	}
+	return validateConstraints(x, constraintsConfigurePort, resourceRefPathsConfigurePort)
+}
+
+// getRootPathConfigurePort returns the root path of the resource, the port is
+// keyed by its port-id such that every resource owns exactly one port
+func getRootPathConfigurePort(o *srosv1alpha1.SrosConfigurePort) ([]*gnmi.Path, error) {
+	p := o.Spec.ForNetworkNode.SrosConfigurePort
+	if p == nil || p.PortId == nil || *p.PortId == "" {
+		return nil, errors.New(errPortIdMissing)
+	}
+	return []*gnmi.Path{
+		{
 			Elem: []*gnmi.PathElem{
 				{Name: "configure"},
-				{Name: "port", Key: map[string]string{"port-id": ""}},
+				{Name: "port", Key: map[string]string{"port-id": *p.PortId}},
 			},
 		},
-	},
+	}, nil
 }
 
 // SetupConfigurePort adds a controller that reconciles ConfigurePorts.
-func SetupConfigurePort(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, namespace string) (string, chan cevent.GenericEvent, error) {
+func SetupConfigurePort(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, namespace string, pool *clientpool.Pool) (string, chan cevent.GenericEvent, error) {
 
 	name := managed.ControllerName(srosv1alpha1.ConfigurePortGroupKind)
 
 	events := make(chan cevent.GenericEvent)
 
+	v := &validatorConfigurePort{log: l, parser: *parser.NewParser(parser.WithLogger(l))}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(srosv1alpha1.ConfigurePortGroupVersionKind),
 		managed.WithExternalConnecter(&connectorConfigurePort{
 			log:         l,
 			kube:        mgr.GetClient(),
+			namespace:   namespace,
+			pool:        pool,
 			usage:       resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
+			validator:   v,
+			states:      newStateCache(defaultStateTTL),
 			newClientFn: target.NewTarget},
 		),
 		managed.WithParser(l),
-		managed.WithValidator(&validatorConfigurePort{log: l, parser: *parser.NewParser(parser.WithLogger(l))}),
+		managed.WithValidator(v),
 		managed.WithLogger(l.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
@@ -7765,6 +5612,21 @@ type validatorConfigurePort struct {
 	parser parser.Parser
 }
 
+// ValidateValues validates the leaf values against the constraints of the
+// yang model, such that invalid values are reported before anything is sent
+// to the device
+func (v *validatorConfigurePort) ValidateValues(ctx context.Context, mg resource.Managed) ([]string, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigurePort)
+	if !ok {
+		return nil, errors.New(errUnexpectedConfigurePort)
+	}
+	x1, err := getSpecData(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return nil, err
+	}
+	return constraintViolationMessages(validateConfigurePort(&v.parser, x1)), nil
+}
+
 func (v *validatorConfigurePort) ValidateLocalleafRef(ctx context.Context, mg resource.Managed) (managed.ValidateLocalleafRefObservation, error) {
 	log := v.log.WithValues("resource", mg.GetName())
 	log.Debug("ValidateLocalleafRef...")
@@ -7846,8 +5708,37 @@ func (v *validatorConfigurePort) ValidateParentDependency(ctx context.Context, m
 	log := v.log.WithValues("resource", mg.GetName())
 	log.Debug("ValidateParentDependency...")
 
+	o, ok := mg.(*srosv1alpha1.SrosConfigurePort)
+	if !ok {
+		return managed.ValidateParentDependencyObservation{}, errors.New(errUnexpectedConfigurePort)
+	}
+	portId := getPortId(o)
+
+	// json unmarshal the external data
+	var x2 interface{}
+	json.Unmarshal(cfg, &x2)
+
 	// we initialize a global list for finer information on the resolution
 	resultleafRefValidation := make([]*parser.ResolvedLeafRefGnmi, 0)
+	for _, dep := range getParentDependenciesConfigurePort(portId) {
+		success, result, err := v.parser.ValidateParentDependencyGnmi(x2, dep.value, []*parser.LeafRefGnmi{dep.leafRef}, log)
+		if err != nil {
+			return managed.ValidateParentDependencyObservation{
+				Success: false,
+			}, nil
+		}
+		resultleafRefValidation = append(resultleafRefValidation, result...)
+		if !success {
+			msg := fmt.Sprintf("%s of port %s does not exist: %s", dep.kind, portId,
+				*v.parser.GnmiPathToXPath(result[0].RemotePath, true))
+			log.Debug("ValidateParentDependency failed", "resultParentValidation", resultleafRefValidation)
+			o.SetConditions(srosv1alpha1.ParentDependencyMissing(msg))
+			return managed.ValidateParentDependencyObservation{
+				Success:          false,
+				ResolvedLeafRefs: resultleafRefValidation}, nil
+		}
+	}
+	o.SetConditions(srosv1alpha1.ParentDependencyAvailable())
 	log.Debug("ValidateParentDependency success", "resultParentValidation", resultleafRefValidation)
 	return managed.ValidateParentDependencyObservation{
 		Success:          true,
@@ -7866,13 +5757,9 @@ func (v *validatorConfigurePort) ValidateResourceIndexes(ctx context.Context, mg
 	}
 	log.Debug("ValidateResourceIndexes", "Spec", o.Spec)
 
-	rootPath := []*gnmi.Path{
-		{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "port"},
-			},
-		},
+	rootPath, err := getRootPathConfigurePort(o)
+	if err != nil {
+		return managed.ValidateResourceIndexesObservation{}, err
 	}
 
 	origResourceIndex := mg.GetResourceIndexes()
@@ -7892,7 +5779,11 @@ func (v *validatorConfigurePort) ValidateResourceIndexes(ctx context.Context, mg
 type connectorConfigurePort struct {
 	log         logging.Logger
 	kube        client.Client
+	namespace   string
+	pool        *clientpool.Pool
 	usage       resource.Tracker
+	validator   valueValidator
+	states      *stateCache
 	newClientFn func(c *gnmitypes.TargetConfig) *target.Target
 	//newClientFn func(ctx context.Context, cfg ndd.Config) (config.ConfigurationClient, error)
 }
@@ -7921,22 +5812,13 @@ func (c *connectorConfigurePort) Connect(ctx context.Context, mg resource.Manage
 	if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
 		return nil, errors.New(targetNotConfigured)
 	}
-	cfg := &gnmitypes.TargetConfig{
-		Name:       nn.GetName(),
-		Address:    ndrv1.PrefixService + "-" + nn.Name + "." + ndrv1.NamespaceLocalK8sDNS + strconv.Itoa(*nn.Spec.GrpcServerPort),
-		Username:   utils.StringPtr("admin"),
-		Password:   utils.StringPtr("admin"),
-		Timeout:    10 * time.Second,
-		SkipVerify: utils.BoolPtr(true),
-		Insecure:   utils.BoolPtr(true),
-		TLSCA:      utils.StringPtr(""), //TODO TLS
-		TLSCert:    utils.StringPtr(""), //TODO TLS
-		TLSKey:     utils.StringPtr(""),
-		Gzip:       utils.BoolPtr(false),
+	cfg, err := getResourceTargetConfig(ctx, c.kube, nn, c.namespace, o)
+	if err != nil {
+		return nil, err
 	}
 
-	cl := target.NewTarget(cfg)
-	if err := cl.CreateGNMIClient(ctx); err != nil {
+	cl, err := c.pool.Get(ctx, cfg)
+	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
@@ -7945,7 +5827,7 @@ func (c *connectorConfigurePort) Connect(ctx context.Context, mg resource.Manage
 	tns := make([]string, 0)
 	tns = append(tns, nn.GetName())
 
-	return &externalConfigurePort{client: cl, targets: tns, log: log, parser: *parser.NewParser(parser.WithLogger(log))}, nil
+	return withValueValidation(&externalConfigurePort{client: cl, states: c.states, targets: tns, log: log, parser: *parser.NewParser(parser.WithLogger(log))}, c.validator), nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -7953,6 +5835,7 @@ func (c *connectorConfigurePort) Connect(ctx context.Context, mg resource.Manage
 type externalConfigurePort struct {
 	//client  config.ConfigurationClient
 	client  *target.Target
+	states  *stateCache
 	targets []string
 	log     logging.Logger
 	parser  parser.Parser
@@ -7967,13 +5850,9 @@ func (e *externalConfigurePort) Observe(ctx context.Context, mg resource.Managed
 	log.Debug("Observing ...")
 
 	// rootpath of the resource
-	rootPath := []*gnmi.Path{
-		{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "port"},
-			},
-		},
+	rootPath, err := getRootPathConfigurePort(o)
+	if err != nil {
+		return managed.ExternalObservation{}, err
 	}
 
 	// gvk: group, version, kind, name, namespace of the resource
@@ -8047,6 +5926,17 @@ func (e *externalConfigurePort) Observe(ctx context.Context, mg resource.Managed
 	hids := make([]string, 0)
 	x1 = e.parser.RemoveLeafsFromJSONData(x1, hids)
 
+	// the device reports leafs with their default value, the spec is normalized
+	// the same way to avoid a difference for leafs that are not in the spec
+	x1 = normalizeConfigurePort(x1)
+
+	// the resource is a list entry keyed by port-id, for lists with keys we need to
+	// create a list before calulating the paths such that the key ends up in the path
+	x1, err = e.parser.AddJSONDataToList(x1)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errWrongInputdata)
+	}
+
 	// validate gnmi resp information
 	var x2 interface{}
 	if len(resp.GetNotification()) != 0 {
@@ -8057,6 +5947,7 @@ func (e *externalConfigurePort) Observe(ctx context.Context, mg resource.Managed
 				log.Debug("Observe response get value issue")
 				return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
 			}
+			x2 = normalizeConfigurePort(x2)
 		}
 	}
 
@@ -8142,9 +6033,18 @@ func (e *externalConfigurePort) Observe(ctx context.Context, mg resource.Managed
 	// Resource Exists
 	switch respMeta.Status {
 	case gext.ResourceStatusSuccess:
+		// the oper state of the port is reported in the status
+		e.observeState(ctx, o)
+
 		if respMeta.HasData {
 			// data is present
 
+			// the response data is a single list entry, for lists with keys we need to
+			// create a list before calulating the paths
+			x2, err = e.parser.AddJSONDataToList(x2)
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errWrongInputdata)
+			}
 			updatesx1 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigurePort)
 			for _, update := range updatesx1 {
 				log.Debug("Observe Fine Grane Updates X1", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
@@ -8216,13 +6116,9 @@ func (e *externalConfigurePort) Create(ctx context.Context, mg resource.Managed)
 	log := e.log.WithValues("Resource", o.GetName())
 	log.Debug("Creating ...")
 
-	rootPath := []*gnmi.Path{
-		{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "port"},
-			},
-		},
+	rootPath, err := getRootPathConfigurePort(o)
+	if err != nil {
+		return managed.ExternalCreation{}, err
 	}
 
 	d, err := json.Marshal(&o.Spec.ForNetworkNode)
@@ -8242,6 +6138,13 @@ func (e *externalConfigurePort) Create(ctx context.Context, mg resource.Managed)
 	hids := make([]string, 0)
 	x1 = e.parser.RemoveLeafsFromJSONData(x1, hids)
 
+	// the resource is a list entry keyed by port-id, for lists with keys we need to
+	// create a list before calulating the paths such that the key ends up in the path
+	x1, err = e.parser.AddJSONDataToList(x1)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errWrongInputdata)
+	}
+
 	updates := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigurePort)
 	for _, update := range updates {
 		log.Debug("Create Fine Grane Updates", "Path", update.Path, "Value", update.GetVal())
@@ -8353,13 +6256,11 @@ func (e *externalConfigurePort) Delete(ctx context.Context, mg resource.Managed)
 	log := e.log.WithValues("Resource", o.GetName())
 	log.Debug("Deleting ...")
 
-	rootPath := []*gnmi.Path{
-		{
-			Elem: []*gnmi.PathElem{
-				{Name: "configure"},
-				{Name: "port"},
-			},
-		},
+	rootPath, err := getRootPathConfigurePort(o)
+	if err != nil {
+		// without port-id the resource was never created on the device
+		log.Debug("Delete without port-id", "error", err)
+		return nil
 	}
 
 	gvk := &gvk.GVK{
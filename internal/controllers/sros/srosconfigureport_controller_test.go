@@ -0,0 +1,210 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	gnmitypes "github.com/karimra/gnmic/types"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+// newTestClient returns a gnmi client that is connected to the fake device
+// driver
+func newTestClient(t *testing.T, dd *gnmitest.DeviceDriver) *target.Target {
+	t.Helper()
+	cl, err := clientpool.New(clientpool.WithDialOptions(dd.Dialer())).Get(context.Background(), &gnmitypes.TargetConfig{
+		Name:       "sr1",
+		Address:    "sr1:57400",
+		Username:   utils.StringPtr("admin"),
+		Password:   utils.StringPtr("admin"),
+		Timeout:    10 * time.Second,
+		Insecure:   utils.BoolPtr(true),
+		SkipVerify: utils.BoolPtr(false),
+		TLSCA:      utils.StringPtr(""),
+		TLSCert:    utils.StringPtr(""),
+		TLSKey:     utils.StringPtr(""),
+		Gzip:       utils.BoolPtr(false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cl
+}
+
+func testConfigurePort(name, portId, description string) *srosv1alpha1.SrosConfigurePort {
+	o := &srosv1alpha1.SrosConfigurePort{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+	o.SetGroupVersionKind(srosv1alpha1.ConfigurePortGroupVersionKind)
+	o.Spec.ForNetworkNode.SrosConfigurePort = &srosv1alpha1.ConfigurePort{
+		PortId:      utils.StringPtr(portId),
+		AdminState:  utils.StringPtr("enable"),
+		Description: utils.StringPtr(description),
+	}
+	return o
+}
+
+// portPaths returns the configured paths of and below the port with the
+// port-id
+func portPaths(paths []string, portId string) []string {
+	port := "/configure/port[port-id=" + portId + "]"
+	l := make([]string, 0)
+	for _, p := range paths {
+		if p == port || strings.HasPrefix(p, port+"/") {
+			l = append(l, p)
+		}
+	}
+	return l
+}
+
+// TestConfigurePortTwoPortsOneNode creates, re-indexes and deletes two ports
+// of the same network node and checks that the operations on one port leave
+// the configuration of the other port intact
+func TestConfigurePortTwoPortsOneNode(t *testing.T) {
+	ctx := context.Background()
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	log := logging.NewNopLogger()
+	e := &externalConfigurePort{client: newTestClient(t, dd), targets: []string{"sr1"}, log: log, parser: *parser.NewParser()}
+	v := &validatorConfigurePort{log: log, parser: *parser.NewParser()}
+
+	a := testConfigurePort("port-a", "1/1/1", "port a")
+	b := testConfigurePort("port-b", "1/1/2", "port b")
+	for _, o := range []*srosv1alpha1.SrosConfigurePort{a, b} {
+		obs, err := v.ValidateResourceIndexes(ctx, o)
+		if err != nil {
+			t.Fatalf("ValidateResourceIndexes(%s): %v", o.GetName(), err)
+		}
+		o.SetResourceIndexes(obs.ResourceIndexes)
+		if _, err := e.Create(ctx, o); err != nil {
+			t.Fatalf("Create(%s): %v", o.GetName(), err)
+		}
+	}
+	paths := dd.Paths()
+	if len(portPaths(paths, "1/1/1")) == 0 || len(portPaths(paths, "1/1/2")) == 0 {
+		t.Fatalf("both ports must be configured after create, got %v", paths)
+	}
+	if n := len(portPaths(paths, "1/1/1")) + len(portPaths(paths, "1/1/2")); n != len(paths) {
+		t.Fatalf("the ports configure paths outside of their port, got %v", paths)
+	}
+	wantB := portPaths(paths, "1/1/2")
+
+	// changing the port-id of port a deletes the old port and creates the new
+	a.Spec.ForNetworkNode.SrosConfigurePort.PortId = utils.StringPtr("1/1/3")
+	obs, err := v.ValidateResourceIndexes(ctx, a)
+	if err != nil {
+		t.Fatalf("ValidateResourceIndexes(): %v", err)
+	}
+	if !obs.Changed {
+		t.Fatal("ValidateResourceIndexes(): a changed port-id must be reported")
+	}
+	if _, err := e.Update(ctx, a, managed.ExternalObservation{ResourceDeletes: obs.ResourceDeletes}); err != nil {
+		t.Fatalf("Update(): %v", err)
+	}
+	a.SetResourceIndexes(obs.ResourceIndexes)
+	if _, err := e.Create(ctx, a); err != nil {
+		t.Fatalf("Create(): %v", err)
+	}
+	paths = dd.Paths()
+	if got := portPaths(paths, "1/1/1"); len(got) != 0 {
+		t.Errorf("the old port-id of port a is still configured: %v", got)
+	}
+	if len(portPaths(paths, "1/1/3")) == 0 {
+		t.Errorf("the new port-id of port a is not configured: %v", paths)
+	}
+	if got := portPaths(paths, "1/1/2"); strings.Join(got, ",") != strings.Join(wantB, ",") {
+		t.Errorf("re-indexing port a changed port b: got %v, want %v", got, wantB)
+	}
+
+	// deleting port a leaves port b intact
+	if err := e.Delete(ctx, a); err != nil {
+		t.Fatalf("Delete(): %v", err)
+	}
+	paths = dd.Paths()
+	if got := portPaths(paths, "1/1/3"); len(got) != 0 {
+		t.Errorf("port a is still configured after delete: %v", got)
+	}
+	if strings.Join(paths, ",") != strings.Join(wantB, ",") {
+		t.Errorf("deleting port a changed port b: got %v, want %v", paths, wantB)
+	}
+}
+
+// TestValidateParentDependencyConfigurePort checks that a missing parent is
+// reported in the parent dependency condition and not as an error, which makes
+// the reconciler report a reconcile error
+func TestValidateParentDependencyConfigurePort(t *testing.T) {
+	v := &validatorConfigurePort{log: logging.NewNopLogger(), parser: *parser.NewParser()}
+	cfg := `{"configure":{"card":[{"slot-number":1,"mda":[{"mda-slot":1}]}],"port":[{"port-id":"1/1/c1","connector":{"breakout":"c1-100g"}}]}}`
+	cases := map[string]struct {
+		portId  string
+		success bool
+		errMsg  string
+	}{
+		"Mda": {
+			portId:  "1/1/1",
+			success: true,
+		},
+		"Connector": {
+			portId:  "1/1/c1/1",
+			success: true,
+		},
+		"CardMissing": {
+			portId: "2/1/1",
+			errMsg: "card of port 2/1/1 does not exist",
+		},
+		"MdaMissing": {
+			portId: "1/2/1",
+			errMsg: "mda of port 1/2/1 does not exist",
+		},
+		"ConnectorMissing": {
+			portId: "1/1/c2/1",
+			errMsg: "connector of port 1/1/c2/1 does not exist",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := testConfigurePort("port", tc.portId, "")
+			obs, err := v.ValidateParentDependency(context.Background(), o, []byte(cfg))
+			if err != nil {
+				t.Fatalf("ValidateParentDependency(): %v", err)
+			}
+			if obs.Success != tc.success {
+				t.Errorf("ValidateParentDependency(): success %t, want %t", obs.Success, tc.success)
+			}
+			c := o.GetCondition(srosv1alpha1.ConditionKindParentDependency)
+			if tc.success && c.Status != corev1.ConditionTrue {
+				t.Errorf("ValidateParentDependency(): got condition %s: %s", c.Status, c.Message)
+			}
+			if !tc.success && (c.Status != corev1.ConditionFalse || !strings.Contains(c.Message, tc.errMsg)) {
+				t.Errorf("ValidateParentDependency(): got condition %s: %s, want %q", c.Status, c.Message, tc.errMsg)
+			}
+		})
+	}
+}
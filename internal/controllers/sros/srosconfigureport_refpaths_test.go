@@ -0,0 +1,328 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-yang/pkg/parser"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// schemaNode is a container or list of a resource, derived from the api types
+// which are generated from the SR OS yang
+type schemaNode struct {
+	path []string
+	list bool
+	typ  reflect.Type
+}
+
+// schemaNodes returns the containers and lists below the type
+func schemaNodes(t reflect.Type, path []string) []schemaNode {
+	nodes := make([]schemaNode, 0)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		ft, list := elemType(f.Type)
+		if ft.Kind() != reflect.Struct {
+			continue
+		}
+		p := append(append([]string{}, path...), jsonName(f))
+		nodes = append(nodes, schemaNode{path: p, list: list, typ: ft})
+		nodes = append(nodes, schemaNodes(ft, p)...)
+	}
+	return nodes
+}
+
+func elemType(t reflect.Type) (reflect.Type, bool) {
+	list := false
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		if t.Kind() == reflect.Slice {
+			list = true
+		}
+		t = t.Elem()
+	}
+	return t, list
+}
+
+func jsonName(f reflect.StructField) string {
+	return strings.Split(f.Tag.Get("json"), ",")[0]
+}
+
+// leafFieldType returns the type of the leaf with the json name in the struct
+func leafFieldType(t reflect.Type, name string) (reflect.Type, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == name {
+			ft, list := elemType(t.Field(i).Type)
+			if list || ft.Kind() == reflect.Struct {
+				return nil, false
+			}
+			return ft, true
+		}
+	}
+	return nil, false
+}
+
+// refPathKeys returns the keys of the reference paths by the path of their
+// element without keys
+func refPathKeys(t *testing.T, refPaths []*gnmi.Path) map[string]string {
+	t.Helper()
+	keys := make(map[string]string)
+	for _, p := range refPaths {
+		names := make([]string, 0, len(p.GetElem()))
+		for _, elem := range p.GetElem() {
+			names = append(names, elem.GetName())
+			if len(elem.GetKey()) > 1 {
+				t.Fatalf("reference path %v: only single keys are expected", p)
+			}
+			for k := range elem.GetKey() {
+				keys[strings.Join(names, "/")] = k
+			}
+		}
+	}
+	return keys
+}
+
+// TestResourceRefPathsConfigurePort checks that the reference paths contain
+// every container and list of the port subtree, that every list is keyed by a
+// leaf of its entries and that there are no paths outside of the port subtree
+func TestResourceRefPathsConfigurePort(t *testing.T) {
+	want := map[string]bool{"port": true}
+	for _, n := range schemaNodes(reflect.TypeOf(srosv1alpha1.ConfigurePort{}), []string{"port"}) {
+		want[strings.Join(n.path, "/")] = n.list
+	}
+
+	got := make(map[string]struct{})
+	for _, p := range resourceRefPathsConfigurePort {
+		names := make([]string, 0, len(p.GetElem()))
+		for _, elem := range p.GetElem() {
+			names = append(names, elem.GetName())
+		}
+		got[strings.Join(names, "/")] = struct{}{}
+	}
+	for p := range want {
+		if _, ok := got[p]; !ok {
+			t.Errorf("no reference path for %s", p)
+		}
+	}
+	for p := range got {
+		if _, ok := want[p]; !ok {
+			t.Errorf("reference path %s is not part of the port subtree", p)
+		}
+	}
+
+	keys := refPathKeys(t, resourceRefPathsConfigurePort)
+	for _, n := range schemaNodes(reflect.TypeOf(srosv1alpha1.ConfigurePort{}), []string{"port"}) {
+		p := strings.Join(n.path, "/")
+		key, ok := keys[p]
+		switch {
+		case n.list && !ok:
+			t.Errorf("list %s has no key", p)
+		case !n.list && ok:
+			t.Errorf("container %s has key %s", p, key)
+		case n.list:
+			if _, ok := leafFieldType(n.typ, key); !ok {
+				t.Errorf("key %s is not a leaf of list %s", key, p)
+			}
+		}
+	}
+}
+
+// keyValue returns the nth key value of the key leaf type
+func keyValue(t reflect.Type, n int) interface{} {
+	switch t.Kind() {
+	case reflect.String:
+		return fmt.Sprintf("k%d", n)
+	default:
+		return n
+	}
+}
+
+// listEntries returns the port data with two entries in the list, the
+// parent lists have a single entry, and the xpaths of the two entries
+func listEntries(t *testing.T, list schemaNode, keys map[string]string) (map[string]interface{}, []string) {
+	t.Helper()
+	port := map[string]interface{}{"port-id": "1/1/1"}
+	cur := port
+	typ := reflect.TypeOf(srosv1alpha1.ConfigurePort{})
+	xpath := "/configure/port[port-id=1/1/1]"
+	for i := 1; i < len(list.path); i++ {
+		name := list.path[i]
+		var field reflect.StructField
+		for j := 0; j < typ.NumField(); j++ {
+			if jsonName(typ.Field(j)) == name {
+				field = typ.Field(j)
+			}
+		}
+		ft, isList := elemType(field.Type)
+		typ = ft
+		if !isList {
+			next := map[string]interface{}{}
+			cur[name] = next
+			cur = next
+			xpath += "/" + name
+			continue
+		}
+		key := keys[strings.Join(list.path[:i+1], "/")]
+		kt, _ := leafFieldType(ft, key)
+		if i < len(list.path)-1 {
+			next := map[string]interface{}{key: keyValue(kt, 0)}
+			cur[name] = []interface{}{next}
+			cur = next
+			xpath += fmt.Sprintf("/%s[%s=%v]", name, key, keyValue(kt, 0))
+			continue
+		}
+		entries := make([]interface{}, 0, 2)
+		xpaths := make([]string, 0, 2)
+		for n := 1; n <= 2; n++ {
+			entries = append(entries, map[string]interface{}{key: keyValue(kt, n)})
+			xpaths = append(xpaths, fmt.Sprintf("%s/%s[%s=%v]", xpath, name, key, keyValue(kt, n)))
+		}
+		cur[name] = entries
+		return port, xpaths
+	}
+	t.Fatalf("%v is not a list", list.path)
+	return nil, nil
+}
+
+// createUpdates creates the port on the fake device driver and returns the
+// updates of the set request by xpath
+func createUpdates(t *testing.T, port map[string]interface{}) map[string]*gnmi.TypedValue {
+	t.Helper()
+	d, err := json.Marshal(map[string]interface{}{"port": port})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := testConfigurePort("port", "1/1/1", "")
+	o.Spec.ForNetworkNode = srosv1alpha1.ConfigurePortParameters{}
+	dec := json.NewDecoder(bytes.NewReader(d))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&o.Spec.ForNetworkNode); err != nil {
+		t.Fatalf("invalid port data %s: %v", string(d), err)
+	}
+
+	dd := gnmitest.NewDeviceDriver(t, map[string]interface{}{})
+	e := &externalConfigurePort{client: newTestClient(t, dd), targets: []string{"sr1"}, log: logging.NewNopLogger(), parser: *parser.NewParser()}
+	if _, err := e.Create(context.Background(), o); err != nil {
+		t.Fatalf("Create(): %v", err)
+	}
+	req := <-dd.Sets
+	updates := make(map[string]*gnmi.TypedValue)
+	for _, u := range req.GetReplace() {
+		updates[gnmitest.XPath(u.GetPath())] = u.GetVal()
+	}
+	return updates
+}
+
+// TestCreateConfigurePortListEntries checks that every list of the port
+// results in an update per list entry
+func TestCreateConfigurePortListEntries(t *testing.T) {
+	keys := refPathKeys(t, resourceRefPathsConfigurePort)
+	for _, n := range schemaNodes(reflect.TypeOf(srosv1alpha1.ConfigurePort{}), []string{"port"}) {
+		if !n.list {
+			continue
+		}
+		n := n
+		t.Run(strings.Join(n.path[1:], "/"), func(t *testing.T) {
+			port, xpaths := listEntries(t, n, keys)
+			updates := createUpdates(t, port)
+			for _, xpath := range xpaths {
+				if _, ok := updates[xpath]; !ok {
+					paths := make([]string, 0, len(updates))
+					for p := range updates {
+						paths = append(paths, p)
+					}
+					sort.Strings(paths)
+					t.Errorf("no update for list entry %s, updates %v", xpath, paths)
+				}
+			}
+		})
+	}
+}
+
+// TestCreateConfigurePortGolden compares the updates of the sample port with
+// the golden file, run the test with -update to regenerate it
+func TestCreateConfigurePortGolden(t *testing.T) {
+	d, err := ioutil.ReadFile(filepath.Join("testdata", "configureport.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var port map[string]interface{}
+	if err := json.Unmarshal(d, &port); err != nil {
+		t.Fatal(err)
+	}
+
+	updates := createUpdates(t, port)
+	paths := make([]string, 0, len(updates))
+	for p := range updates {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	var b bytes.Buffer
+	for _, p := range paths {
+		fmt.Fprintf(&b, "%s %s\n", p, typedValueString(updates[p]))
+	}
+
+	golden := filepath.Join("testdata", "configureport_updates.golden")
+	if *update {
+		if err := ioutil.WriteFile(golden, b.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b.Bytes(), want) {
+		t.Errorf("updates differ from %s, got:\n%s", golden, b.String())
+	}
+}
+
+// typedValueString returns the value of an update in a stable notation, json
+// values are compacted
+func typedValueString(v *gnmi.TypedValue) string {
+	var d []byte
+	switch x := v.GetValue().(type) {
+	case *gnmi.TypedValue_JsonVal:
+		d = x.JsonVal
+	case *gnmi.TypedValue_JsonIetfVal:
+		d = x.JsonIetfVal
+	default:
+		return v.String()
+	}
+	var i interface{}
+	if err := json.Unmarshal(d, &i); err != nil {
+		return string(d)
+	}
+	d, _ = json.Marshal(i)
+	return string(d)
+}
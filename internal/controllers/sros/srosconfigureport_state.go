@@ -0,0 +1,122 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"sort"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+const (
+	// Errors
+	errReadStateConfigurePort = "cannot read state of ConfigurePort"
+)
+
+// observeState reads the state of the port from the network node into the
+// status of the ConfigurePort.
+func (e *externalConfigurePort) observeState(ctx context.Context, o *srosv1alpha1.SrosConfigurePort) {
+	log := e.log.WithValues("Resource", o.GetName())
+	portId := getPortId(o)
+	if portId == "" {
+		return
+	}
+
+	x, err := e.states.get(ctx, e.client, &e.parser, &gnmi.Path{
+		Elem: []*gnmi.PathElem{
+			{Name: "state"},
+			{Name: "port", Key: map[string]string{"port-id": portId}},
+		},
+	})
+	if err != nil {
+		log.Debug(errReadStateConfigurePort, "error", err)
+		return
+	}
+	if x == nil {
+		log.Debug(errReadStateConfigurePort, "error", "no state data")
+		return
+	}
+	o.Status.AtNetworkNode = getObservationConfigurePort(x)
+}
+
+// getObservationConfigurePort returns the observation of a port from its state
+// data, which is either the port or the port list of the state tree
+func getObservationConfigurePort(x interface{}) srosv1alpha1.ConfigurePortObservation {
+	if p := stateValue(x, "port"); p != nil {
+		x = p
+	}
+	if l, ok := x.([]interface{}); ok {
+		if len(l) == 0 {
+			return srosv1alpha1.ConfigurePortObservation{}
+		}
+		x = l[0]
+	}
+
+	obs := srosv1alpha1.ConfigurePortObservation{
+		OperState:      stateString(x, "oper-state"),
+		OperSpeed:      stateUint32(x, "ethernet", "oper-speed"),
+		OperDuplex:     stateString(x, "ethernet", "oper-duplex"),
+		OperMtu:        stateUint32(x, "ethernet", "oper-mtu"),
+		LastOperChange: stateString(x, "last-oper-change"),
+	}
+	if t := stateValue(x, "transceiver"); t != nil {
+		obs.Transceiver = &srosv1alpha1.ConfigurePortObservationTransceiver{
+			Type: stateString(t, "type"),
+		}
+		if ddm := stateValue(t, "digital-diagnostic-monitoring"); ddm != nil {
+			obs.Transceiver.DigitalDiagnosticMonitoring = getDdmObservation(ddm)
+		}
+	}
+	return obs
+}
+
+// getDdmObservation returns the current digital diagnostic monitoring readings
+// of a transceiver, the readings of a transceiver without lanes are reported as
+// lane 1
+func getDdmObservation(x interface{}) *srosv1alpha1.ConfigurePortObservationTransceiverDdm {
+	ddm := &srosv1alpha1.ConfigurePortObservationTransceiverDdm{
+		Temperature:   stateString(x, "temperature", "current"),
+		SupplyVoltage: stateString(x, "supply-voltage", "current"),
+		Lane:          make([]*srosv1alpha1.ConfigurePortObservationTransceiverDdmLane, 0),
+	}
+	lanes, _ := stateValue(x, "lane").([]interface{})
+	for _, l := range lanes {
+		ddm.Lane = append(ddm.Lane, getDdmLaneObservation(l, stateUint32(l, "lane-id")))
+	}
+	if len(ddm.Lane) == 0 {
+		lane := uint32(1)
+		if l := getDdmLaneObservation(x, &lane); l.TransmitBiasCurrent != nil || l.TransmitOutputPower != nil || l.ReceiveOpticalPower != nil {
+			ddm.Lane = append(ddm.Lane, l)
+		}
+	}
+	sort.SliceStable(ddm.Lane, func(i, j int) bool {
+		return uint32Value(ddm.Lane[i].LaneId) < uint32Value(ddm.Lane[j].LaneId)
+	})
+	return ddm
+}
+
+func getDdmLaneObservation(x interface{}, laneId *uint32) *srosv1alpha1.ConfigurePortObservationTransceiverDdmLane {
+	return &srosv1alpha1.ConfigurePortObservationTransceiverDdmLane{
+		LaneId:              laneId,
+		TransmitBiasCurrent: stateString(x, "transmit-bias-current", "current"),
+		TransmitOutputPower: stateString(x, "transmit-output-power", "current"),
+		ReceiveOpticalPower: stateString(x, "receive-optical-power", "current"),
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"github.com/yndd/ndd-yang/pkg/parser"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+// toJSON returns the json encoding of the observation for the test output
+func toJSON(t *testing.T, v interface{}) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestGetObservationConfigurePort(t *testing.T) {
+	cases := map[string]struct {
+		state string
+		want  srosv1alpha1.ConfigurePortObservation
+	}{
+		"Empty": {
+			state: `{"port":[]}`,
+			want:  srosv1alpha1.ConfigurePortObservation{},
+		},
+		"PortWithoutTransceiver": {
+			state: `{"port":[{"port-id":"1/1/1","oper-state":"up","last-oper-change":"2021-10-01T10:00:00.0Z","ethernet":{"oper-speed":100000,"oper-duplex":"full","oper-mtu":"9212"}}]}`,
+			want: srosv1alpha1.ConfigurePortObservation{
+				OperState:      utils.StringPtr("up"),
+				OperSpeed:      utils.Uint32Ptr(100000),
+				OperDuplex:     utils.StringPtr("full"),
+				OperMtu:        utils.Uint32Ptr(9212),
+				LastOperChange: utils.StringPtr("2021-10-01T10:00:00.0Z"),
+			},
+		},
+		// json ietf encoded state carries module prefixes, decimal64 values
+		// are numbers or strings
+		"ModulePrefixes": {
+			state: `{"nokia-state:port":{"port-id":"1/1/1","oper-state":"down","transceiver":{"type":"qsfp28","digital-diagnostic-monitoring":{"temperature":{"current":"35.5"},"supply-voltage":{"current":3.3},"transmit-bias-current":{"current":"6.2"}}}}}`,
+			want: srosv1alpha1.ConfigurePortObservation{
+				OperState: utils.StringPtr("down"),
+				Transceiver: &srosv1alpha1.ConfigurePortObservationTransceiver{
+					Type: utils.StringPtr("qsfp28"),
+					DigitalDiagnosticMonitoring: &srosv1alpha1.ConfigurePortObservationTransceiverDdm{
+						Temperature:   utils.StringPtr("35.5"),
+						SupplyVoltage: utils.StringPtr("3.3"),
+						Lane: []*srosv1alpha1.ConfigurePortObservationTransceiverDdmLane{
+							{LaneId: utils.Uint32Ptr(1), TransmitBiasCurrent: utils.StringPtr("6.2")},
+						},
+					},
+				},
+			},
+		},
+		"TransceiverLanesAreSorted": {
+			state: `{"port-id":"1/1/c1","oper-state":"up","transceiver":{"type":"qsfp-dd","digital-diagnostic-monitoring":{"lane":[{"lane-id":2,"receive-optical-power":{"current":"-2.1"}},{"lane-id":1,"receive-optical-power":{"current":"-1.9"}}]}}}`,
+			want: srosv1alpha1.ConfigurePortObservation{
+				OperState: utils.StringPtr("up"),
+				Transceiver: &srosv1alpha1.ConfigurePortObservationTransceiver{
+					Type: utils.StringPtr("qsfp-dd"),
+					DigitalDiagnosticMonitoring: &srosv1alpha1.ConfigurePortObservationTransceiverDdm{
+						Lane: []*srosv1alpha1.ConfigurePortObservationTransceiverDdmLane{
+							{LaneId: utils.Uint32Ptr(1), ReceiveOpticalPower: utils.StringPtr("-1.9")},
+							{LaneId: utils.Uint32Ptr(2), ReceiveOpticalPower: utils.StringPtr("-2.1")},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := getObservationConfigurePort(unmarshalTestData(t, tc.state)); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("getObservationConfigurePort(): got %s, want %s", toJSON(t, got), toJSON(t, tc.want))
+			}
+		})
+	}
+}
+
+// TestObserveStateConfigurePort reads the state of the port from the device
+// driver, when the state cannot be read the last observation is kept
+func TestObserveStateConfigurePort(t *testing.T) {
+	ctx := context.Background()
+	dd := gnmitest.NewDeviceDriver(t, unmarshalTestData(t, `{"port":[{"port-id":"1/1/1","oper-state":"up"}]}`))
+	e := &externalConfigurePort{client: newTestClient(t, dd), log: logging.NewNopLogger(), parser: *parser.NewParser()}
+
+	o := testConfigurePort("port-1", "1/1/1", "a")
+	e.observeState(ctx, o)
+	if s := o.Status.AtNetworkNode.OperState; s == nil || *s != "up" {
+		t.Fatalf("observeState(): oper-state %v, want up", s)
+	}
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	e.observeState(cctx, o)
+	if s := o.Status.AtNetworkNode.OperState; s == nil || *s != "up" {
+		t.Errorf("observeState(): the last observation must be kept when the state cannot be read, got %v", s)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+const (
+	// paths on which the webhooks are served
+	mutatePathConfigurePort   = "/mutate-sros-ndd-yndd-io-v1alpha1-srosconfigureport"
+	validatePathConfigurePort = "/validate-sros-ndd-yndd-io-v1alpha1-srosconfigureport"
+)
+
+// +kubebuilder:webhook:path=/mutate-sros-ndd-yndd-io-v1alpha1-srosconfigureport,mutating=true,failurePolicy=fail,sideEffects=None,groups=sros.ndd.yndd.io,resources=srosconfigureports,verbs=create;update,versions=v1alpha1,name=msrosconfigureport.sros.ndd.yndd.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-sros-ndd-yndd-io-v1alpha1-srosconfigureport,mutating=false,failurePolicy=fail,sideEffects=None,groups=sros.ndd.yndd.io,resources=srosconfigureports,verbs=create;update,versions=v1alpha1,name=vsrosconfigureport.sros.ndd.yndd.io,admissionReviewVersions=v1
+
+// SetupConfigurePortWebhook registers the defaulting and validating webhooks of
+// ConfigurePorts with the webhook server of the manager.
+func SetupConfigurePortWebhook(mgr ctrl.Manager, l logging.Logger) error {
+	mgr.GetWebhookServer().Register(mutatePathConfigurePort, &webhook.Admission{
+		Handler: &defaultingWebhookConfigurePort{
+			log: l.WithValues("webhook", mutatePathConfigurePort),
+		},
+	})
+	mgr.GetWebhookServer().Register(validatePathConfigurePort, &webhook.Admission{
+		Handler: &validatingWebhookConfigurePort{
+			log:    l.WithValues("webhook", validatePathConfigurePort),
+			parser: *parser.NewParser(parser.WithLogger(l)),
+		},
+	})
+	return nil
+}
+
+// defaultingWebhookConfigurePort sets the yang defaults in the spec of
+// ConfigurePorts, such that the stored spec matches the config of the device
+type defaultingWebhookConfigurePort struct {
+	log     logging.Logger
+	decoder *admission.Decoder
+}
+
+// InjectDecoder injects the decoder of the webhook server.
+func (w *defaultingWebhookConfigurePort) InjectDecoder(d *admission.Decoder) error {
+	w.decoder = d
+	return nil
+}
+
+// Handle sets the yang defaults in the spec of a ConfigurePort with the same
+// defaulting that is used when the spec is compared with the device.
+func (w *defaultingWebhookConfigurePort) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := w.log.WithValues("resource", req.Name, "operation", req.Operation)
+	log.Debug("Default...")
+
+	o := &srosv1alpha1.SrosConfigurePort{}
+	if err := w.decoder.Decode(req, o); err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecodeAdmissionRequest))
+	}
+
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, errors.Wrap(err, errJSONMarshal))
+	}
+	var x1 interface{}
+	if err := json.Unmarshal(d, &x1); err != nil {
+		return admission.Errored(http.StatusInternalServerError, errors.Wrap(err, errJSONUnMarshal))
+	}
+	if d, err = json.Marshal(defaultConfigurePort(x1)); err != nil {
+		return admission.Errored(http.StatusInternalServerError, errors.Wrap(err, errJSONMarshal))
+	}
+	if err := json.Unmarshal(d, &o.Spec.ForNetworkNode); err != nil {
+		return admission.Errored(http.StatusInternalServerError, errors.Wrap(err, errJSONUnMarshal))
+	}
+
+	d, err = json.Marshal(o)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, errors.Wrap(err, errJSONMarshal))
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, d)
+}
+
+// validatingWebhookConfigurePort rejects ConfigurePorts that would fail the
+// local validation during reconciliation
+type validatingWebhookConfigurePort struct {
+	log     logging.Logger
+	parser  parser.Parser
+	decoder *admission.Decoder
+}
+
+// InjectDecoder injects the decoder of the webhook server.
+func (w *validatingWebhookConfigurePort) InjectDecoder(d *admission.Decoder) error {
+	w.decoder = d
+	return nil
+}
+
+// Handle validates the port-id, the values of the leafs and the local
+// leafrefs of a ConfigurePort, on update the port-id cannot change.
+func (w *validatingWebhookConfigurePort) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := w.log.WithValues("resource", req.Name, "operation", req.Operation)
+	log.Debug("Validate...")
+
+	o := &srosv1alpha1.SrosConfigurePort{}
+	old := &srosv1alpha1.SrosConfigurePort{}
+	if resp := decodeRequest(w.decoder, req, o, old); resp != nil {
+		return *resp
+	}
+
+	errs := field.ErrorList{}
+	portIdPath := specPath.Child("port", "port-id")
+	portId := getPortId(o)
+	if portId == "" {
+		errs = append(errs, field.Required(portIdPath, errPortIdMissing))
+	}
+	if req.Operation == admissionv1.Update {
+		if oldPortId := getPortId(old); oldPortId != "" && oldPortId != portId {
+			errs = append(errs, field.Forbidden(portIdPath, "port-id is immutable, it changed from "+oldPortId+" to "+portId))
+		}
+	}
+
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, errors.Wrap(err, errJSONMarshal))
+	}
+	var x1 interface{}
+	json.Unmarshal(d, &x1)
+
+	errs = append(errs, constraintViolationsToFieldErrors(validateConfigurePort(&w.parser, x1))...)
+
+	// For local leafref validation we dont need to supply the external data so we use nil
+	_, resultleafRefValidation, err := w.parser.ValidateLeafRefGnmi(
+		parser.LeafRefValidationLocal, x1, nil, localleafRefConfigurePort, log)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	for _, r := range resultleafRefValidation {
+		if !r.Resolved {
+			errs = append(errs, field.Invalid(gnmiPathToField(specPath, r.LocalPath), r.Value,
+				"leafref does not resolve to "+*w.parser.GnmiPathToXPath(r.RemotePath, true)))
+		}
+	}
+
+	if len(errs) != 0 {
+		log.Debug("Validate failed", "errors", errs.ToAggregate().Error())
+		return denied(srosv1alpha1.ConfigurePortKind, req.Name, errs)
+	}
+	return admission.Allowed("")
+}
+
+// getPortId returns the port-id of the ConfigurePort or an empty string when
+// it is not set
+func getPortId(o *srosv1alpha1.SrosConfigurePort) string {
+	p := o.Spec.ForNetworkNode.SrosConfigurePort
+	if p == nil || p.PortId == nil {
+		return ""
+	}
+	return *p.PortId
+}
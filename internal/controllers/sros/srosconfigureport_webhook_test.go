@@ -0,0 +1,104 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+func newTestDecoder(t *testing.T) *admission.Decoder {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := srosv1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	d, err := admission.NewDecoder(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func rawObject(t *testing.T, o runtime.Object) runtime.RawExtension {
+	t.Helper()
+	d, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return runtime.RawExtension{Raw: d}
+}
+
+func TestValidatingWebhookConfigurePortPortId(t *testing.T) {
+	cases := map[string]struct {
+		op      admissionv1.Operation
+		o       *srosv1alpha1.SrosConfigurePort
+		old     *srosv1alpha1.SrosConfigurePort
+		allowed bool
+	}{
+		"Create": {
+			op:      admissionv1.Create,
+			o:       testConfigurePort("port-a", "1/1/1", "port a"),
+			allowed: true,
+		},
+		"CreateWithoutPortId": {
+			op: admissionv1.Create,
+			o: func() *srosv1alpha1.SrosConfigurePort {
+				o := testConfigurePort("port-a", "1/1/1", "port a")
+				o.Spec.ForNetworkNode.SrosConfigurePort.PortId = nil
+				return o
+			}(),
+		},
+		"UpdateSamePortId": {
+			op:      admissionv1.Update,
+			o:       testConfigurePort("port-a", "1/1/1", "new description"),
+			old:     testConfigurePort("port-a", "1/1/1", "port a"),
+			allowed: true,
+		},
+		"UpdateChangedPortId": {
+			op:  admissionv1.Update,
+			o:   testConfigurePort("port-a", "1/1/2", "port a"),
+			old: testConfigurePort("port-a", "1/1/1", "port a"),
+		},
+	}
+	w := &validatingWebhookConfigurePort{log: logging.NewNopLogger(), parser: *parser.NewParser(), decoder: newTestDecoder(t)}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: tc.op,
+				Name:      tc.o.GetName(),
+				Object:    rawObject(t, tc.o),
+			}}
+			if tc.old != nil {
+				req.OldObject = rawObject(t, tc.old)
+			}
+			resp := w.Handle(context.Background(), req)
+			if resp.Allowed != tc.allowed {
+				t.Errorf("Handle(): allowed %t, want %t: %v", resp.Allowed, tc.allowed, resp.Result)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+func TestGetPortsReferencingPortPolicy(t *testing.T) {
+	cases := map[string]struct {
+		config string
+		want   []string
+	}{
+		"NoPorts": {
+			config: `{"configure":{}}`,
+			want:   []string{},
+		},
+		"ConnectorReferences": {
+			config: `{"configure":{"port":[
+				{"port-id":"1/1/c2","connector":{"breakout":"c4-10g","port-policy":"pp1"}},
+				{"port-id":"1/1/c1","connector":{"port-policy":"pp1"}},
+				{"port-id":"1/1/c3","connector":{"port-policy":"pp2"}},
+				{"port-id":"1/1/c4","connector":{"breakout":"c1-100g"}}
+			]}}`,
+			want: []string{"1/1/c1", "1/1/c2"},
+		},
+		"ModulePrefixes": {
+			config: `{"nokia-conf:configure":{"nokia-conf:port":[{"port-id":"1/1/c1","connector":{"port-policy":"pp1"}}]}}`,
+			want:   []string{"1/1/c1"},
+		},
+		// only the leafref of the connector references the port-policy, leafs
+		// with the same name in other containers do not
+		"OtherLeafsWithTheName": {
+			config: `{"configure":{"port":[
+				{"port-id":"1/1/1","description":"pp1","ethernet":{"port-policy":"pp1"}},
+				{"port-id":"1/1/2","port-policy":"pp1"}
+			]}}`,
+			want: []string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := getPortsReferencingPortPolicy(unmarshalTestData(t, tc.config), "pp1"); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("getPortsReferencingPortPolicy(): got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// testConfigurePortWithPortPolicy returns a ConfigurePort on the network node
+// whose connector references the port-policy
+func testConfigurePortWithPortPolicy(name, nn, portId, portPolicy string) *srosv1alpha1.SrosConfigurePort {
+	o := testConfigurePort(name, portId, name)
+	o.Spec.NetworkNodeReference = &nddv1.Reference{Name: nn}
+	o.Spec.ForNetworkNode.SrosConfigurePort.Connector = &srosv1alpha1.ConfigurePortConnector{PortPolicy: utils.StringPtr(portPolicy)}
+	return o
+}
+
+// TestDeleteConfigurePortPolicyInUse deletes a port-policy that a port in the
+// config of the device or a ConfigurePort of the network node references, the
+// port-policy is not deleted on the device
+func TestDeleteConfigurePortPolicyInUse(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := srosv1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	deleted := testConfigurePortWithPortPolicy("port-d", "sr1", "1/1/c4", "pp1")
+	deleted.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	deleted.SetFinalizers([]string{srosv1alpha1.ConfigurePortFinalizer})
+
+	cases := map[string]struct {
+		config  string
+		objs    []client.Object
+		portIds []string
+	}{
+		"DeviceConfig": {
+			config:  `{"configure":{"port":[{"port-id":"1/1/c1","connector":{"port-policy":"pp1"}}]}}`,
+			portIds: []string{"1/1/c1"},
+		},
+		// a ConfigurePort that is not yet configured on the device also
+		// blocks the delete
+		"ConfigurePort": {
+			config:  `{"configure":{"port":[{"port-id":"1/1/c1","connector":{"port-policy":"pp2"}}]}}`,
+			objs:    []client.Object{testConfigurePortWithPortPolicy("port-b", "sr1", "1/1/c2", "pp1")},
+			portIds: []string{"1/1/c2"},
+		},
+		"DeviceConfigAndConfigurePort": {
+			config:  `{"configure":{"port":[{"port-id":"1/1/c1","connector":{"port-policy":"pp1"}}]}}`,
+			objs:    []client.Object{testConfigurePortWithPortPolicy("port-a", "sr1", "1/1/c1", "pp1")},
+			portIds: []string{"1/1/c1"},
+		},
+		"NotInUse": {
+			config: `{"configure":{"port":[{"port-id":"1/1/c1","connector":{"port-policy":"pp2"}}]}}`,
+			objs: []client.Object{
+				testConfigurePortWithPortPolicy("port-b", "sr1", "1/1/c2", "pp2"),
+				testConfigurePortWithPortPolicy("port-c", "sr2", "1/1/c3", "pp1"),
+				deleted,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			o := &srosv1alpha1.SrosConfigurePortPolicy{ObjectMeta: metav1.ObjectMeta{Name: "pp1"}}
+			o.SetGroupVersionKind(srosv1alpha1.ConfigurePortPolicyGroupVersionKind)
+			o.Spec.NetworkNodeReference = &nddv1.Reference{Name: "sr1"}
+			o.Spec.ForNetworkNode.SrosConfigurePortPolicy = &srosv1alpha1.ConfigurePortPolicy{Name: utils.StringPtr("pp1")}
+
+			dd := gnmitest.NewDeviceDriver(t, unmarshalTestData(t, tc.config))
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.objs...).Build()
+			e := &externalConfigurePortPolicy{client: newTestClient(t, dd), kube: kube, log: logging.NewNopLogger(), parser: *parser.NewParser()}
+			err := e.Delete(ctx, o)
+			if len(tc.portIds) == 0 {
+				if err != nil {
+					t.Fatalf("Delete(): %v", err)
+				}
+				if len(dd.Sets) != 1 {
+					t.Errorf("Delete(): set requests %d, want 1", len(dd.Sets))
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), errPortPolicyInUse+": "+strings.Join(tc.portIds, ", ")) {
+				t.Fatalf("Delete(): a port-policy in use by %v must not be deleted, got %v", tc.portIds, err)
+			}
+			if len(dd.Sets) != 0 {
+				t.Error("Delete(): a set request is sent for a port-policy in use")
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+func testConfigurePortXc(name, nn string, created time.Time) *srosv1alpha1.SrosConfigurePortXc {
+	o := &srosv1alpha1.SrosConfigurePortXc{
+		ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+	}
+	o.SetGroupVersionKind(srosv1alpha1.ConfigurePortXcGroupVersionKind)
+	o.Spec.NetworkNodeReference = &nddv1.Reference{Name: nn}
+	o.Spec.ForNetworkNode.SrosConfigurePortXc = &srosv1alpha1.ConfigurePortXc{}
+	return o
+}
+
+// TestConnectConfigurePortXcOwner connects the ConfigurePortXcs of a network
+// node, only the oldest one owns the port-xc of the network node
+func TestConnectConfigurePortXcOwner(t *testing.T) {
+	ctx := context.Background()
+	s := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, ndrv1.AddToScheme, srosv1alpha1.AddToScheme} {
+		if err := add(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now().Truncate(time.Second)
+	sr1 := testNetworkNode("sr1", "", "")
+	sr1.SetConditions(ndrv1.Configured())
+	xcA := testConfigurePortXc("xc-a", "sr1", now)
+	xcB := testConfigurePortXc("xc-b", "sr1", now.Add(time.Minute))
+	xcC := testConfigurePortXc("xc-c", "sr1", now)
+	xcD := testConfigurePortXc("xc-d", "sr2", now.Add(-time.Minute))
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(sr1, xcA, xcB, xcC, xcD).Build()
+
+	for o, want := range map[*srosv1alpha1.SrosConfigurePortXc]string{xcA: "xc-a", xcB: "xc-a", xcC: "xc-a", xcD: "xc-d"} {
+		owner, err := getOwnerConfigurePortXc(ctx, kube, o)
+		if err != nil {
+			t.Fatalf("getOwnerConfigurePortXc(%s): %v", o.GetName(), err)
+		}
+		if owner.GetName() != want {
+			t.Errorf("getOwnerConfigurePortXc(%s): got %s, want %s", o.GetName(), owner.GetName(), want)
+		}
+	}
+
+	c := &connectorConfigurePortXc{
+		log:   logging.NewNopLogger(),
+		kube:  kube,
+		usage: resource.TrackerFn(func(context.Context, resource.Managed) error { return nil }),
+	}
+	// a second ConfigurePortXc of the network node is connected to a client
+	// that reports the owner and leaves the device alone
+	e, err := c.Connect(ctx, xcB)
+	if err != nil {
+		t.Fatalf("Connect(): %v", err)
+	}
+	got, err := e.Observe(ctx, xcB)
+	if err != nil {
+		t.Fatalf("Observe(): %v", err)
+	}
+	if got.Ready || got.ResourceExists {
+		t.Errorf("Observe(): got %+v, want a resource that is not ready", got)
+	}
+	if cond := xcB.GetCondition(srosv1alpha1.ConditionKindPortXcOwner); cond.Status != corev1.ConditionFalse || !strings.Contains(cond.Message, "xc-a") {
+		t.Errorf("Observe(): got owner condition %s: %s, want %s with the owner xc-a", cond.Status, cond.Message, corev1.ConditionFalse)
+	}
+	// deleting it removes the finalizer without deleting the port-xc of the owner
+	xcB.SetDeletionTimestamp(&metav1.Time{Time: now})
+	if got, err := e.Observe(ctx, xcB); err != nil || got.ResourceExists {
+		t.Errorf("Observe(): a deleted ConfigurePortXc that is not the owner must not exist, got %+v, %v", got, err)
+	}
+
+	// the owner passes the check, it fails on the missing credentials
+	if _, err := c.Connect(ctx, xcA); err == nil {
+		t.Error("Connect(): the owner must be connected to the device")
+	}
+	if cond := xcA.GetCondition(srosv1alpha1.ConditionKindPortXcOwner); cond.Status != corev1.ConditionTrue {
+		t.Errorf("Connect(): got owner condition %s, want %s", cond.Status, corev1.ConditionTrue)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+const (
+	// path on which the validating webhook is served
+	validatePathConfigurePortXc = "/validate-sros-ndd-yndd-io-v1alpha1-srosconfigureportxc"
+)
+
+// +kubebuilder:webhook:path=/validate-sros-ndd-yndd-io-v1alpha1-srosconfigureportxc,mutating=false,failurePolicy=fail,sideEffects=None,groups=sros.ndd.yndd.io,resources=srosconfigureportxcs,verbs=create;update,versions=v1alpha1,name=vsrosconfigureportxc.sros.ndd.yndd.io,admissionReviewVersions=v1
+
+// SetupConfigurePortXcWebhook registers the validating webhook of
+// ConfigurePortXcs with the webhook server of the manager.
+func SetupConfigurePortXcWebhook(mgr ctrl.Manager, l logging.Logger) error {
+	mgr.GetWebhookServer().Register(validatePathConfigurePortXc, &webhook.Admission{
+		Handler: &validatingWebhookConfigurePortXc{
+			log:  l.WithValues("webhook", validatePathConfigurePortXc),
+			kube: mgr.GetClient(),
+		},
+	})
+	return nil
+}
+
+// validatingWebhookConfigurePortXc rejects a second ConfigurePortXc of a
+// network node, the port-xc container is owned by a single ConfigurePortXc
+type validatingWebhookConfigurePortXc struct {
+	log     logging.Logger
+	kube    client.Client
+	decoder *admission.Decoder
+}
+
+// InjectDecoder injects the decoder of the webhook server.
+func (w *validatingWebhookConfigurePortXc) InjectDecoder(d *admission.Decoder) error {
+	w.decoder = d
+	return nil
+}
+
+// Handle rejects a ConfigurePortXc that is created for, or moved to, a network
+// node that has another ConfigurePortXc. A ConfigurePortXc that is being
+// deleted does not block a new one, the new one takes over the port-xc once the
+// deleted one is gone.
+func (w *validatingWebhookConfigurePortXc) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := w.log.WithValues("resource", req.Name, "operation", req.Operation)
+	log.Debug("Validate...")
+
+	o := &srosv1alpha1.SrosConfigurePortXc{}
+	old := &srosv1alpha1.SrosConfigurePortXc{}
+	if resp := decodeRequest(w.decoder, req, o, old); resp != nil {
+		return *resp
+	}
+
+	nnPath := field.NewPath("spec", "networkNodeRef", "name")
+	nn := getNetworkNodeName(o)
+	if req.Operation == admissionv1.Update && getNetworkNodeName(old) == nn {
+		return admission.Allowed("")
+	}
+
+	l := &srosv1alpha1.SrosConfigurePortXcList{}
+	if err := w.kube.List(ctx, l); err != nil {
+		return admission.Errored(http.StatusInternalServerError, errors.Wrap(err, errListConfigurePortXc))
+	}
+	errs := field.ErrorList{}
+	for i := range l.Items {
+		x := &l.Items[i]
+		if x.GetName() == o.GetName() || meta.WasDeleted(x) || getNetworkNodeName(x) != nn {
+			continue
+		}
+		errs = append(errs, field.Forbidden(nnPath, errOwnedConfigurePortXc+" "+x.GetName()))
+	}
+
+	if len(errs) != 0 {
+		log.Debug("Validate failed", "errors", errs.ToAggregate().Error())
+		return denied(srosv1alpha1.ConfigurePortXcKind, req.Name, errs)
+	}
+	return admission.Allowed("")
+}
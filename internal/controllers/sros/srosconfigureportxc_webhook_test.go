@@ -0,0 +1,100 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+func TestValidatingWebhookConfigurePortXc(t *testing.T) {
+	s := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, ndrv1.AddToScheme, srosv1alpha1.AddToScheme} {
+		if err := add(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now().Truncate(time.Second)
+	xcA := testConfigurePortXc("xc-a", "sr1", now)
+	xcD := testConfigurePortXc("xc-d", "sr2", now)
+	xcD.SetDeletionTimestamp(&metav1.Time{Time: now})
+	xcD.SetFinalizers([]string{srosv1alpha1.ConfigurePortXcFinalizer})
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(xcA, xcD).Build()
+
+	cases := map[string]struct {
+		op      admissionv1.Operation
+		o       *srosv1alpha1.SrosConfigurePortXc
+		old     *srosv1alpha1.SrosConfigurePortXc
+		allowed bool
+	}{
+		"CreateOtherNetworkNode": {
+			op:      admissionv1.Create,
+			o:       testConfigurePortXc("xc-b", "sr3", now),
+			allowed: true,
+		},
+		"CreateSecond": {
+			op: admissionv1.Create,
+			o:  testConfigurePortXc("xc-b", "sr1", now),
+		},
+		// the new ConfigurePortXc takes over once the deleted one is gone
+		"CreateSecondOfDeleted": {
+			op:      admissionv1.Create,
+			o:       testConfigurePortXc("xc-b", "sr2", now),
+			allowed: true,
+		},
+		"UpdateOwner": {
+			op:      admissionv1.Update,
+			o:       testConfigurePortXc("xc-a", "sr1", now),
+			old:     testConfigurePortXc("xc-a", "sr1", now),
+			allowed: true,
+		},
+		"UpdateMovedToOwnedNetworkNode": {
+			op:  admissionv1.Update,
+			o:   testConfigurePortXc("xc-b", "sr1", now),
+			old: testConfigurePortXc("xc-b", "sr3", now),
+		},
+	}
+	w := &validatingWebhookConfigurePortXc{log: logging.NewNopLogger(), kube: kube, decoder: newTestDecoder(t)}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: tc.op,
+				Name:      tc.o.GetName(),
+				Object:    rawObject(t, tc.o),
+			}}
+			if tc.old != nil {
+				req.OldObject = rawObject(t, tc.old)
+			}
+			resp := w.Handle(context.Background(), req)
+			if resp.Allowed != tc.allowed {
+				t.Errorf("Handle(): allowed %t, want %t: %v", resp.Allowed, tc.allowed, resp.Result)
+			}
+		})
+	}
+}
@@ -0,0 +1,1165 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	gnmitypes "github.com/karimra/gnmic/types"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/event"
+	"github.com/yndd/ndd-runtime/pkg/gext"
+	"github.com/yndd/ndd-runtime/pkg/gvk"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	cevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
+)
+
+const (
+	// Errors
+	errUnexpectedConfigureRouterBgp        = "the managed resource is not a ConfigureRouterBgp resource"
+	errKubeUpdateFailedConfigureRouterBgp  = "cannot update ConfigureRouterBgp"
+	errReadConfigureRouterBgp              = "cannot read ConfigureRouterBgp"
+	errCreateConfigureRouterBgp            = "cannot create ConfigureRouterBgp"
+	erreUpdateConfigureRouterBgp           = "cannot update ConfigureRouterBgp"
+	errDeleteConfigureRouterBgp            = "cannot delete ConfigureRouterBgp"
+	errRouterNameMissingConfigureRouterBgp = "router-name is mandatory for a ConfigureRouterBgp"
+
+	// resource information
+	levelConfigureRouterBgp = 3
+)
+
+// resourceRefPathsConfigureRouterBgp splits the bgp instance in the updates
+// that are sent to the device, every group and neighbor is a list entry with
+// its own path such that a group or neighbor is added, changed or deleted
+// without replacing the bgp instance
+var resourceRefPathsConfigureRouterBgp = []*gnmi.Path{
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "export"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "group", Key: map[string]string{"group-name": ""}},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "group", Key: map[string]string{"group-name": ""}},
+			{Name: "export"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "group", Key: map[string]string{"group-name": ""}},
+			{Name: "family"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "group", Key: map[string]string{"group-name": ""}},
+			{Name: "import"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "import"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "neighbor", Key: map[string]string{"ip-address": ""}},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "neighbor", Key: map[string]string{"ip-address": ""}},
+			{Name: "export"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "neighbor", Key: map[string]string{"ip-address": ""}},
+			{Name: "family"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "bgp"},
+			{Name: "neighbor", Key: map[string]string{"ip-address": ""}},
+			{Name: "import"},
+		},
+	},
+}
+
+var localleafRefConfigureRouterBgp = []*parser.LeafRefGnmi{
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "neighbor", Key: map[string]string{"ip-address": ""}},
+				{Name: "group"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "group", Key: map[string]string{"group-name": ""}},
+			},
+		},
+	},
+}
+var externalLeafRefConfigureRouterBgp = []*parser.LeafRefGnmi{
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "apply-groups"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "apply-groups-exclude"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "export"},
+				{Name: "policy", Key: map[string]string{"policy": ""}},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "policy-options"},
+				{Name: "policy-statement", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "import"},
+				{Name: "policy", Key: map[string]string{"policy": ""}},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "policy-options"},
+				{Name: "policy-statement", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "group", Key: map[string]string{"group-name": ""}},
+				{Name: "export"},
+				{Name: "policy", Key: map[string]string{"policy": ""}},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "policy-options"},
+				{Name: "policy-statement", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "group", Key: map[string]string{"group-name": ""}},
+				{Name: "import"},
+				{Name: "policy", Key: map[string]string{"policy": ""}},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "policy-options"},
+				{Name: "policy-statement", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "neighbor", Key: map[string]string{"ip-address": ""}},
+				{Name: "export"},
+				{Name: "policy", Key: map[string]string{"policy": ""}},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "policy-options"},
+				{Name: "policy-statement", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "bgp"},
+				{Name: "neighbor", Key: map[string]string{"ip-address": ""}},
+				{Name: "import"},
+				{Name: "policy", Key: map[string]string{"policy": ""}},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "policy-options"},
+				{Name: "policy-statement", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+}
+
+// constraintsConfigureRouterBgp contains the range, length, pattern and enum
+// constraints of the leafs of the bgp instance, indexed by schema path
+var constraintsConfigureRouterBgp = map[string]leafConstraint{
+	"/bgp/connect-retry": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 65535}}},
+	},
+	"/bgp/group/group-name": {
+		{kind: leafKindString, lengths: []valueRange{{1, 64}}},
+	},
+	"/bgp/group/peer-as": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 4294967295}}},
+	},
+	"/bgp/group/type": {
+		{kind: leafKindEnum, enums: []string{"external", "internal"}},
+	},
+	"/bgp/keepalive": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 21845}}},
+	},
+	"/bgp/neighbor/group": {
+		{kind: leafKindString, lengths: []valueRange{{1, 64}}},
+	},
+	"/bgp/neighbor/ip-address": {
+		{kind: leafKindString, patterns: []string{`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])`}},
+		{kind: leafKindString, patterns: []string{`((:|[0-9a-fA-F]{0,4}):)([0-9a-fA-F]{0,4}:){0,5}((([0-9a-fA-F]{0,4}:)?(:|[0-9a-fA-F]{0,4}))|(((25[0-5]|2[0-4][0-9]|[01]?[0-9]?[0-9])\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9]?[0-9])))`}},
+	},
+	"/bgp/neighbor/peer-as": {
+		{kind: leafKindInteger, ranges: []valueRange{{1, 4294967295}}},
+	},
+	"/bgp/neighbor/type": {
+		{kind: leafKindEnum, enums: []string{"external", "internal"}},
+	},
+	"/bgp/router-id": {
+		{kind: leafKindString, patterns: []string{`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])`}},
+	},
+}
+
+// defaultsConfigureRouterBgp contains the yang defaults of the leafs of the bgp
+// instance as json values, indexed by schema path
+var defaultsConfigureRouterBgp = map[string]string{
+	"/bgp/connect-retry": `120`,
+	"/bgp/keepalive":     `30`,
+}
+
+var defaulterConfigureRouterBgp = newDefaulter(defaultsConfigureRouterBgp, []string{})
+
+// leafListsConfigureRouterBgp are the leaf-lists of a bgp instance, the import
+// and export policies of the instance, its groups and its neighbors
+var leafListsConfigureRouterBgp = newLeafLists(
+	"/bgp/export/policy",
+	"/bgp/import/policy",
+	"/bgp/group/export/policy",
+	"/bgp/group/import/policy",
+	"/bgp/neighbor/export/policy",
+	"/bgp/neighbor/import/policy",
+)
+
+// defaultConfigureRouterBgp sets the yang defaults in the json data of a bgp
+// instance, it is used for the spec and the data of the device such that both
+// are normalized the same way before they are compared
+func defaultConfigureRouterBgp(x interface{}) interface{} {
+	return defaulterConfigureRouterBgp.apply(x)
+}
+
+// validateConfigureRouterBgp returns the paths of all leafs in the data that
+// violate the constraints of the yang model
+func validateConfigureRouterBgp(p *parser.Parser, x1 interface{}) []constraintViolation {
+	// the router-name is part of the root path and not of the data
+	x1 = p.RemoveLeafsFromJSONData(x1, []string{"router-name"})
+	return validateConstraints(x1, constraintsConfigureRouterBgp, resourceRefPathsConfigureRouterBgp)
+}
+
+// getRootPathConfigureRouterBgp returns the root path of the resource, the bgp
+// instance is a container of the router instance such that every resource owns
+// the bgp instance of one router instance
+func getRootPathConfigureRouterBgp(o *srosv1alpha1.SrosConfigureRouterBgp) ([]*gnmi.Path, error) {
+	routerName := getRouterNameConfigureRouterBgp(o)
+	if routerName == "" {
+		return nil, errors.New(errRouterNameMissingConfigureRouterBgp)
+	}
+	return []*gnmi.Path{
+		{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "router", Key: map[string]string{"router-name": routerName}},
+				{Name: "bgp"},
+			},
+		},
+	}, nil
+}
+
+// getRouterNameConfigureRouterBgp returns the router-name of the
+// ConfigureRouterBgp or an empty string when it is not set
+func getRouterNameConfigureRouterBgp(o *srosv1alpha1.SrosConfigureRouterBgp) string {
+	if o.Spec.ForNetworkNode.RouterName == nil {
+		return ""
+	}
+	return *o.Spec.ForNetworkNode.RouterName
+}
+
+// SetupConfigureRouterBgp adds a controller that reconciles ConfigureRouterBgps.
+func SetupConfigureRouterBgp(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, namespace string, pool *clientpool.Pool) (string, chan cevent.GenericEvent, error) {
+
+	name := managed.ControllerName(srosv1alpha1.ConfigureRouterBgpGroupKind)
+
+	events := make(chan cevent.GenericEvent)
+
+	v := &validatorConfigureRouterBgp{log: l, parser: *parser.NewParser(parser.WithLogger(l))}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(srosv1alpha1.ConfigureRouterBgpGroupVersionKind),
+		managed.WithExternalConnecter(&connectorConfigureRouterBgp{
+			log:         l,
+			kube:        mgr.GetClient(),
+			namespace:   namespace,
+			pool:        pool,
+			usage:       resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
+			validator:   v,
+			states:      newStateCache(defaultStateTTL),
+			newClientFn: target.NewTarget},
+		),
+		managed.WithParser(l),
+		managed.WithValidator(v),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return srosv1alpha1.ConfigureRouterBgpGroupKind, events, ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srosv1alpha1.SrosConfigureRouterBgp{}).
+		WithEventFilter(resource.IgnoreUpdateWithoutGenerationChangePredicate()).
+		Watches(
+			&source.Channel{Source: events},
+			&handler.EnqueueRequestForObject{},
+		).
+		//Watches(
+		//	&source.Kind{Type: &ndrv1.NetworkNode{}},
+		//	handler.EnqueueRequestsFromMapFunc(r.NetworkNodeMapFunc),
+		//).
+		Complete(r)
+}
+
+type validatorConfigureRouterBgp struct {
+	log    logging.Logger
+	parser parser.Parser
+}
+
+// ValidateValues validates the leaf values against the constraints of the
+// yang model, such that invalid values are reported before anything is sent
+// to the device
+func (v *validatorConfigureRouterBgp) ValidateValues(ctx context.Context, mg resource.Managed) ([]string, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterBgp)
+	if !ok {
+		return nil, errors.New(errUnexpectedConfigureRouterBgp)
+	}
+	x1, err := getSpecData(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return nil, err
+	}
+	return constraintViolationMessages(validateConfigureRouterBgp(&v.parser, x1)), nil
+}
+
+func (v *validatorConfigureRouterBgp) ValidateLocalleafRef(ctx context.Context, mg resource.Managed) (managed.ValidateLocalleafRefObservation, error) {
+	log := v.log.WithValues("resource", mg.GetName())
+	log.Debug("ValidateLocalleafRef...")
+
+	// json unmarshal the resource
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterBgp)
+	if !ok {
+		return managed.ValidateLocalleafRefObservation{}, errors.New(errUnexpectedConfigureRouterBgp)
+	}
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ValidateLocalleafRefObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+	var x1 interface{}
+	json.Unmarshal(d, &x1)
+
+	// For local leafref validation we dont need to supply the external data so we use nil
+	success, resultleafRefValidation, err := v.parser.ValidateLeafRefGnmi(
+		parser.LeafRefValidationLocal, x1, nil, localleafRefConfigureRouterBgp, log)
+	if err != nil {
+		return managed.ValidateLocalleafRefObservation{
+			Success: false,
+		}, nil
+	}
+	if !success {
+		log.Debug("ValidateLocalleafRef failed", "resultleafRefValidation", resultleafRefValidation)
+		return managed.ValidateLocalleafRefObservation{
+			Success:          false,
+			ResolvedLeafRefs: resultleafRefValidation}, nil
+	}
+	log.Debug("ValidateLocalleafRef success", "resultleafRefValidation", resultleafRefValidation)
+	return managed.ValidateLocalleafRefObservation{
+		Success:          true,
+		ResolvedLeafRefs: resultleafRefValidation}, nil
+}
+
+func (v *validatorConfigureRouterBgp) ValidateExternalleafRef(ctx context.Context, mg resource.Managed, cfg []byte) (managed.ValidateExternalleafRefObservation, error) {
+	log := v.log.WithValues("resource", mg.GetName())
+	log.Debug("ValidateExternalleafRef...")
+
+	// json unmarshal the resource
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterBgp)
+	if !ok {
+		return managed.ValidateExternalleafRefObservation{}, errors.New(errUnexpectedConfigureRouterBgp)
+	}
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ValidateExternalleafRefObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+	var x1 interface{}
+	json.Unmarshal(d, &x1)
+
+	// the import and export policies are leaf-lists, every policy is
+	// validated as an entry of a list keyed by the policy name
+	x1 = leafListsConfigureRouterBgp.key(x1)
+
+	// json unmarshal the external data
+	var x2 interface{}
+	json.Unmarshal(cfg, &x2)
+
+	// For local external leafref validation we need to supply the external
+	// data to validate the remote leafref, we use x2 for this
+	success, resultleafRefValidation, err := v.parser.ValidateLeafRefGnmi(
+		parser.LeafRefValidationExternal, x1, x2, externalLeafRefConfigureRouterBgp, log)
+	if err != nil {
+		return managed.ValidateExternalleafRefObservation{
+			Success: false,
+		}, nil
+	}
+	if !success {
+		log.Debug("ValidateExternalleafRef failed", "resultleafRefValidation", resultleafRefValidation)
+		return managed.ValidateExternalleafRefObservation{
+			Success:          false,
+			ResolvedLeafRefs: resultleafRefValidation}, nil
+	}
+	log.Debug("ValidateExternalleafRef success", "resultleafRefValidation", resultleafRefValidation)
+	return managed.ValidateExternalleafRefObservation{
+		Success:          true,
+		ResolvedLeafRefs: resultleafRefValidation}, nil
+}
+
+func (v *validatorConfigureRouterBgp) ValidateParentDependency(ctx context.Context, mg resource.Managed, cfg []byte) (managed.ValidateParentDependencyObservation, error) {
+	log := v.log.WithValues("resource", mg.GetName())
+	log.Debug("ValidateParentDependency...")
+
+	// the router instance is created with the bgp instance when it does not
+	// exist, the bgp instance has no parent dependencies
+	return managed.ValidateParentDependencyObservation{
+		Success:          true,
+		ResolvedLeafRefs: make([]*parser.ResolvedLeafRefGnmi, 0)}, nil
+}
+
+// ValidateResourceIndexes validates if the indexes of a resource got changed
+// if so we need to delete the original resource, because it will be dangling if we dont delete it
+func (v *validatorConfigureRouterBgp) ValidateResourceIndexes(ctx context.Context, mg resource.Managed) (managed.ValidateResourceIndexesObservation, error) {
+	log := v.log.WithValues("resosurce", mg.GetName())
+
+	// json unmarshal the resource
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterBgp)
+	if !ok {
+		return managed.ValidateResourceIndexesObservation{}, errors.New(errUnexpectedConfigureRouterBgp)
+	}
+	log.Debug("ValidateResourceIndexes", "Spec", o.Spec)
+
+	rootPath, err := getRootPathConfigureRouterBgp(o)
+	if err != nil {
+		return managed.ValidateResourceIndexesObservation{}, err
+	}
+
+	origResourceIndex := mg.GetResourceIndexes()
+	// we call the CompareConfigPathsWithResourceKeys irrespective is the get resource index returns nil
+	changed, deletPaths, newResourceIndex := v.parser.CompareGnmiPathsWithResourceKeys(rootPath[0], origResourceIndex)
+	if changed {
+		log.Debug("ValidateResourceIndexes changed", "deletPaths", deletPaths[0])
+		return managed.ValidateResourceIndexesObservation{Changed: true, ResourceDeletes: deletPaths, ResourceIndexes: newResourceIndex}, nil
+	}
+
+	log.Debug("ValidateResourceIndexes success")
+	return managed.ValidateResourceIndexesObservation{Changed: false, ResourceIndexes: newResourceIndex}, nil
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connectorConfigureRouterBgp struct {
+	log         logging.Logger
+	kube        client.Client
+	namespace   string
+	pool        *clientpool.Pool
+	usage       resource.Tracker
+	validator   valueValidator
+	states      *stateCache
+	newClientFn func(c *gnmitypes.TargetConfig) *target.Target
+	//newClientFn func(ctx context.Context, cfg ndd.Config) (config.ConfigurationClient, error)
+}
+
+// Connect produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a NetworkNode.
+// 2. Getting the managed resource's NetworkNode with connection details
+// A resource is mapped to a single target
+func (c *connectorConfigureRouterBgp) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	log := c.log.WithValues("resource", mg.GetName())
+	log.Debug("Connect")
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterBgp)
+	if !ok {
+		return nil, errors.New(errUnexpectedConfigureRouterBgp)
+	}
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackTCUsage)
+	}
+
+	// find network node that is configured status
+	nn := &ndrv1.NetworkNode{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: o.GetNetworkNodeReference().Name}, nn); err != nil {
+		return nil, errors.Wrap(err, errGetNetworkNode)
+	}
+
+	if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
+		return nil, errors.New(targetNotConfigured)
+	}
+	cfg, err := getResourceTargetConfig(ctx, c.kube, nn, c.namespace, o)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := c.pool.Get(ctx, cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	// we make a string here since we use a trick in registration to go to multiple targets
+	// while here the object is mapped to a single target/network node
+	tns := make([]string, 0)
+	tns = append(tns, nn.GetName())
+
+	return withValueValidation(&externalConfigureRouterBgp{client: cl, states: c.states, targets: tns, log: log, parser: *parser.NewParser(parser.WithLogger(log))}, c.validator), nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type externalConfigureRouterBgp struct {
+	//client  config.ConfigurationClient
+	client  *target.Target
+	states  *stateCache
+	targets []string
+	log     logging.Logger
+	parser  parser.Parser
+}
+
+func (e *externalConfigureRouterBgp) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterBgp)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedConfigureRouterBgp)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Observing ...")
+
+	// rootpath of the resource
+	rootPath, err := getRootPathConfigureRouterBgp(o)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// gvk: group, version, kind, name, namespace of the resource
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// gext: gni extension information for the resource: action, gvk name and level
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionGet,
+		Name:   gvkstring,
+		Level:  levelConfigureRouterBgp,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetGextInfo)
+	}
+
+	// gnmi get request
+	req := &gnmi.GetRequest{
+		Path:     rootPath,
+		Encoding: gnmi.Encoding_JSON,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	// gnmi get response
+	resp, err := e.client.Get(ctx, req)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errReadConfigureRouterBgp)
+	}
+
+	// validate if the extension matches or not
+	if resp.GetExtension()[0].GetRegisteredExt().GetId() != gnmi_ext.ExtensionID_EID_EXPERIMENTAL {
+		log.Debug("Observe response GNMI Extension mismatch", "Extension Info", resp.GetExtension()[0])
+		return managed.ExternalObservation{}, errors.New(errGnmiExtensionMismatch)
+	}
+
+	// get gnmi extension metadata
+	meta := resp.GetExtension()[0].GetRegisteredExt().GetMsg()
+	respMeta := &gext.GEXT{}
+	if err := json.Unmarshal(meta, &respMeta); err != nil {
+		log.Debug("Observe response gext unmarshal issue", "Extension Info", meta)
+		return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+
+	// prepare the input data to compare against the response data
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+	var x1 interface{}
+	if err := json.Unmarshal(d, &x1); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	// remove the hierarchical elements for data processing, comparison, etc
+	// they are used in the provider for parent dependency resolution
+	// but are not relevant in the data, they are referenced in the rootPath
+	// when interacting with the device driver
+	hids := []string{"router-name"}
+	x1 = e.parser.RemoveLeafsFromJSONData(x1, hids)
+
+	// the device reports leafs with their default value, the spec is defaulted
+	// the same way to avoid a difference for leafs that are not in the spec
+	x1 = defaultConfigureRouterBgp(x1)
+
+	// the import and export policies are leaf-lists
+	x1 = leafListsConfigureRouterBgp.mark(x1)
+
+	// validate gnmi resp information
+	var x2 interface{}
+	if len(resp.GetNotification()) != 0 {
+		if len(resp.GetNotification()[0].GetUpdate()) != 0 {
+			// get value from gnmi get response
+			x2, err = e.parser.GetValue(resp.GetNotification()[0].GetUpdate()[0].Val)
+			if err != nil {
+				log.Debug("Observe response get value issue")
+				return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
+			}
+			x2 = leafListsConfigureRouterBgp.mark(defaultConfigureRouterBgp(x2))
+		}
+	}
+
+	// logging information that will be used to provide the response
+	log.Debug("Observer Response", "Meta", string(meta))
+	log.Debug("Spec Data", "X1", x1)
+	log.Debug("Resp Data", "X2", x2)
+
+	// if the cache is not ready we back off and return
+	if !respMeta.CacheReady {
+		log.Debug("Cache Not Ready ...")
+		return managed.ExternalObservation{
+			Ready:            false,
+			ResourceExists:   false,
+			ResourceHasData:  true,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	if !respMeta.Exists {
+		// Resource Does not Exists
+		if respMeta.HasData {
+			// this is an umnaged resource which has data and will be moved to a managed resource
+
+			updatesx1 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigureRouterBgp)
+			for _, update := range updatesx1 {
+				log.Debug("Observe Fine Grane Updates X1", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+			updatesx2 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x2, resourceRefPathsConfigureRouterBgp)
+			for _, update := range updatesx2 {
+				log.Debug("Observe Fine Grane Updates X2", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+
+			deletes, updates, err := e.parser.FindResourceDeltaGnmi(updatesx1, updatesx2, log)
+			if err != nil {
+				return managed.ExternalObservation{}, err
+			}
+			if updates, err = restoreLeafLists(updates); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errJSONUnMarshal)
+			}
+			if len(deletes) != 0 || len(updates) != 0 {
+				// UMR -> MR with data, which is NOT up to date
+				log.Debug("Observing Response: resource NOT up to date", "Exists", false, "HasData", true, "UpToDate", false, "Response", resp, "Updates", updates, "Deletes", deletes)
+				for _, del := range deletes {
+					log.Debug("Observing Response: resource NOT up to date, deletes", "path", e.parser.GnmiPathToXPath(del, true))
+				}
+				for _, upd := range updates {
+					val, _ := e.parser.GetValue(upd.GetVal())
+					log.Debug("Observing Response: resource NOT up to date, updates", "path", e.parser.GnmiPathToXPath(upd.GetPath(), true), "data", val)
+				}
+				return managed.ExternalObservation{
+					Ready:            true,
+					ResourceExists:   false,
+					ResourceHasData:  true,
+					ResourceUpToDate: false,
+					ResourceDeletes:  deletes,
+					ResourceUpdates:  updates,
+				}, nil
+			}
+			// UMR -> MR with data, which is up to date
+			log.Debug("Observing Response: resource up to date", "Exists", false, "HasData", true, "UpToDate", true, "Response", resp)
+			return managed.ExternalObservation{
+				Ready:            true,
+				ResourceExists:   false,
+				ResourceHasData:  true,
+				ResourceUpToDate: true,
+			}, nil
+		}
+		// UMR -> MR without data
+		log.Debug("Observing Response:", "Exists", false, "HasData", false, "UpToDate", false, "Response", resp)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   false,
+			ResourceHasData:  false,
+			ResourceUpToDate: false,
+		}, nil
+
+	}
+	// Resource Exists
+	switch respMeta.Status {
+	case gext.ResourceStatusSuccess:
+		// the session state of the neighbors is reported in the status
+		e.observeState(ctx, o)
+
+		if respMeta.HasData {
+			// data is present
+
+			updatesx1 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigureRouterBgp)
+			for _, update := range updatesx1 {
+				log.Debug("Observe Fine Grane Updates X1", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+			updatesx2 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x2, resourceRefPathsConfigureRouterBgp)
+			for _, update := range updatesx2 {
+				log.Debug("Observe Fine Grane Updates X2", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+
+			deletes, updates, err := e.parser.FindResourceDeltaGnmi(updatesx1, updatesx2, log)
+			if err != nil {
+				return managed.ExternalObservation{}, err
+			}
+			if updates, err = restoreLeafLists(updates); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errJSONUnMarshal)
+			}
+			// MR -> MR, resource is NOT up to date
+			if len(deletes) != 0 || len(updates) != 0 {
+				// resource is NOT up to date
+				log.Debug("Observing Response: resource NOT up to date", "Exists", true, "HasData", true, "UpToDate", false, "Response", resp, "Updates", updates, "Deletes", deletes)
+				for _, del := range deletes {
+					log.Debug("Observing Response: resource NOT up to date, deletes", "path", e.parser.GnmiPathToXPath(del, true))
+				}
+				for _, upd := range updates {
+					val, _ := e.parser.GetValue(upd.GetVal())
+					log.Debug("Observing Response: resource NOT up to date, updates", "path", e.parser.GnmiPathToXPath(upd.GetPath(), true), "data", val)
+				}
+				return managed.ExternalObservation{
+					Ready:            true,
+					ResourceExists:   true,
+					ResourceHasData:  true,
+					ResourceUpToDate: false,
+					ResourceDeletes:  deletes,
+					ResourceUpdates:  updates,
+				}, nil
+			}
+			// MR -> MR, resource is up to date
+			log.Debug("Observing Response: resource up to date", "Exists", true, "HasData", true, "UpToDate", true, "Response", resp)
+			return managed.ExternalObservation{
+				Ready:            true,
+				ResourceExists:   true,
+				ResourceHasData:  true,
+				ResourceUpToDate: true,
+			}, nil
+		}
+		// MR -> MR, resource has no data, strange, someone could have deleted the resource
+		log.Debug("Observing Response", "Exists", true, "HasData", false, "UpToDate", false, "Status", respMeta.Status)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   true,
+			ResourceHasData:  false,
+			ResourceUpToDate: false,
+		}, nil
+
+	default:
+		// MR -> MR, resource is not in a success state, so the object might still be in creation phase
+		log.Debug("Observing Response", "Exists", true, "HasData", false, "UpToDate", false, "Status", respMeta.Status)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   true,
+			ResourceHasData:  false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+}
+
+func (e *externalConfigureRouterBgp) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterBgp)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedConfigureRouterBgp)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Creating ...")
+
+	rootPath, err := getRootPathConfigureRouterBgp(o)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJSONMarshal)
+	}
+
+	var x1 interface{}
+	if err := json.Unmarshal(d, &x1); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	// remove the hierarchical elements for data processing, comparison, etc
+	// they are used in the provider for parent dependency resolution
+	// but are not relevant in the data, they are referenced in the rootPath
+	// when interacting with the device driver
+	hids := []string{"router-name"}
+	x1 = e.parser.RemoveLeafsFromJSONData(x1, hids)
+
+	// the import and export policies are leaf-lists
+	x1 = leafListsConfigureRouterBgp.mark(x1)
+
+	updates, err := restoreLeafLists(e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigureRouterBgp))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJSONUnMarshal)
+	}
+	for _, update := range updates {
+		log.Debug("Create Fine Grane Updates", "Path", update.Path, "Value", update.GetVal())
+	}
+
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	gextInfo := &gext.GEXT{
+		Action:   gext.GEXTActionCreate,
+		Name:     gvkstring,
+		Level:    levelConfigureRouterBgp,
+		RootPath: rootPath[0],
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetGextInfo)
+	}
+
+	if len(updates) == 0 {
+		log.Debug("cannot create object since there are no updates present")
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateObject)
+	}
+
+	req := &gnmi.SetRequest{
+		Replace: updates,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	_, err = e.client.Set(ctx, req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errReadConfigureRouterBgp)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *externalConfigureRouterBgp) Update(ctx context.Context, mg resource.Managed, obs managed.ExternalObservation) (managed.ExternalUpdate, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterBgp)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedConfigureRouterBgp)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Updating ...")
+
+	for _, u := range obs.ResourceUpdates {
+		log.Debug("Update -> Update", "Path", u.Path, "Value", u.GetVal())
+	}
+	for _, d := range obs.ResourceDeletes {
+		log.Debug("Update -> Delete", "Path", d)
+	}
+
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionUpdate,
+		Name:   gvkstring,
+		Level:  levelConfigureRouterBgp,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetGextInfo)
+	}
+
+	req := &gnmi.SetRequest{
+		Update: obs.ResourceUpdates,
+		Delete: obs.ResourceDeletes,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	_, err = e.client.Set(ctx, req)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errReadConfigureRouterBgp)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *externalConfigureRouterBgp) Delete(ctx context.Context, mg resource.Managed) error {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterBgp)
+	if !ok {
+		return errors.New(errUnexpectedConfigureRouterBgp)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Deleting ...")
+
+	rootPath, err := getRootPathConfigureRouterBgp(o)
+	if err != nil {
+		return err
+	}
+
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return err
+	}
+
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionDelete,
+		Name:   gvkstring,
+		Level:  levelConfigureRouterBgp,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return errors.Wrap(err, errGetGextInfo)
+	}
+
+	req := gnmi.SetRequest{
+		Delete: rootPath,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	_, err = e.client.Set(ctx, &req)
+	if err != nil {
+		return errors.Wrap(err, errDeleteConfigureRouterBgp)
+	}
+
+	return nil
+}
+
+func (e *externalConfigureRouterBgp) GetTarget() []string {
+	return e.targets
+}
+
+func (e *externalConfigureRouterBgp) GetConfig(ctx context.Context) ([]byte, error) {
+	e.log.Debug("Get Config ...")
+	req := &gnmi.GetRequest{
+		Path:     []*gnmi.Path{},
+		Encoding: gnmi.Encoding_JSON,
+	}
+
+	resp, err := e.client.Get(ctx, req)
+	if err != nil {
+		return make([]byte, 0), errors.Wrap(err, errGetConfig)
+	}
+
+	if len(resp.GetNotification()) != 0 {
+		if len(resp.GetNotification()[0].GetUpdate()) != 0 {
+			x2, err := e.parser.GetValue(resp.GetNotification()[0].GetUpdate()[0].Val)
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, errGetConfig)
+			}
+
+			data, err := json.Marshal(x2)
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, errJSONMarshal)
+			}
+			return data, nil
+		}
+	}
+	e.log.Debug("Get Config Empty response")
+	return nil, nil
+}
+
+func (e *externalConfigureRouterBgp) GetResourceName(ctx context.Context, path []*gnmi.Path) (string, error) {
+	e.log.Debug("Get ResourceName ...")
+
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionGetResourceName,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return "", errors.Wrap(err, errGetGextInfo)
+	}
+
+	req := &gnmi.GetRequest{
+		Path:     path,
+		Encoding: gnmi.Encoding_JSON,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	resp, err := e.client.Get(ctx, req)
+	if err != nil {
+		return "", errors.Wrap(err, errGetResourceName)
+	}
+
+	x2, err := e.parser.GetValue(resp.GetNotification()[0].GetUpdate()[0].Val)
+	if err != nil {
+		return "", errors.Wrap(err, errJSONMarshal)
+	}
+
+	d, err := json.Marshal(x2)
+	if err != nil {
+		return "", errors.Wrap(err, errJSONMarshal)
+	}
+
+	var resourceName nddv1.ResourceName
+	if err := json.Unmarshal(d, &resourceName); err != nil {
+		return "", errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	e.log.Debug("Get ResourceName Response", "ResourceName", resourceName)
+
+	return resourceName.Name, nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+const (
+	// Errors
+	errReadStateConfigureRouterBgp = "cannot read state of ConfigureRouterBgp"
+)
+
+// observeState reads the session state of the neighbors of the bgp instance
+// from the network node into the status of the ConfigureRouterBgp.
+func (e *externalConfigureRouterBgp) observeState(ctx context.Context, o *srosv1alpha1.SrosConfigureRouterBgp) {
+	log := e.log.WithValues("Resource", o.GetName())
+	routerName := getRouterNameConfigureRouterBgp(o)
+	if routerName == "" {
+		return
+	}
+
+	x, err := e.states.get(ctx, e.client, &e.parser, &gnmi.Path{
+		Elem: []*gnmi.PathElem{
+			{Name: "state"},
+			{Name: "router", Key: map[string]string{"router-name": routerName}},
+			{Name: "bgp"},
+			{Name: "neighbor"},
+		},
+	})
+	if err != nil {
+		log.Debug(errReadStateConfigureRouterBgp, "error", err)
+		return
+	}
+	if x == nil {
+		// without neighbors in the state tree there is no session to report
+		o.Status.AtNetworkNode = srosv1alpha1.ConfigureRouterBgpObservation{}
+		return
+	}
+	o.Status.AtNetworkNode = getObservationConfigureRouterBgp(x)
+}
+
+// getObservationConfigureRouterBgp returns the observation of a bgp instance
+// from the state data of its neighbors, which is either the neighbor list or a
+// container holding it. The neighbors are sorted by ip-address.
+func getObservationConfigureRouterBgp(x interface{}) srosv1alpha1.ConfigureRouterBgpObservation {
+	if n := stateValue(x, "neighbor"); n != nil {
+		x = n
+	}
+	neighbors, ok := x.([]interface{})
+	if !ok {
+		// a single neighbor is not reported as a list
+		neighbors = []interface{}{x}
+	}
+
+	obs := srosv1alpha1.ConfigureRouterBgpObservation{
+		Neighbor: make([]*srosv1alpha1.ConfigureRouterBgpObservationNeighbor, 0, len(neighbors)),
+	}
+	for _, n := range neighbors {
+		ipAddress := stateString(n, "ip-address")
+		if ipAddress == nil {
+			continue
+		}
+		obs.Neighbor = append(obs.Neighbor, &srosv1alpha1.ConfigureRouterBgpObservationNeighbor{
+			IpAddress:    ipAddress,
+			SessionState: stateString(n, "statistics", "session-state"),
+			LastState:    stateString(n, "statistics", "last-state"),
+		})
+	}
+	sort.SliceStable(obs.Neighbor, func(i, j int) bool {
+		return compareIpAddresses(*obs.Neighbor[i].IpAddress, *obs.Neighbor[j].IpAddress) < 0
+	})
+	return obs
+}
+
+// compareIpAddresses compares two ip addresses by their value, ipv4 addresses
+// sort before ipv6 addresses. Values that are no ip address, e.g. an address
+// with a zone, sort after the ip addresses and are compared as strings.
+func compareIpAddresses(a, b string) int {
+	ipa, ipb := net.ParseIP(a), net.ParseIP(b)
+	switch {
+	case ipa == nil && ipb == nil:
+		return strings.Compare(a, b)
+	case ipa == nil:
+		return 1
+	case ipb == nil:
+		return -1
+	}
+	if (ipa.To4() == nil) != (ipb.To4() == nil) {
+		if ipa.To4() != nil {
+			return -1
+		}
+		return 1
+	}
+	return bytes.Compare(ipa.To16(), ipb.To16())
+}
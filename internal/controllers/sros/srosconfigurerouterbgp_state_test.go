@@ -0,0 +1,123 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+func TestGetObservationConfigureRouterBgp(t *testing.T) {
+	cases := map[string]struct {
+		state string
+		want  srosv1alpha1.ConfigureRouterBgpObservation
+	}{
+		"NoNeighbors": {
+			state: `{"neighbor":[]}`,
+			want: srosv1alpha1.ConfigureRouterBgpObservation{
+				Neighbor: []*srosv1alpha1.ConfigureRouterBgpObservationNeighbor{},
+			},
+		},
+		"SingleNeighbor": {
+			state: `{"nokia-state:neighbor":{"ip-address":"10.0.0.1","statistics":{"session-state":"Established","last-state":"OpenConfirm"}}}`,
+			want: srosv1alpha1.ConfigureRouterBgpObservation{
+				Neighbor: []*srosv1alpha1.ConfigureRouterBgpObservationNeighbor{
+					{IpAddress: utils.StringPtr("10.0.0.1"), SessionState: utils.StringPtr("Established"), LastState: utils.StringPtr("OpenConfirm")},
+				},
+			},
+		},
+		// the neighbors are sorted by the value of their ip-address, ipv4
+		// neighbors before ipv6 neighbors
+		"NeighborsAreSorted": {
+			state: `[
+				{"ip-address":"2001:db8::10","statistics":{"session-state":"Active"}},
+				{"ip-address":"10.0.0.10","statistics":{"session-state":"Established"}},
+				{"ip-address":"2001:db8::2","statistics":{"session-state":"Connect"}},
+				{"ip-address":"10.0.0.2","statistics":{"session-state":"Idle"}},
+				{"statistics":{"session-state":"Idle"}}
+			]`,
+			want: srosv1alpha1.ConfigureRouterBgpObservation{
+				Neighbor: []*srosv1alpha1.ConfigureRouterBgpObservationNeighbor{
+					{IpAddress: utils.StringPtr("10.0.0.2"), SessionState: utils.StringPtr("Idle")},
+					{IpAddress: utils.StringPtr("10.0.0.10"), SessionState: utils.StringPtr("Established")},
+					{IpAddress: utils.StringPtr("2001:db8::2"), SessionState: utils.StringPtr("Connect")},
+					{IpAddress: utils.StringPtr("2001:db8::10"), SessionState: utils.StringPtr("Active")},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := getObservationConfigureRouterBgp(unmarshalTestData(t, tc.state)); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("getObservationConfigureRouterBgp(): got %s, want %s", toJSON(t, got), toJSON(t, tc.want))
+			}
+		})
+	}
+}
+
+func TestCompareIpAddresses(t *testing.T) {
+	cases := map[string]struct {
+		a, b string
+		want int
+	}{
+		"Ipv4":            {a: "10.0.0.2", b: "10.0.0.10", want: -1},
+		"Ipv4Equal":       {a: "10.0.0.2", b: "10.0.0.2", want: 0},
+		"Ipv6":            {a: "2001:db8::10", b: "2001:db8::2", want: 1},
+		"Ipv6Notation":    {a: "2001:db8::1", b: "2001:0db8:0:0::1", want: 0},
+		"Ipv4BeforeIpv6":  {a: "::1", b: "192.168.0.1", want: 1},
+		"InvalidLast":     {a: "fe80::1%eth0", b: "2001:db8::1", want: 1},
+		"InvalidAsString": {a: "b", b: "a", want: 1},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := compareIpAddresses(tc.a, tc.b); got != tc.want {
+				t.Errorf("compareIpAddresses(%s, %s): got %d, want %d", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestObserveStateConfigureRouterBgp reads the state of the neighbors from the
+// device driver, when the state cannot be read the last observation is kept
+func TestObserveStateConfigureRouterBgp(t *testing.T) {
+	ctx := context.Background()
+	dd := gnmitest.NewDeviceDriver(t, unmarshalTestData(t, `{"neighbor":[{"ip-address":"10.0.0.1","statistics":{"session-state":"Established"}}]}`))
+	e := &externalConfigureRouterBgp{client: newTestClient(t, dd), log: logging.NewNopLogger(), parser: *parser.NewParser()}
+
+	o := &srosv1alpha1.SrosConfigureRouterBgp{ObjectMeta: metav1.ObjectMeta{Name: "bgp-1"}}
+	o.Spec.ForNetworkNode.RouterName = utils.StringPtr("Base")
+	e.observeState(ctx, o)
+	if n := o.Status.AtNetworkNode.Neighbor; len(n) != 1 || n[0].SessionState == nil || *n[0].SessionState != "Established" {
+		t.Fatalf("observeState(): got %s, want an established neighbor", toJSON(t, o.Status.AtNetworkNode))
+	}
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	e.observeState(cctx, o)
+	if n := o.Status.AtNetworkNode.Neighbor; len(n) != 1 {
+		t.Errorf("observeState(): the last observation must be kept when the state cannot be read, got %s", toJSON(t, o.Status.AtNetworkNode))
+	}
+}
@@ -0,0 +1,1508 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	gnmitypes "github.com/karimra/gnmic/types"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/pkg/errors"
+	ndrv1 "github.com/yndd/ndd-core/apis/dvr/v1"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/event"
+	"github.com/yndd/ndd-runtime/pkg/gext"
+	"github.com/yndd/ndd-runtime/pkg/gvk"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	cevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/clientpool"
+)
+
+const (
+	// Errors
+	errUnexpectedConfigureRouterInterface       = "the managed resource is not a ConfigureRouterInterface resource"
+	errKubeUpdateFailedConfigureRouterInterface = "cannot update ConfigureRouterInterface"
+	errReadConfigureRouterInterface             = "cannot read ConfigureRouterInterface"
+	errCreateConfigureRouterInterface           = "cannot create ConfigureRouterInterface"
+	erreUpdateConfigureRouterInterface          = "cannot update ConfigureRouterInterface"
+	errDeleteConfigureRouterInterface           = "cannot delete ConfigureRouterInterface"
+	errRouterNameMissing                        = "router-name or service-name is mandatory for a ConfigureRouterInterface"
+	errRouterInstanceAmbiguous                  = "router-name and service-name are mutually exclusive for a ConfigureRouterInterface"
+	errInterfaceNameMissing                     = "interface-name is mandatory for a ConfigureRouterInterface"
+	errListConfigurePort                        = "cannot list ConfigurePorts"
+	errListConfigureLag                         = "cannot list ConfigureLags"
+	errListConfigureRouterInterface             = "cannot list ConfigureRouterInterfaces"
+	errValidateParentConfigureRouterInterface   = "cannot validate the vprn of the ConfigureRouterInterface"
+
+	// resource information
+	levelConfigureRouterInterface = 3
+)
+
+var resourceRefPathsConfigureRouterInterface = []*gnmi.Path{
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "interface", Key: map[string]string{"interface-name": ""}},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "interface", Key: map[string]string{"interface-name": ""}},
+			{Name: "ipv4"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "interface", Key: map[string]string{"interface-name": ""}},
+			{Name: "ipv4"},
+			{Name: "bfd"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "interface", Key: map[string]string{"interface-name": ""}},
+			{Name: "ipv4"},
+			{Name: "primary"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "interface", Key: map[string]string{"interface-name": ""}},
+			{Name: "ipv4"},
+			{Name: "secondary", Key: map[string]string{"address": ""}},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "interface", Key: map[string]string{"interface-name": ""}},
+			{Name: "ipv6"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "interface", Key: map[string]string{"interface-name": ""}},
+			{Name: "ipv6"},
+			{Name: "address", Key: map[string]string{"ipv6-address": ""}},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "interface", Key: map[string]string{"interface-name": ""}},
+			{Name: "ipv6"},
+			{Name: "bfd"},
+		},
+	},
+	{
+		Elem: []*gnmi.PathElem{
+			{Name: "interface", Key: map[string]string{"interface-name": ""}},
+			{Name: "qos"},
+		},
+	},
+}
+
+// dependencyConfigureRouterInterface contains the ports and lags the port of an
+// interface can refer to, the keys of the remote paths are populated with the
+// port-id or lag-name of the port of the interface
+var dependencyConfigureRouterInterface = map[string]*parser.LeafRefGnmi{
+	"port": {
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "port", Key: map[string]string{"port-id": ""}},
+			},
+		},
+	},
+	"lag": {
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "lag", Key: map[string]string{"lag-name": ""}},
+			},
+		},
+	},
+}
+
+// dependencyVprnConfigureRouterInterface is the vprn service of a vprn
+// interface, the key of the remote path is populated with the service-name
+var dependencyVprnConfigureRouterInterface = &parser.LeafRefGnmi{
+	RemotePath: &gnmi.Path{
+		Elem: []*gnmi.PathElem{
+			{Name: "configure"},
+			{Name: "service"},
+			{Name: "vprn", Key: map[string]string{"service-name": ""}},
+		},
+	},
+}
+
+// hierarchicalIdsConfigureRouterInterface are the leafs of the router
+// instance, they are part of the root path and not of the data
+var hierarchicalIdsConfigureRouterInterface = []string{"router-name", "service-name"}
+
+var localleafRefConfigureRouterInterface = []*parser.LeafRefGnmi{}
+var externalLeafRefConfigureRouterInterface = []*parser.LeafRefGnmi{
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "interface"},
+				{Name: "apply-groups"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "interface"},
+				{Name: "apply-groups-exclude"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "groups"},
+				{Name: "group", Key: map[string]string{"name": ""}},
+			},
+		},
+	},
+	{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "interface"},
+				{Name: "qos"},
+				{Name: "network-policy"},
+			},
+		},
+		RemotePath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "configure"},
+				{Name: "qos"},
+				{Name: "network", Key: map[string]string{"network-policy-name": ""}},
+			},
+		},
+	},
+}
+
+// constraintsConfigureRouterInterface contains the range, length, pattern and
+// enum constraints of the leafs of the interface, indexed by schema path
+var constraintsConfigureRouterInterface = map[string]leafConstraint{
+	"/interface/ip-mtu": {
+		{kind: leafKindInteger, ranges: []valueRange{{512, 9786}}},
+	},
+	"/interface/ipv4/bfd/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 20}}},
+	},
+	"/interface/ipv4/bfd/receive": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 100000}}},
+	},
+	"/interface/ipv4/bfd/transmit-interval": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 100000}}},
+	},
+	"/interface/ipv4/primary/address": {
+		{kind: leafKindString, patterns: []string{`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])`}},
+	},
+	"/interface/ipv4/primary/prefix-length": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 32}}},
+	},
+	"/interface/ipv4/secondary/address": {
+		{kind: leafKindString, patterns: []string{`(([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])`}},
+	},
+	"/interface/ipv4/secondary/prefix-length": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 32}}},
+	},
+	"/interface/ipv6/address/ipv6-address": {
+		{kind: leafKindString, patterns: []string{`((:|[0-9a-fA-F]{0,4}):)([0-9a-fA-F]{0,4}:){0,5}((([0-9a-fA-F]{0,4}:)?(:|[0-9a-fA-F]{0,4}))|(((25[0-5]|2[0-4][0-9]|[01]?[0-9]?[0-9])\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9]?[0-9])))`}},
+	},
+	"/interface/ipv6/address/prefix-length": {
+		{kind: leafKindInteger, ranges: []valueRange{{0, 128}}},
+	},
+	"/interface/ipv6/bfd/multiplier": {
+		{kind: leafKindInteger, ranges: []valueRange{{3, 20}}},
+	},
+	"/interface/ipv6/bfd/receive": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 100000}}},
+	},
+	"/interface/ipv6/bfd/transmit-interval": {
+		{kind: leafKindInteger, ranges: []valueRange{{10, 100000}}},
+	},
+}
+
+// defaultsConfigureRouterInterface contains the yang defaults of the leafs of
+// the interface as json values, indexed by schema path
+var defaultsConfigureRouterInterface = map[string]string{
+	"/interface/ipv4/bfd/multiplier":        `3`,
+	"/interface/ipv4/bfd/receive":           `100`,
+	"/interface/ipv4/bfd/transmit-interval": `100`,
+	"/interface/ipv6/bfd/multiplier":        `3`,
+	"/interface/ipv6/bfd/receive":           `100`,
+	"/interface/ipv6/bfd/transmit-interval": `100`,
+}
+
+var defaulterConfigureRouterInterface = newDefaulter(defaultsConfigureRouterInterface, []string{})
+
+// defaultConfigureRouterInterface sets the yang defaults in the json data of an
+// interface, it is used for the spec and the data of the device such that both
+// are normalized the same way before they are compared
+func defaultConfigureRouterInterface(x interface{}) interface{} {
+	return defaulterConfigureRouterInterface.apply(x)
+}
+
+// validateConfigureRouterInterface returns the paths of all leafs in the data
+// that violate the constraints of the yang model
+func validateConfigureRouterInterface(p *parser.Parser, x1 interface{}) []constraintViolation {
+	// the router instance is part of the root path and not of the data
+	x1 = p.RemoveLeafsFromJSONData(x1, hierarchicalIdsConfigureRouterInterface)
+	// the interface is a list entry, by adding it to a list the interface-name
+	// is reported in the path of the violations
+	x, err := p.AddJSONDataToList(x1)
+	if err != nil {
+		return []constraintViolation{{detail: err.Error()}}
+	}
+	return validateConstraints(x, constraintsConfigureRouterInterface, resourceRefPathsConfigureRouterInterface)
+}
+
+// getRootPathConfigureRouterInterface returns the root path of the resource,
+// the interface is keyed by its interface-name within the router instance
+// such that every resource owns exactly one interface
+func getRootPathConfigureRouterInterface(o *srosv1alpha1.SrosConfigureRouterInterface) ([]*gnmi.Path, error) {
+	r, err := getRouterInstanceConfigureRouterInterface(o)
+	if err != nil {
+		return nil, err
+	}
+	interfaceName := getInterfaceNameConfigureRouterInterface(o)
+	if interfaceName == "" {
+		return nil, errors.New(errInterfaceNameMissing)
+	}
+	return []*gnmi.Path{
+		{
+			Elem: append(r.pathElems(), &gnmi.PathElem{Name: "interface", Key: map[string]string{"interface-name": interfaceName}}),
+		},
+	}, nil
+}
+
+// routerInstanceConfigureRouterInterface is the router instance of an
+// interface, a base router instance or a vprn service
+type routerInstanceConfigureRouterInterface struct {
+	vprn bool
+	name string
+}
+
+// String returns the router instance as it is reported, e.g. router Base
+func (r routerInstanceConfigureRouterInterface) String() string {
+	if r.vprn {
+		return "vprn " + r.name
+	}
+	return "router " + r.name
+}
+
+// pathElems returns the path of the router instance in the configuration
+func (r routerInstanceConfigureRouterInterface) pathElems() []*gnmi.PathElem {
+	if r.vprn {
+		return []*gnmi.PathElem{
+			{Name: "configure"},
+			{Name: "service"},
+			{Name: "vprn", Key: map[string]string{"service-name": r.name}},
+		}
+	}
+	return []*gnmi.PathElem{
+		{Name: "configure"},
+		{Name: "router", Key: map[string]string{"router-name": r.name}},
+	}
+}
+
+// getRouterInstanceConfigureRouterInterface returns the router instance of the
+// ConfigureRouterInterface, the router-name of a base router interface or the
+// service-name of a vprn interface
+func getRouterInstanceConfigureRouterInterface(o *srosv1alpha1.SrosConfigureRouterInterface) (routerInstanceConfigureRouterInterface, error) {
+	routerName := o.Spec.ForNetworkNode.RouterName
+	serviceName := o.Spec.ForNetworkNode.ServiceName
+	switch {
+	case routerName != nil && serviceName != nil:
+		return routerInstanceConfigureRouterInterface{}, errors.New(errRouterInstanceAmbiguous)
+	case serviceName != nil && *serviceName != "":
+		return routerInstanceConfigureRouterInterface{vprn: true, name: *serviceName}, nil
+	case routerName != nil && *routerName != "":
+		return routerInstanceConfigureRouterInterface{name: *routerName}, nil
+	}
+	return routerInstanceConfigureRouterInterface{}, errors.New(errRouterNameMissing)
+}
+
+// getInterfaceNameConfigureRouterInterface returns the interface-name of the
+// ConfigureRouterInterface or an empty string when it is not set
+func getInterfaceNameConfigureRouterInterface(o *srosv1alpha1.SrosConfigureRouterInterface) string {
+	i := o.Spec.ForNetworkNode.SrosConfigureRouterInterface
+	if i == nil || i.InterfaceName == nil {
+		return ""
+	}
+	return *i.InterfaceName
+}
+
+// getPortConfigureRouterInterface returns the port of the
+// ConfigureRouterInterface or an empty string when it is not set
+func getPortConfigureRouterInterface(o *srosv1alpha1.SrosConfigureRouterInterface) string {
+	i := o.Spec.ForNetworkNode.SrosConfigureRouterInterface
+	if i == nil || i.Port == nil {
+		return ""
+	}
+	return *i.Port
+}
+
+// getPortBindingConfigureRouterInterface returns the kind, port or lag, and the
+// port-id or lag-name the port of an interface refers to, the encapsulation
+// value after the colon is not part of the port-id or lag-name
+func getPortBindingConfigureRouterInterface(port string) (string, string) {
+	id := strings.SplitN(port, ":", 2)[0]
+	if strings.HasPrefix(id, "lag-") {
+		return "lag", id
+	}
+	return "port", id
+}
+
+// getNetworkNodeName returns the name of the network node of a managed
+// resource or an empty string when it is not set
+func getNetworkNodeName(mg resource.Managed) string {
+	if mg.GetNetworkNodeReference() == nil {
+		return ""
+	}
+	return mg.GetNetworkNodeReference().Name
+}
+
+// getInterfaceDataConfigureRouterInterface returns the json data of the
+// interface of the ConfigureRouterInterface
+func getInterfaceDataConfigureRouterInterface(o *srosv1alpha1.SrosConfigureRouterInterface) interface{} {
+	d, err := json.Marshal(o.Spec.ForNetworkNode.SrosConfigureRouterInterface)
+	if err != nil {
+		return nil
+	}
+	var x interface{}
+	json.Unmarshal(d, &x)
+	return x
+}
+
+// getPrefixesConfigureRouterInterface returns the ipv4 and ipv6 addresses of
+// the json data of an interface with their prefix length, e.g. 10.0.0.1/24
+func getPrefixesConfigureRouterInterface(x interface{}) []string {
+	prefixes := make([]string, 0)
+	add := func(address *string, prefixLength *uint32) {
+		if address == nil || prefixLength == nil {
+			return
+		}
+		// ipv6 addresses are normalized such that prefixes can be compared
+		a := *address
+		if ip := net.ParseIP(a); ip != nil {
+			a = ip.String()
+		}
+		prefixes = append(prefixes, fmt.Sprintf("%s/%d", a, *prefixLength))
+	}
+	add(stateString(x, "ipv4", "primary", "address"), stateUint32(x, "ipv4", "primary", "prefix-length"))
+	secondaries, _ := stateValue(x, "ipv4", "secondary").([]interface{})
+	for _, s := range secondaries {
+		add(stateString(s, "address"), stateUint32(s, "prefix-length"))
+	}
+	addresses, _ := stateValue(x, "ipv6", "address").([]interface{})
+	for _, a := range addresses {
+		add(stateString(a, "ipv6-address"), stateUint32(a, "prefix-length"))
+	}
+	return prefixes
+}
+
+// prefixesOverlap returns true when the subnets of both prefixes overlap,
+// prefixes that cannot be parsed are reported by the constraint validation
+func prefixesOverlap(a, b string) bool {
+	_, na, err := net.ParseCIDR(a)
+	if err != nil {
+		return false
+	}
+	_, nb, err := net.ParseCIDR(b)
+	if err != nil {
+		return false
+	}
+	return na.Contains(nb.IP) || nb.Contains(na.IP)
+}
+
+// routerInterfaceSibling is another interface of the router instance of an
+// interface with its prefixes
+type routerInterfaceSibling struct {
+	name     string
+	prefixes []string
+}
+
+// isOlderConfigureRouterInterface returns true when resource a is created
+// before resource b, the name breaks the tie of resources created at the same
+// time. The older resource keeps an interface-name or subnet that both use.
+func isOlderConfigureRouterInterface(a, b *srosv1alpha1.SrosConfigureRouterInterface) bool {
+	ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	return a.GetName() < b.GetName()
+}
+
+// validateSiblingsConfigureRouterInterface returns the violations of the
+// interface against the other interfaces of its router instance, an
+// interface-name is used by a single interface and the subnets of the
+// interfaces do not overlap
+func validateSiblingsConfigureRouterInterface(o *srosv1alpha1.SrosConfigureRouterInterface, r routerInstanceConfigureRouterInterface, siblings []routerInterfaceSibling) []string {
+	interfaceName := getInterfaceNameConfigureRouterInterface(o)
+	msgs := make([]string, 0)
+	for _, sibling := range siblings {
+		if sibling.name == interfaceName {
+			msgs = append(msgs, fmt.Sprintf("interface %s in %s is defined by another ConfigureRouterInterface", interfaceName, r))
+		}
+	}
+	for _, prefix := range getPrefixesConfigureRouterInterface(getInterfaceDataConfigureRouterInterface(o)) {
+		for _, sibling := range siblings {
+			if sibling.name == interfaceName {
+				continue
+			}
+			for _, siblingPrefix := range sibling.prefixes {
+				if prefixesOverlap(prefix, siblingPrefix) {
+					msgs = append(msgs, fmt.Sprintf("subnet %s of interface %s overlaps with subnet %s of interface %s in %s",
+						prefix, interfaceName, siblingPrefix, sibling.name, r))
+				}
+			}
+		}
+	}
+	return msgs
+}
+
+// getSiblingsConfigureRouterInterface returns the interfaces of the older
+// ConfigureRouterInterfaces of the router instance on the same network node.
+// A resource is only validated against older resources, such that of two
+// conflicting resources the interface of the older one is not deleted from
+// the device when the newer one is created.
+func (v *validatorConfigureRouterInterface) getSiblingsConfigureRouterInterface(ctx context.Context, o *srosv1alpha1.SrosConfigureRouterInterface, r routerInstanceConfigureRouterInterface) ([]routerInterfaceSibling, error) {
+	nodeName := getNetworkNodeName(o)
+
+	siblings := make([]routerInterfaceSibling, 0)
+	l := &srosv1alpha1.SrosConfigureRouterInterfaceList{}
+	if err := v.kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListConfigureRouterInterface)
+	}
+	for i := range l.Items {
+		item := &l.Items[i]
+		if item.GetName() == o.GetName() || item.GetDeletionTimestamp() != nil ||
+			getNetworkNodeName(item) != nodeName || !isOlderConfigureRouterInterface(item, o) {
+			continue
+		}
+		if ir, err := getRouterInstanceConfigureRouterInterface(item); err != nil || ir != r {
+			continue
+		}
+		siblings = append(siblings, routerInterfaceSibling{
+			name:     getInterfaceNameConfigureRouterInterface(item),
+			prefixes: getPrefixesConfigureRouterInterface(getInterfaceDataConfigureRouterInterface(item)),
+		})
+	}
+	return siblings, nil
+}
+
+// getConfigSiblingsConfigureRouterInterface returns the other interfaces of
+// the router instance in the configuration of the device, which include the
+// interfaces that are not managed by a ConfigureRouterInterface
+func getConfigSiblingsConfigureRouterInterface(x2 interface{}, r routerInstanceConfigureRouterInterface, interfaceName string) []routerInterfaceSibling {
+	instances, _ := stateValue(x2, "configure", "router").([]interface{})
+	key := "router-name"
+	if r.vprn {
+		instances, _ = stateValue(x2, "configure", "service", "vprn").([]interface{})
+		key = "service-name"
+	}
+	siblings := make([]routerInterfaceSibling, 0)
+	for _, instance := range instances {
+		if name := stateString(instance, key); name == nil || *name != r.name {
+			continue
+		}
+		interfaces, _ := stateValue(instance, "interface").([]interface{})
+		for _, itf := range interfaces {
+			name := stateString(itf, "interface-name")
+			if name == nil || *name == interfaceName {
+				continue
+			}
+			siblings = append(siblings, routerInterfaceSibling{
+				name:     *name,
+				prefixes: getPrefixesConfigureRouterInterface(itf),
+			})
+		}
+	}
+	return siblings
+}
+
+// SetupConfigureRouterInterface adds a controller that reconciles ConfigureRouterInterfaces.
+func SetupConfigureRouterInterface(mgr ctrl.Manager, o controller.Options, l logging.Logger, poll time.Duration, namespace string, pool *clientpool.Pool) (string, chan cevent.GenericEvent, error) {
+
+	name := managed.ControllerName(srosv1alpha1.ConfigureRouterInterfaceGroupKind)
+
+	events := make(chan cevent.GenericEvent)
+
+	v := &validatorConfigureRouterInterface{log: l, kube: mgr.GetClient(), parser: *parser.NewParser(parser.WithLogger(l))}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(srosv1alpha1.ConfigureRouterInterfaceGroupVersionKind),
+		managed.WithExternalConnecter(&connectorConfigureRouterInterface{
+			log:         l,
+			kube:        mgr.GetClient(),
+			namespace:   namespace,
+			pool:        pool,
+			usage:       resource.NewNetworkNodeUsageTracker(mgr.GetClient(), &ndrv1.NetworkNodeUsage{}),
+			validator:   v,
+			newClientFn: target.NewTarget},
+		),
+		managed.WithParser(l),
+		managed.WithValidator(v),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return srosv1alpha1.ConfigureRouterInterfaceGroupKind, events, ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&srosv1alpha1.SrosConfigureRouterInterface{}).
+		WithEventFilter(resource.IgnoreUpdateWithoutGenerationChangePredicate()).
+		Watches(
+			&source.Channel{Source: events},
+			&handler.EnqueueRequestForObject{},
+		).
+		//Watches(
+		//	&source.Kind{Type: &ndrv1.NetworkNode{}},
+		//	handler.EnqueueRequestsFromMapFunc(r.NetworkNodeMapFunc),
+		//).
+		Complete(r)
+}
+
+type validatorConfigureRouterInterface struct {
+	log    logging.Logger
+	kube   client.Client
+	parser parser.Parser
+}
+
+// ValidateValues validates the leaf values against the constraints of the
+// yang model and the interface against the other interfaces of the router
+// instance, such that invalid values are reported before anything is sent to
+// the device
+func (v *validatorConfigureRouterInterface) ValidateValues(ctx context.Context, mg resource.Managed) ([]string, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return nil, errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	x1, err := getSpecData(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return nil, err
+	}
+	msgs := constraintViolationMessages(validateConfigureRouterInterface(&v.parser, x1))
+	r, err := getRouterInstanceConfigureRouterInterface(o)
+	if err != nil {
+		return append(msgs, err.Error()), nil
+	}
+	siblings, err := v.getSiblingsConfigureRouterInterface(ctx, o, r)
+	if err != nil {
+		return nil, err
+	}
+	return append(msgs, validateSiblingsConfigureRouterInterface(o, r, siblings)...), nil
+}
+
+func (v *validatorConfigureRouterInterface) ValidateLocalleafRef(ctx context.Context, mg resource.Managed) (managed.ValidateLocalleafRefObservation, error) {
+	log := v.log.WithValues("resource", mg.GetName())
+	log.Debug("ValidateLocalleafRef...")
+
+	// json unmarshal the resource
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return managed.ValidateLocalleafRefObservation{}, errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ValidateLocalleafRefObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+	var x1 interface{}
+	if err := json.Unmarshal(d, &x1); err != nil {
+		return managed.ValidateLocalleafRefObservation{}, errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	// For local leafref validation we dont need to supply the external data so we use nil
+	success, resultleafRefValidation, err := v.parser.ValidateLeafRefGnmi(
+		parser.LeafRefValidationLocal, x1, nil, localleafRefConfigureRouterInterface, log)
+	if err != nil {
+		return managed.ValidateLocalleafRefObservation{
+			Success: false,
+		}, nil
+	}
+	if !success {
+		log.Debug("ValidateLocalleafRef failed", "resultleafRefValidation", resultleafRefValidation)
+		return managed.ValidateLocalleafRefObservation{
+			Success:          false,
+			ResolvedLeafRefs: resultleafRefValidation}, nil
+	}
+	log.Debug("ValidateLocalleafRef success", "resultleafRefValidation", resultleafRefValidation)
+	return managed.ValidateLocalleafRefObservation{
+		Success:          true,
+		ResolvedLeafRefs: resultleafRefValidation}, nil
+}
+
+func (v *validatorConfigureRouterInterface) ValidateExternalleafRef(ctx context.Context, mg resource.Managed, cfg []byte) (managed.ValidateExternalleafRefObservation, error) {
+	log := v.log.WithValues("resource", mg.GetName())
+	log.Debug("ValidateExternalleafRef...")
+
+	// json unmarshal the resource
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return managed.ValidateExternalleafRefObservation{}, errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ValidateExternalleafRefObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+	var x1 interface{}
+	if err := json.Unmarshal(d, &x1); err != nil {
+		return managed.ValidateExternalleafRefObservation{}, errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	// json unmarshal the external data
+	var x2 interface{}
+	if len(cfg) != 0 {
+		if err := json.Unmarshal(cfg, &x2); err != nil {
+			return managed.ValidateExternalleafRefObservation{}, errors.Wrap(err, errJSONUnMarshal)
+		}
+	}
+
+	// For local external leafref validation we need to supply the external
+	// data to validate the remote leafref, we use x2 for this
+	success, resultleafRefValidation, err := v.parser.ValidateLeafRefGnmi(
+		parser.LeafRefValidationExternal, x1, x2, externalLeafRefConfigureRouterInterface, log)
+	if err != nil {
+		return managed.ValidateExternalleafRefObservation{
+			Success: false,
+		}, nil
+	}
+
+	// the port of the interface is a port or lag, which is resolved against the
+	// resources of the network node as well as the configuration of the device
+	if r, err := v.resolvePortConfigureRouterInterface(ctx, o, x2); err != nil {
+		return managed.ValidateExternalleafRefObservation{}, err
+	} else if r != nil {
+		resultleafRefValidation = append(resultleafRefValidation, r)
+		success = success && r.Resolved
+	}
+	if !success {
+		log.Debug("ValidateExternalleafRef failed", "resultleafRefValidation", resultleafRefValidation)
+		return managed.ValidateExternalleafRefObservation{
+			Success:          false,
+			ResolvedLeafRefs: resultleafRefValidation}, nil
+	}
+	log.Debug("ValidateExternalleafRef success", "resultleafRefValidation", resultleafRefValidation)
+	return managed.ValidateExternalleafRefObservation{
+		Success:          true,
+		ResolvedLeafRefs: resultleafRefValidation}, nil
+}
+
+// resolvePortConfigureRouterInterface resolves the port or lag of the
+// interface, it is resolved when a ConfigurePort or ConfigureLag on the same
+// network node defines it or when it exists in the configuration of the
+// device. Nil is returned when the interface has no port.
+func (v *validatorConfigureRouterInterface) resolvePortConfigureRouterInterface(ctx context.Context, o *srosv1alpha1.SrosConfigureRouterInterface, x2 interface{}) (*parser.ResolvedLeafRefGnmi, error) {
+	port := getPortConfigureRouterInterface(o)
+	if port == "" {
+		return nil, nil
+	}
+	kind, id := getPortBindingConfigureRouterInterface(port)
+	r := &parser.ResolvedLeafRefGnmi{
+		LocalPath: &gnmi.Path{
+			Elem: []*gnmi.PathElem{
+				{Name: "interface"},
+				{Name: "port"},
+			},
+		},
+		RemotePath: v.parser.DeepCopyGnmiPath(dependencyConfigureRouterInterface[kind].RemotePath),
+		Value:      port,
+	}
+	for _, elem := range r.RemotePath.GetElem() {
+		for k := range elem.GetKey() {
+			elem.Key[k] = id
+		}
+	}
+
+	managedByResource, err := v.isManagedConfigureRouterInterface(ctx, o, kind, id)
+	if err != nil {
+		return nil, err
+	}
+	if managedByResource {
+		r.Resolved = true
+		return r, nil
+	}
+	success, _, err := v.parser.ValidateParentDependencyGnmi(x2, id, []*parser.LeafRefGnmi{dependencyConfigureRouterInterface[kind]}, v.log)
+	if err != nil {
+		return nil, err
+	}
+	r.Resolved = success
+	return r, nil
+}
+
+// isManagedConfigureRouterInterface returns true when a ConfigurePort or
+// ConfigureLag on the network node of the interface defines the port or lag,
+// resources that are being deleted are ignored
+func (v *validatorConfigureRouterInterface) isManagedConfigureRouterInterface(ctx context.Context, o *srosv1alpha1.SrosConfigureRouterInterface, kind, id string) (bool, error) {
+	nodeName := getNetworkNodeName(o)
+	switch kind {
+	case "lag":
+		l := &srosv1alpha1.SrosConfigureLagList{}
+		if err := v.kube.List(ctx, l); err != nil {
+			return false, errors.Wrap(err, errListConfigureLag)
+		}
+		for i := range l.Items {
+			item := &l.Items[i]
+			if item.GetDeletionTimestamp() == nil && getNetworkNodeName(item) == nodeName && getLagName(item) == id {
+				return true, nil
+			}
+		}
+	default:
+		l := &srosv1alpha1.SrosConfigurePortList{}
+		if err := v.kube.List(ctx, l); err != nil {
+			return false, errors.Wrap(err, errListConfigurePort)
+		}
+		for i := range l.Items {
+			item := &l.Items[i]
+			if item.GetDeletionTimestamp() == nil && getNetworkNodeName(item) == nodeName && getPortId(item) == id {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (v *validatorConfigureRouterInterface) ValidateParentDependency(ctx context.Context, mg resource.Managed, cfg []byte) (managed.ValidateParentDependencyObservation, error) {
+	log := v.log.WithValues("resource", mg.GetName())
+	log.Debug("ValidateParentDependency...")
+
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return managed.ValidateParentDependencyObservation{}, errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	r, err := getRouterInstanceConfigureRouterInterface(o)
+	if err != nil {
+		return managed.ValidateParentDependencyObservation{}, err
+	}
+
+	// a base router instance always exists, the vprn of a vprn interface
+	// should exist in the configuration of the device
+	resultleafRefValidation := make([]*parser.ResolvedLeafRefGnmi, 0)
+	if r.vprn {
+		// json unmarshal the external data
+		var x2 interface{}
+		if len(cfg) != 0 {
+			if err := json.Unmarshal(cfg, &x2); err != nil {
+				return managed.ValidateParentDependencyObservation{}, errors.Wrap(err, errJSONUnMarshal)
+			}
+		}
+		success, result, err := v.parser.ValidateParentDependencyGnmi(x2, r.name, []*parser.LeafRefGnmi{dependencyVprnConfigureRouterInterface}, log)
+		if err != nil {
+			return managed.ValidateParentDependencyObservation{}, errors.Wrap(err, errValidateParentConfigureRouterInterface)
+		}
+		resultleafRefValidation = append(resultleafRefValidation, result...)
+		if !success {
+			log.Debug("ValidateParentDependency failed", "resultParentValidation", resultleafRefValidation)
+			return managed.ValidateParentDependencyObservation{
+				Success:          false,
+				ResolvedLeafRefs: resultleafRefValidation}, nil
+		}
+	}
+	log.Debug("ValidateParentDependency success", "resultParentValidation", resultleafRefValidation)
+	return managed.ValidateParentDependencyObservation{
+		Success:          true,
+		ResolvedLeafRefs: resultleafRefValidation}, nil
+}
+
+// ValidateResourceIndexes validates if the indexes of a resource got changed
+// if so we need to delete the original resource, because it will be dangling if we dont delete it
+func (v *validatorConfigureRouterInterface) ValidateResourceIndexes(ctx context.Context, mg resource.Managed) (managed.ValidateResourceIndexesObservation, error) {
+	log := v.log.WithValues("resosurce", mg.GetName())
+
+	// json unmarshal the resource
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return managed.ValidateResourceIndexesObservation{}, errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	log.Debug("ValidateResourceIndexes", "Spec", o.Spec)
+
+	rootPath, err := getRootPathConfigureRouterInterface(o)
+	if err != nil {
+		return managed.ValidateResourceIndexesObservation{}, err
+	}
+
+	origResourceIndex := mg.GetResourceIndexes()
+	// we call the CompareConfigPathsWithResourceKeys irrespective is the get resource index returns nil
+	changed, deletPaths, newResourceIndex := v.parser.CompareGnmiPathsWithResourceKeys(rootPath[0], origResourceIndex)
+	if changed {
+		log.Debug("ValidateResourceIndexes changed", "deletPaths", deletPaths[0])
+		return managed.ValidateResourceIndexesObservation{Changed: true, ResourceDeletes: deletPaths, ResourceIndexes: newResourceIndex}, nil
+	}
+
+	log.Debug("ValidateResourceIndexes success")
+	return managed.ValidateResourceIndexesObservation{Changed: false, ResourceIndexes: newResourceIndex}, nil
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connectorConfigureRouterInterface struct {
+	log         logging.Logger
+	kube        client.Client
+	namespace   string
+	pool        *clientpool.Pool
+	usage       resource.Tracker
+	validator   valueValidator
+	newClientFn func(c *gnmitypes.TargetConfig) *target.Target
+	//newClientFn func(ctx context.Context, cfg ndd.Config) (config.ConfigurationClient, error)
+}
+
+// Connect produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a NetworkNode.
+// 2. Getting the managed resource's NetworkNode with connection details
+// A resource is mapped to a single target
+func (c *connectorConfigureRouterInterface) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	log := c.log.WithValues("resource", mg.GetName())
+	log.Debug("Connect")
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return nil, errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackTCUsage)
+	}
+
+	// find network node that is configured status
+	nn := &ndrv1.NetworkNode{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: o.GetNetworkNodeReference().Name}, nn); err != nil {
+		return nil, errors.Wrap(err, errGetNetworkNode)
+	}
+
+	if nn.GetCondition(ndrv1.ConditionKindDeviceDriverConfigured).Status != corev1.ConditionTrue {
+		return nil, errors.New(targetNotConfigured)
+	}
+	cfg, err := getResourceTargetConfig(ctx, c.kube, nn, c.namespace, o)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := c.pool.Get(ctx, cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	// we make a string here since we use a trick in registration to go to multiple targets
+	// while here the object is mapped to a single target/network node
+	tns := make([]string, 0)
+	tns = append(tns, nn.GetName())
+
+	return withValueValidation(&externalConfigureRouterInterface{client: cl, targets: tns, log: log, parser: *parser.NewParser(parser.WithLogger(log))}, c.validator), nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type externalConfigureRouterInterface struct {
+	//client  config.ConfigurationClient
+	client  *target.Target
+	targets []string
+	log     logging.Logger
+	parser  parser.Parser
+}
+
+func (e *externalConfigureRouterInterface) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Observing ...")
+
+	// rootpath of the resource
+	rootPath, err := getRootPathConfigureRouterInterface(o)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// gvk: group, version, kind, name, namespace of the resource
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// gext: gni extension information for the resource: action, gvk name and level
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionGet,
+		Name:   gvkstring,
+		Level:  levelConfigureRouterInterface,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetGextInfo)
+	}
+
+	// gnmi get request
+	req := &gnmi.GetRequest{
+		Path:     rootPath,
+		Encoding: gnmi.Encoding_JSON,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	// gnmi get response
+	resp, err := e.client.Get(ctx, req)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errReadConfigureRouterInterface)
+	}
+
+	// validate if the extension matches or not
+	if resp.GetExtension()[0].GetRegisteredExt().GetId() != gnmi_ext.ExtensionID_EID_EXPERIMENTAL {
+		log.Debug("Observe response GNMI Extension mismatch", "Extension Info", resp.GetExtension()[0])
+		return managed.ExternalObservation{}, errors.New(errGnmiExtensionMismatch)
+	}
+
+	// get gnmi extension metadata
+	meta := resp.GetExtension()[0].GetRegisteredExt().GetMsg()
+	respMeta := &gext.GEXT{}
+	if err := json.Unmarshal(meta, &respMeta); err != nil {
+		log.Debug("Observe response gext unmarshal issue", "Extension Info", meta)
+		return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+
+	// prepare the input data to compare against the response data
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
+	}
+	var x1 interface{}
+	if err := json.Unmarshal(d, &x1); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	// remove the hierarchical elements for data processing, comparison, etc
+	// they are used in the provider for parent dependency resolution
+	// but are not relevant in the data, they are referenced in the rootPath
+	// when interacting with the device driver
+	x1 = e.parser.RemoveLeafsFromJSONData(x1, hierarchicalIdsConfigureRouterInterface)
+
+	// the device reports leafs with their default value, the spec is defaulted
+	// the same way to avoid a difference for leafs that are not in the spec
+	x1 = defaultConfigureRouterInterface(x1)
+
+	// the resource is a list entry keyed by interface-name, for lists with keys we need to
+	// create a list before calulating the paths such that the key ends up in the path
+	x1, err = e.parser.AddJSONDataToList(x1)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errWrongInputdata)
+	}
+
+	// validate gnmi resp information
+	var x2 interface{}
+	if len(resp.GetNotification()) != 0 {
+		if len(resp.GetNotification()[0].GetUpdate()) != 0 {
+			// get value from gnmi get response
+			x2, err = e.parser.GetValue(resp.GetNotification()[0].GetUpdate()[0].Val)
+			if err != nil {
+				log.Debug("Observe response get value issue")
+				return managed.ExternalObservation{}, errors.Wrap(err, errJSONMarshal)
+			}
+			x2 = defaultConfigureRouterInterface(x2)
+		}
+	}
+
+	// logging information that will be used to provide the response
+	log.Debug("Observer Response", "Meta", string(meta))
+	log.Debug("Spec Data", "X1", x1)
+	log.Debug("Resp Data", "X2", x2)
+
+	// if the cache is not ready we back off and return
+	if !respMeta.CacheReady {
+		log.Debug("Cache Not Ready ...")
+		return managed.ExternalObservation{
+			Ready:            false,
+			ResourceExists:   false,
+			ResourceHasData:  true,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	if !respMeta.Exists {
+		// Resource Does not Exists
+		if respMeta.HasData {
+			// this is an umnaged resource which has data and will be moved to a managed resource
+
+			updatesx1 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigureRouterInterface)
+			for _, update := range updatesx1 {
+				log.Debug("Observe Fine Grane Updates X1", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+			// for lists with keys we need to create a list before calulating the paths since this is what
+			// the object eventually happens to be based upon. We avoid having multiple entries in a list object
+			// and hence we have to add this step
+			x2, err = e.parser.AddJSONDataToList(x2)
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errWrongInputdata)
+			}
+			updatesx2 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x2, resourceRefPathsConfigureRouterInterface)
+			for _, update := range updatesx2 {
+				log.Debug("Observe Fine Grane Updates X2", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+
+			deletes, updates, err := e.parser.FindResourceDeltaGnmi(updatesx1, updatesx2, log)
+			if err != nil {
+				return managed.ExternalObservation{}, err
+			}
+			if len(deletes) != 0 || len(updates) != 0 {
+				// UMR -> MR with data, which is NOT up to date
+				log.Debug("Observing Response: resource NOT up to date", "Exists", false, "HasData", true, "UpToDate", false, "Response", resp, "Updates", updates, "Deletes", deletes)
+				for _, del := range deletes {
+					log.Debug("Observing Response: resource NOT up to date, deletes", "path", e.parser.GnmiPathToXPath(del, true))
+				}
+				for _, upd := range updates {
+					val, _ := e.parser.GetValue(upd.GetVal())
+					log.Debug("Observing Response: resource NOT up to date, updates", "path", e.parser.GnmiPathToXPath(upd.GetPath(), true), "data", val)
+				}
+				return managed.ExternalObservation{
+					Ready:            true,
+					ResourceExists:   false,
+					ResourceHasData:  true,
+					ResourceUpToDate: false,
+					ResourceDeletes:  deletes,
+					ResourceUpdates:  updates,
+				}, nil
+			}
+			// UMR -> MR with data, which is up to date
+			log.Debug("Observing Response: resource up to date", "Exists", false, "HasData", true, "UpToDate", true, "Response", resp)
+			return managed.ExternalObservation{
+				Ready:            true,
+				ResourceExists:   false,
+				ResourceHasData:  true,
+				ResourceUpToDate: true,
+			}, nil
+		}
+		// UMR -> MR without data
+		log.Debug("Observing Response:", "Exists", false, "HasData", false, "UpToDate", false, "Response", resp)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   false,
+			ResourceHasData:  false,
+			ResourceUpToDate: false,
+		}, nil
+
+	}
+	// Resource Exists
+	switch respMeta.Status {
+	case gext.ResourceStatusSuccess:
+		if respMeta.HasData {
+			// data is present
+
+			// the response data is a single list entry, for lists with keys we need to
+			// create a list before calulating the paths
+			x2, err = e.parser.AddJSONDataToList(x2)
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errWrongInputdata)
+			}
+			updatesx1 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigureRouterInterface)
+			for _, update := range updatesx1 {
+				log.Debug("Observe Fine Grane Updates X1", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+			updatesx2 := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x2, resourceRefPathsConfigureRouterInterface)
+			for _, update := range updatesx2 {
+				log.Debug("Observe Fine Grane Updates X2", "Path", e.parser.GnmiPathToXPath(update.Path, true), "Value", update.GetVal())
+			}
+
+			deletes, updates, err := e.parser.FindResourceDeltaGnmi(updatesx1, updatesx2, log)
+			if err != nil {
+				return managed.ExternalObservation{}, err
+			}
+			// MR -> MR, resource is NOT up to date
+			if len(deletes) != 0 || len(updates) != 0 {
+				// resource is NOT up to date
+				log.Debug("Observing Response: resource NOT up to date", "Exists", true, "HasData", true, "UpToDate", false, "Response", resp, "Updates", updates, "Deletes", deletes)
+				for _, del := range deletes {
+					log.Debug("Observing Response: resource NOT up to date, deletes", "path", e.parser.GnmiPathToXPath(del, true))
+				}
+				for _, upd := range updates {
+					val, _ := e.parser.GetValue(upd.GetVal())
+					log.Debug("Observing Response: resource NOT up to date, updates", "path", e.parser.GnmiPathToXPath(upd.GetPath(), true), "data", val)
+				}
+				return managed.ExternalObservation{
+					Ready:            true,
+					ResourceExists:   true,
+					ResourceHasData:  true,
+					ResourceUpToDate: false,
+					ResourceDeletes:  deletes,
+					ResourceUpdates:  updates,
+				}, nil
+			}
+			// MR -> MR, resource is up to date
+			log.Debug("Observing Response: resource up to date", "Exists", true, "HasData", true, "UpToDate", true, "Response", resp)
+			return managed.ExternalObservation{
+				Ready:            true,
+				ResourceExists:   true,
+				ResourceHasData:  true,
+				ResourceUpToDate: true,
+			}, nil
+		}
+		// MR -> MR, resource has no data, strange, someone could have deleted the resource
+		log.Debug("Observing Response", "Exists", true, "HasData", false, "UpToDate", false, "Status", respMeta.Status)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   true,
+			ResourceHasData:  false,
+			ResourceUpToDate: false,
+		}, nil
+
+	default:
+		// MR -> MR, resource is not in a success state, so the object might still be in creation phase
+		log.Debug("Observing Response", "Exists", true, "HasData", false, "UpToDate", false, "Status", respMeta.Status)
+		return managed.ExternalObservation{
+			Ready:            true,
+			ResourceExists:   true,
+			ResourceHasData:  false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+}
+
+// validateConfigSiblings validates the interface against the other interfaces
+// of its router instance in the configuration of the device. A conflict is
+// reported in the value validation condition and returned as an error, such
+// that the interface is not pushed and nothing is deleted from the device.
+func (e *externalConfigureRouterInterface) validateConfigSiblings(ctx context.Context, o *srosv1alpha1.SrosConfigureRouterInterface) error {
+	r, err := getRouterInstanceConfigureRouterInterface(o)
+	if err != nil {
+		return err
+	}
+	cfg, err := e.GetConfig(ctx)
+	if err != nil {
+		return err
+	}
+	var x2 interface{}
+	if len(cfg) != 0 {
+		if err := json.Unmarshal(cfg, &x2); err != nil {
+			return errors.Wrap(err, errJSONUnMarshal)
+		}
+	}
+	siblings := getConfigSiblingsConfigureRouterInterface(x2, r, getInterfaceNameConfigureRouterInterface(o))
+	if msgs := validateSiblingsConfigureRouterInterface(o, r, siblings); len(msgs) != 0 {
+		msg := strings.Join(msgs, "; ")
+		o.SetConditions(srosv1alpha1.ValueValidationFailure(msg))
+		return errors.New(msg)
+	}
+	return nil
+}
+
+func (e *externalConfigureRouterInterface) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Creating ...")
+
+	rootPath, err := getRootPathConfigureRouterInterface(o)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := e.validateConfigSiblings(ctx, o); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	d, err := json.Marshal(&o.Spec.ForNetworkNode)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJSONMarshal)
+	}
+
+	var x1 interface{}
+	if err := json.Unmarshal(d, &x1); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	// remove the hierarchical elements for data processing, comparison, etc
+	// they are used in the provider for parent dependency resolution
+	// but are not relevant in the data, they are referenced in the rootPath
+	// when interacting with the device driver
+	x1 = e.parser.RemoveLeafsFromJSONData(x1, hierarchicalIdsConfigureRouterInterface)
+
+	// the resource is a list entry keyed by interface-name, for lists with keys we need to
+	// create a list before calulating the paths such that the key ends up in the path
+	x1, err = e.parser.AddJSONDataToList(x1)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errWrongInputdata)
+	}
+
+	updates := e.parser.GetUpdatesFromJSONDataGnmi(rootPath[0], e.parser.XpathToGnmiPath("/", 0), x1, resourceRefPathsConfigureRouterInterface)
+	for _, update := range updates {
+		log.Debug("Create Fine Grane Updates", "Path", update.Path, "Value", update.GetVal())
+	}
+
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	gextInfo := &gext.GEXT{
+		Action:   gext.GEXTActionCreate,
+		Name:     gvkstring,
+		Level:    levelConfigureRouterInterface,
+		RootPath: rootPath[0],
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetGextInfo)
+	}
+
+	if len(updates) == 0 {
+		log.Debug("cannot create object since there are no updates present")
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateObject)
+	}
+
+	req := &gnmi.SetRequest{
+		Replace: updates,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	_, err = e.client.Set(ctx, req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errReadConfigureRouterInterface)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *externalConfigureRouterInterface) Update(ctx context.Context, mg resource.Managed, obs managed.ExternalObservation) (managed.ExternalUpdate, error) {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Updating ...")
+
+	for _, u := range obs.ResourceUpdates {
+		log.Debug("Update -> Update", "Path", u.Path, "Value", u.GetVal())
+	}
+	for _, d := range obs.ResourceDeletes {
+		log.Debug("Update -> Delete", "Path", d)
+	}
+	if err := e.validateConfigSiblings(ctx, o); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionUpdate,
+		Name:   gvkstring,
+		Level:  levelConfigureRouterInterface,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetGextInfo)
+	}
+
+	req := &gnmi.SetRequest{
+		Update: obs.ResourceUpdates,
+		Delete: obs.ResourceDeletes,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	_, err = e.client.Set(ctx, req)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errReadConfigureRouterInterface)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *externalConfigureRouterInterface) Delete(ctx context.Context, mg resource.Managed) error {
+	o, ok := mg.(*srosv1alpha1.SrosConfigureRouterInterface)
+	if !ok {
+		return errors.New(errUnexpectedConfigureRouterInterface)
+	}
+	log := e.log.WithValues("Resource", o.GetName())
+	log.Debug("Deleting ...")
+
+	rootPath, err := getRootPathConfigureRouterInterface(o)
+	if err != nil {
+		// without router-name or interface-name the resource was never created on the device
+		log.Debug("Delete without router-name or interface-name", "error", err)
+		return nil
+	}
+
+	gvk := &gvk.GVK{
+		Group:     mg.GetObjectKind().GroupVersionKind().Group,
+		Version:   mg.GetObjectKind().GroupVersionKind().Version,
+		Kind:      mg.GetObjectKind().GroupVersionKind().Kind,
+		Name:      mg.GetName(),
+		NameSpace: mg.GetNamespace(),
+	}
+	gvkstring, err := gvk.String()
+	if err != nil {
+		return err
+	}
+
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionDelete,
+		Name:   gvkstring,
+		Level:  levelConfigureRouterInterface,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return errors.Wrap(err, errGetGextInfo)
+	}
+
+	req := gnmi.SetRequest{
+		Delete: rootPath,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	_, err = e.client.Set(ctx, &req)
+	if err != nil {
+		return errors.Wrap(err, errDeleteConfigureRouterInterface)
+	}
+
+	return nil
+}
+
+func (e *externalConfigureRouterInterface) GetTarget() []string {
+	return e.targets
+}
+
+func (e *externalConfigureRouterInterface) GetConfig(ctx context.Context) ([]byte, error) {
+	e.log.Debug("Get Config ...")
+	req := &gnmi.GetRequest{
+		Path:     []*gnmi.Path{},
+		Encoding: gnmi.Encoding_JSON,
+	}
+
+	resp, err := e.client.Get(ctx, req)
+	if err != nil {
+		return make([]byte, 0), errors.Wrap(err, errGetConfig)
+	}
+
+	if len(resp.GetNotification()) != 0 {
+		if len(resp.GetNotification()[0].GetUpdate()) != 0 {
+			x2, err := e.parser.GetValue(resp.GetNotification()[0].GetUpdate()[0].Val)
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, errGetConfig)
+			}
+
+			data, err := json.Marshal(x2)
+			if err != nil {
+				return make([]byte, 0), errors.Wrap(err, errJSONMarshal)
+			}
+			return data, nil
+		}
+	}
+	e.log.Debug("Get Config Empty response")
+	return nil, nil
+}
+
+func (e *externalConfigureRouterInterface) GetResourceName(ctx context.Context, path []*gnmi.Path) (string, error) {
+	e.log.Debug("Get ResourceName ...")
+
+	gextInfo := &gext.GEXT{
+		Action: gext.GEXTActionGetResourceName,
+	}
+	gextInfoString, err := gextInfo.String()
+	if err != nil {
+		return "", errors.Wrap(err, errGetGextInfo)
+	}
+
+	req := &gnmi.GetRequest{
+		Path:     path,
+		Encoding: gnmi.Encoding_JSON,
+		Extension: []*gnmi_ext.Extension{
+			{Ext: &gnmi_ext.Extension_RegisteredExt{
+				RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(gextInfoString)}}},
+		},
+	}
+
+	resp, err := e.client.Get(ctx, req)
+	if err != nil {
+		return "", errors.Wrap(err, errGetResourceName)
+	}
+
+	x2, err := e.parser.GetValue(resp.GetNotification()[0].GetUpdate()[0].Val)
+	if err != nil {
+		return "", errors.Wrap(err, errJSONMarshal)
+	}
+
+	d, err := json.Marshal(x2)
+	if err != nil {
+		return "", errors.Wrap(err, errJSONMarshal)
+	}
+
+	var resourceName nddv1.ResourceName
+	if err := json.Unmarshal(d, &resourceName); err != nil {
+		return "", errors.Wrap(err, errJSONUnMarshal)
+	}
+
+	e.log.Debug("Get ResourceName Response", "ResourceName", resourceName)
+
+	return resourceName.Name, nil
+}
@@ -0,0 +1,352 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/logging"
+	"github.com/yndd/ndd-runtime/pkg/utils"
+	"github.com/yndd/ndd-yang/pkg/parser"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+var testCreatedConfigureRouterInterface = time.Now().Truncate(time.Second)
+
+// testConfigureRouterInterface returns an interface of the router instance on
+// network node sr1 with the ipv4 prefixes, e.g. 10.0.0.1/24, the first prefix
+// is the primary address. Resources with a higher age are created earlier.
+func testConfigureRouterInterface(name, routerName, serviceName, interfaceName string, age int, prefixes ...string) *srosv1alpha1.SrosConfigureRouterInterface {
+	o := &srosv1alpha1.SrosConfigureRouterInterface{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(testCreatedConfigureRouterInterface.Add(-time.Duration(age) * time.Minute)),
+		},
+	}
+	o.SetGroupVersionKind(srosv1alpha1.ConfigureRouterInterfaceGroupVersionKind)
+	o.Spec.NetworkNodeReference = &nddv1.Reference{Name: "sr1"}
+	if routerName != "" {
+		o.Spec.ForNetworkNode.RouterName = utils.StringPtr(routerName)
+	}
+	if serviceName != "" {
+		o.Spec.ForNetworkNode.ServiceName = utils.StringPtr(serviceName)
+	}
+	i := &srosv1alpha1.ConfigureRouterInterface{InterfaceName: utils.StringPtr(interfaceName)}
+	for n, prefix := range prefixes {
+		split := strings.SplitN(prefix, "/", 2)
+		pl, _ := strconv.ParseUint(split[1], 10, 8)
+		l := uint8(pl)
+		if i.Ipv4 == nil {
+			i.Ipv4 = &srosv1alpha1.ConfigureRouterInterfaceIpv4{}
+		}
+		if n == 0 {
+			i.Ipv4.Primary = &srosv1alpha1.ConfigureRouterInterfaceIpv4Primary{Address: utils.StringPtr(split[0]), PrefixLength: &l}
+			continue
+		}
+		i.Ipv4.Secondary = append(i.Ipv4.Secondary, &srosv1alpha1.ConfigureRouterInterfaceIpv4Secondary{Address: utils.StringPtr(split[0]), PrefixLength: &l})
+	}
+	o.Spec.ForNetworkNode.SrosConfigureRouterInterface = i
+	return o
+}
+
+func TestPrefixesOverlap(t *testing.T) {
+	cases := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"SameSubnet":        {a: "10.0.0.1/24", b: "10.0.0.2/24", want: true},
+		"ContainedSubnet":   {a: "10.0.0.1/16", b: "10.0.1.1/24", want: true},
+		"ContainingSubnet":  {a: "10.0.1.1/24", b: "10.0.0.1/16", want: true},
+		"AdjacentSubnets":   {a: "10.0.0.1/24", b: "10.0.1.1/24", want: false},
+		"HostRoutes":        {a: "10.0.0.1/32", b: "10.0.0.2/32", want: false},
+		"Ipv6Subnet":        {a: "2001:db8::1/64", b: "2001:db8::2/64", want: true},
+		"Ipv6OtherSubnet":   {a: "2001:db8::1/64", b: "2001:db8:0:1::1/64", want: false},
+		"Ipv4AndIpv6":       {a: "10.0.0.1/8", b: "2001:db8::1/64", want: false},
+		"InvalidPrefix":     {a: "10.0.0.1", b: "10.0.0.1/24", want: false},
+		"InvalidPrefixLen":  {a: "10.0.0.1/24", b: "10.0.0.1/33", want: false},
+		"DefaultRouteIpv4":  {a: "0.0.0.0/0", b: "192.168.1.1/24", want: true},
+		"ContainedHostAddr": {a: "192.168.1.1/24", b: "192.168.1.200/32", want: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := prefixesOverlap(tc.a, tc.b); got != tc.want {
+				t.Errorf("prefixesOverlap(%s, %s): got %t, want %t", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetRootPathConfigureRouterInterface(t *testing.T) {
+	p := parser.NewParser()
+	cases := map[string]struct {
+		o      *srosv1alpha1.SrosConfigureRouterInterface
+		want   string
+		errMsg string
+	}{
+		"BaseRouter": {
+			o:    testConfigureRouterInterface("itf-1", "Base", "", "system", 0),
+			want: "/configure/router[router-name=Base]/interface[interface-name=system]",
+		},
+		"Vprn": {
+			o:    testConfigureRouterInterface("itf-1", "", "customer-1", "to-ce1", 0),
+			want: "/configure/service/vprn[service-name=customer-1]/interface[interface-name=to-ce1]",
+		},
+		"RouterInstanceMissing": {
+			o:      testConfigureRouterInterface("itf-1", "", "", "system", 0),
+			errMsg: errRouterNameMissing,
+		},
+		"RouterInstanceAmbiguous": {
+			o:      testConfigureRouterInterface("itf-1", "Base", "customer-1", "system", 0),
+			errMsg: errRouterInstanceAmbiguous,
+		},
+		"InterfaceNameMissing": {
+			o:      testConfigureRouterInterface("itf-1", "Base", "", "", 0),
+			errMsg: errInterfaceNameMissing,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := getRootPathConfigureRouterInterface(tc.o)
+			if tc.errMsg != "" {
+				if err == nil || err.Error() != tc.errMsg {
+					t.Errorf("getRootPathConfigureRouterInterface(): got error %v, want %q", err, tc.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getRootPathConfigureRouterInterface(): %v", err)
+			}
+			if xpath := *p.GnmiPathToXPath(got[0], true); xpath != tc.want {
+				t.Errorf("getRootPathConfigureRouterInterface(): got %s, want %s", xpath, tc.want)
+			}
+		})
+	}
+}
+
+// TestValidateValuesConfigureRouterInterface validates interfaces against the
+// interfaces of the other resources, only the newer resource of two
+// conflicting resources fails the value validation
+func TestValidateValuesConfigureRouterInterface(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := srosv1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	other := testConfigureRouterInterface("other-node", "Base", "", "to-sr3", 10, "10.0.0.1/24")
+	other.Spec.NetworkNodeReference = &nddv1.Reference{Name: "sr2"}
+	deleted := testConfigureRouterInterface("deleted", "Base", "", "to-sr4", 10, "10.0.0.1/24")
+	deleted.SetDeletionTimestamp(&metav1.Time{Time: testCreatedConfigureRouterInterface})
+	deleted.SetFinalizers([]string{"finalizer.managedresource.ndd.yndd.io"})
+	objs := []*srosv1alpha1.SrosConfigureRouterInterface{
+		testConfigureRouterInterface("base-a", "Base", "", "to-sr2", 5, "10.0.0.1/24", "10.1.0.1/24"),
+		testConfigureRouterInterface("vprn-a", "", "customer-1", "to-ce1", 5, "10.0.0.1/24"),
+		other,
+		deleted,
+	}
+
+	cases := map[string]struct {
+		o      *srosv1alpha1.SrosConfigureRouterInterface
+		errMsg string
+	}{
+		"NoConflict": {
+			o: testConfigureRouterInterface("base-b", "Base", "", "to-sr3", 1, "10.2.0.1/24"),
+		},
+		"OverlapPrimary": {
+			o:      testConfigureRouterInterface("base-b", "Base", "", "to-sr3", 1, "10.0.0.2/24"),
+			errMsg: "subnet 10.0.0.2/24 of interface to-sr3 overlaps with subnet 10.0.0.1/24 of interface to-sr2 in router Base",
+		},
+		"OverlapSecondary": {
+			o:      testConfigureRouterInterface("base-b", "Base", "", "to-sr3", 1, "10.2.0.1/24", "10.1.0.0/16"),
+			errMsg: "subnet 10.1.0.0/16 of interface to-sr3 overlaps with subnet 10.1.0.1/24 of interface to-sr2 in router Base",
+		},
+		"DuplicateInterfaceName": {
+			o:      testConfigureRouterInterface("base-b", "Base", "", "to-sr2", 1, "10.0.0.1/24"),
+			errMsg: "interface to-sr2 in router Base is defined by another ConfigureRouterInterface",
+		},
+		// the older resource keeps the interface and its subnets
+		"OlderResource": {
+			o: testConfigureRouterInterface("base-b", "Base", "", "to-sr2", 6, "10.0.0.1/24"),
+		},
+		"OtherRouterInstance": {
+			o: testConfigureRouterInterface("base-b", "management", "", "to-sr2", 1, "10.0.0.1/24"),
+		},
+		"VprnOverlap": {
+			o:      testConfigureRouterInterface("vprn-b", "", "customer-1", "to-ce2", 1, "10.0.0.5/30"),
+			errMsg: "subnet 10.0.0.5/30 of interface to-ce2 overlaps with subnet 10.0.0.1/24 of interface to-ce1 in vprn customer-1",
+		},
+		"VprnDuplicateInterfaceName": {
+			o:      testConfigureRouterInterface("vprn-b", "", "customer-1", "to-ce1", 1),
+			errMsg: "interface to-ce1 in vprn customer-1 is defined by another ConfigureRouterInterface",
+		},
+		"OtherVprn": {
+			o: testConfigureRouterInterface("vprn-b", "", "customer-2", "to-ce1", 1, "10.0.0.1/24"),
+		},
+		"RouterInstanceMissing": {
+			o:      testConfigureRouterInterface("base-b", "", "", "to-sr3", 1),
+			errMsg: errRouterNameMissing,
+		},
+		"InvalidValue": {
+			o:      testConfigureRouterInterface("base-b", "Base", "", "to-sr3", 1, "10.2.0.1/33"),
+			errMsg: "prefix-length: 33 is not in range 0..32",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := fake.NewClientBuilder().WithScheme(s)
+			for _, o := range objs {
+				b = b.WithObjects(o.DeepCopy())
+			}
+			v := &validatorConfigureRouterInterface{log: logging.NewNopLogger(), kube: b.Build(), parser: *parser.NewParser()}
+
+			msgs, err := v.ValidateValues(context.Background(), tc.o)
+			if err != nil {
+				t.Fatalf("ValidateValues(): %v", err)
+			}
+			if tc.errMsg == "" {
+				if len(msgs) != 0 {
+					t.Errorf("ValidateValues(): got %v, want no violations", msgs)
+				}
+				return
+			}
+			if !strings.Contains(strings.Join(msgs, "; "), tc.errMsg) {
+				t.Errorf("ValidateValues(): got %v, want %q", msgs, tc.errMsg)
+			}
+		})
+	}
+}
+
+// TestCreateConfigureRouterInterfaceConfigSiblings validates an interface
+// against the interfaces in the configuration of the device, which are not
+// managed by a ConfigureRouterInterface, a conflicting interface is not pushed
+func TestCreateConfigureRouterInterfaceConfigSiblings(t *testing.T) {
+	cfg := unmarshalTestData(t, `{"configure":{
+		"router":[{"router-name":"Base","interface":[
+			{"interface-name":"system","ipv4":{"primary":{"address":"192.0.2.1","prefix-length":32}}},
+			{"interface-name":"to-sr2","ipv4":{"primary":{"address":"10.0.0.1","prefix-length":24}}}
+		]}],
+		"service":{"vprn":[{"service-name":"customer-1","interface":[
+			{"interface-name":"to-ce1","ipv6":{"address":[{"ipv6-address":"2001:db8::1","prefix-length":64}]}}
+		]}]}
+	}}`)
+	cases := map[string]struct {
+		o      *srosv1alpha1.SrosConfigureRouterInterface
+		errMsg string
+	}{
+		"NoConflict": {
+			o: testConfigureRouterInterface("itf", "Base", "", "to-sr3", 0, "10.1.0.1/24"),
+		},
+		// the interface of the resource itself is updated
+		"SameInterface": {
+			o: testConfigureRouterInterface("itf", "Base", "", "to-sr2", 0, "10.0.0.1/24"),
+		},
+		"Overlap": {
+			o:      testConfigureRouterInterface("itf", "Base", "", "to-sr3", 0, "10.2.0.1/24", "192.0.2.0/24"),
+			errMsg: "subnet 192.0.2.0/24 of interface to-sr3 overlaps with subnet 192.0.2.1/32 of interface system in router Base",
+		},
+		"OtherRouterInstance": {
+			o: testConfigureRouterInterface("itf", "", "customer-1", "to-ce2", 0, "10.0.0.1/24"),
+		},
+		"VprnOverlap": {
+			o:      testConfigureRouterInterface("itf", "", "customer-1", "to-ce2", 0, "10.0.0.1/24"),
+			errMsg: "overlaps with subnet 2001:db8::1/64 of interface to-ce1 in vprn customer-1",
+		},
+	}
+	pl := uint8(64)
+	cases["VprnOverlap"].o.Spec.ForNetworkNode.SrosConfigureRouterInterface.Ipv6 = &srosv1alpha1.ConfigureRouterInterfaceIpv6{
+		Address: []*srosv1alpha1.ConfigureRouterInterfaceIpv6Address{{Ipv6Address: utils.StringPtr("2001:db8::2"), PrefixLength: &pl}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dd := gnmitest.NewDeviceDriver(t, cfg)
+			e := &externalConfigureRouterInterface{client: newTestClient(t, dd), targets: []string{"sr1"}, log: logging.NewNopLogger(), parser: *parser.NewParser()}
+
+			_, err := e.Create(context.Background(), tc.o)
+			if tc.errMsg == "" {
+				if err != nil {
+					t.Fatalf("Create(): %v", err)
+				}
+				if len(dd.Paths()) == 0 {
+					t.Error("Create(): the interface is not pushed")
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+				t.Fatalf("Create(): got error %v, want %q", err, tc.errMsg)
+			}
+			if c := tc.o.GetCondition(srosv1alpha1.ConditionKindValueValidation); !strings.Contains(c.Message, tc.errMsg) {
+				t.Errorf("Create(): got value validation condition %s: %s, want %q", c.Status, c.Message, tc.errMsg)
+			}
+			if paths := dd.Paths(); len(paths) != 0 {
+				t.Errorf("Create(): a conflicting interface must not be pushed, got %v", paths)
+			}
+		})
+	}
+}
+
+func TestValidateParentDependencyConfigureRouterInterface(t *testing.T) {
+	v := &validatorConfigureRouterInterface{log: logging.NewNopLogger(), parser: *parser.NewParser()}
+	cfg := `{"configure":{"service":{"vprn":[{"service-name":"customer-1"}]}}}`
+	cases := map[string]struct {
+		o       *srosv1alpha1.SrosConfigureRouterInterface
+		cfg     string
+		success bool
+		errMsg  string
+	}{
+		"BaseRouter": {
+			o:       testConfigureRouterInterface("itf-1", "Base", "", "system", 0),
+			cfg:     cfg,
+			success: true,
+		},
+		"VprnExists": {
+			o:       testConfigureRouterInterface("itf-1", "", "customer-1", "to-ce1", 0),
+			cfg:     cfg,
+			success: true,
+		},
+		"VprnMissing": {
+			o:   testConfigureRouterInterface("itf-1", "", "customer-2", "to-ce1", 0),
+			cfg: cfg,
+		},
+		"InvalidConfig": {
+			o:      testConfigureRouterInterface("itf-1", "", "customer-1", "to-ce1", 0),
+			cfg:    `{"configure":`,
+			errMsg: errJSONUnMarshal,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := v.ValidateParentDependency(context.Background(), tc.o, []byte(tc.cfg))
+			if obs.Success != tc.success {
+				t.Errorf("ValidateParentDependency(): success %t, want %t", obs.Success, tc.success)
+			}
+			if tc.errMsg == "" && err != nil {
+				t.Errorf("ValidateParentDependency(): %v", err)
+			}
+			if tc.errMsg != "" && (err == nil || !strings.Contains(err.Error(), tc.errMsg)) {
+				t.Errorf("ValidateParentDependency(): got error %v, want %q", err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/karimra/gnmic/target"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/yndd/ndd-yang/pkg/parser"
+)
+
+const (
+	// time the state data of a path is cached
+	defaultStateTTL = 1 * time.Minute
+)
+
+// stateCache caches the state data of the network nodes per path, such that
+// the state is read at most once per ttl rather than on every observation of
+// a resource. A nil stateCache reads the state on every call.
+type stateCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]stateEntry
+}
+
+// stateEntry is the cached state data of a path, or the error reading it
+type stateEntry struct {
+	x       interface{}
+	err     error
+	expires time.Time
+}
+
+// newStateCache returns a state cache with the ttl
+func newStateCache(ttl time.Duration) *stateCache {
+	return &stateCache{
+		ttl:     ttl,
+		entries: make(map[string]stateEntry),
+	}
+}
+
+// get returns the state data at the path of the state tree of the network
+// node from the cache, the state is read when it is not cached or expired.
+// Errors are cached as well, such that a network node that does not serve the
+// state is not asked on every observation.
+func (s *stateCache) get(ctx context.Context, c *target.Target, p *parser.Parser, path *gnmi.Path) (interface{}, error) {
+	if s == nil {
+		return getState(ctx, c, p, path)
+	}
+	name := ""
+	if c.Config != nil {
+		name = c.Config.Name
+	}
+	key := name + *p.GnmiPathToXPath(path, true)
+	now := time.Now()
+
+	s.mu.Lock()
+	e, ok := s.entries[key]
+	s.mu.Unlock()
+	if ok && now.Before(e.expires) {
+		return e.x, e.err
+	}
+
+	x, err := getState(ctx, c, p, path)
+	// a cancelled read says nothing about the state of the network node
+	if ctx.Err() != nil {
+		return x, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, e := range s.entries {
+		if !now.Before(e.expires) {
+			delete(s.entries, k)
+		}
+	}
+	s.entries[key] = stateEntry{x: x, err: err, expires: now.Add(s.ttl)}
+	return x, err
+}
+
+// getState returns the state data at the path of the state tree of the network
+// node, or nil when the network node has no data at the path. The state of a
+// resource is informational, the callers keep the last observation when it
+// cannot be read and the observation of the config does not fail.
+//
+// The get has no gnmi extension, so it is not answered from the config cache
+// of the device driver. The device driver of the network node has to serve
+// gets of the state tree from the device, a device driver that does not
+// returns an error or no data and the status of the resources is not updated.
+func getState(ctx context.Context, c *target.Target, p *parser.Parser, path *gnmi.Path) (interface{}, error) {
+	resp, err := c.Get(ctx, &gnmi.GetRequest{
+		Path:     []*gnmi.Path{path},
+		Encoding: gnmi.Encoding_JSON,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range resp.GetNotification() {
+		for _, u := range n.GetUpdate() {
+			return p.GetValue(u.GetVal())
+		}
+	}
+	return nil, nil
+}
+
+// stateValue returns the value at the path in the state data or nil when it is
+// not present, the module prefixes of json ietf encoded names are ignored
+func stateValue(x interface{}, path ...string) interface{} {
+	for _, name := range path {
+		m, ok := x.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		x = nil
+		for k, v := range m {
+			if k == name || strings.HasSuffix(k, ":"+name) {
+				x = v
+				break
+			}
+		}
+	}
+	return x
+}
+
+// stateString returns the value at the path in the state data as a string,
+// decimal64 values are json encoded as strings or as numbers
+func stateString(x interface{}, path ...string) *string {
+	var s string
+	switch v := stateValue(x, path...).(type) {
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		s = strconv.FormatBool(v)
+	default:
+		return nil
+	}
+	return &s
+}
+
+// stateUint32 returns the value at the path in the state data as an uint32
+func stateUint32(x interface{}, path ...string) *uint32 {
+	var n uint32
+	switch v := stateValue(x, path...).(type) {
+	case float64:
+		n = uint32(v)
+	case string:
+		u, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil
+		}
+		n = uint32(u)
+	default:
+		return nil
+	}
+	return &n
+}
+
+func uint32Value(n *uint32) uint32 {
+	if n == nil {
+		return 0
+	}
+	return *n
+}
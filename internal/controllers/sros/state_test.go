@@ -0,0 +1,73 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/yndd/ndd-yang/pkg/parser"
+
+	"github.com/yndd/ndd-provider-sros/internal/gnmitest"
+)
+
+// TestStateCache reads the state of a port through the cache, a cancelled
+// context fails every read of the device driver, such that a value shows it is
+// served from the cache
+func TestStateCache(t *testing.T) {
+	ctx := context.Background()
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	dd := gnmitest.NewDeviceDriver(t, unmarshalTestData(t, `{"port":[{"port-id":"1/1/1","oper-state":"up"}]}`))
+	cl := newTestClient(t, dd)
+	p := parser.NewParser()
+	path := &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "state"}, {Name: "port", Key: map[string]string{"port-id": "1/1/1"}}}}
+
+	cases := map[string]struct {
+		states *stateCache
+		cached bool
+	}{
+		"Cached":  {states: newStateCache(time.Hour), cached: true},
+		"Expired": {states: newStateCache(0)},
+		"NoCache": {},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// a cancelled read is not cached
+			if _, err := tc.states.get(cctx, cl, p, path); err == nil {
+				t.Fatal("get(): a cancelled read of the device driver must fail")
+			}
+			x, err := tc.states.get(ctx, cl, p, path)
+			if err != nil {
+				t.Fatalf("get(): %v", err)
+			}
+			if s := getObservationConfigurePort(x).OperState; s == nil || *s != "up" {
+				t.Fatalf("get(): got %v, want the state of the port", x)
+			}
+
+			x, err = tc.states.get(cctx, cl, p, path)
+			if tc.cached && (err != nil || x == nil) {
+				t.Errorf("get(): the state must be served from the cache, got %v, %v", x, err)
+			}
+			if !tc.cached && err == nil {
+				t.Error("get(): the state must be read from the device driver")
+			}
+		})
+	}
+}
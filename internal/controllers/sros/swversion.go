@@ -0,0 +1,112 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// swVersionRegexp matches the release in an SR OS software version, e.g.
+	// 21.10.R1 in TiMOS-B-21.10.R1
+	swVersionRegexp = regexp.MustCompile(`(\d+)\.(\d+)(?:\.R(\d+))?`)
+	// swVersionConstraintRegexp matches a release constraint, e.g. >=21.7
+	swVersionConstraintRegexp = regexp.MustCompile(`^(=|!=|>=|<=|>|<)?\s*(\d+)(?:\.(\d+)(?:\.R(\d+))?)?$`)
+)
+
+// swVersionConstraint is a constraint on the SR OS release, the release is
+// compared up to the precision of the constraint, such that 21 matches every
+// 21.x release and 21.10 every 21.10.Rx release
+type swVersionConstraint struct {
+	op      string
+	release []int
+}
+
+// parseSwVersionConstraints parses comma separated release constraints
+func parseSwVersionConstraints(s string) ([]swVersionConstraint, error) {
+	constraints := make([]swVersionConstraint, 0)
+	for _, c := range strings.Split(s, ",") {
+		c = strings.TrimSpace(c)
+		m := swVersionConstraintRegexp.FindStringSubmatch(c)
+		if m == nil {
+			return nil, fmt.Errorf("invalid release constraint %q, expected an optional operator followed by major[.minor[.Rn]]", c)
+		}
+		constraint := swVersionConstraint{op: m[1], release: make([]int, 0, 3)}
+		if constraint.op == "" {
+			constraint.op = "="
+		}
+		for _, v := range m[2:] {
+			if v == "" {
+				break
+			}
+			n, _ := strconv.Atoi(v)
+			constraint.release = append(constraint.release, n)
+		}
+		constraints = append(constraints, constraint)
+	}
+	return constraints, nil
+}
+
+// matchSwVersion returns true when the release of the software version matches
+// all constraints, a software version without a release never matches
+func matchSwVersion(swVersion string, constraints []swVersionConstraint) bool {
+	m := swVersionRegexp.FindStringSubmatch(swVersion)
+	if m == nil {
+		return false
+	}
+	release := make([]int, 0, 3)
+	for _, v := range m[1:] {
+		n, _ := strconv.Atoi(v)
+		release = append(release, n)
+	}
+	for _, c := range constraints {
+		if !c.match(release) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c swVersionConstraint) match(release []int) bool {
+	cmp := 0
+	for i, n := range c.release {
+		if release[i] != n {
+			if release[i] < n {
+				cmp = -1
+			} else {
+				cmp = 1
+			}
+			break
+		}
+	}
+	switch c.op {
+	case "!=":
+		return cmp != 0
+	case ">=":
+		return cmp >= 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case "<":
+		return cmp < 0
+	}
+	return cmp == 0
+}
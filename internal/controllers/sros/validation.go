@@ -0,0 +1,93 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/meta"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+// A valueValidator validates the values of a resource, e.g. against the
+// constraints of the yang model, and returns a message per violation.
+type valueValidator interface {
+	ValidateValues(ctx context.Context, mg resource.Managed) ([]string, error)
+}
+
+// valueValidatingClient validates the values of the resource before it is
+// observed. The leafref and parent validation of the reconciler delete the
+// resource from the device when they fail, a resource with invalid values is
+// therefore reported as not ready instead, such that the reconciler requeues
+// it without deleting the configuration on the device or pushing the invalid
+// values. The violations are reported in the ValueValidationSuccess condition.
+type valueValidatingClient struct {
+	managed.ExternalClient
+	validator valueValidator
+}
+
+// withValueValidation returns the external client which validates the values
+// of the resource before it is observed
+func withValueValidation(e managed.ExternalClient, v valueValidator) managed.ExternalClient {
+	return &valueValidatingClient{ExternalClient: e, validator: v}
+}
+
+func (c *valueValidatingClient) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	// a resource that is deleted is removed from the device, whatever its values
+	if meta.WasDeleted(mg) {
+		return c.ExternalClient.Observe(ctx, mg)
+	}
+	msgs, err := c.validator.ValidateValues(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if len(msgs) != 0 {
+		mg.SetConditions(srosv1alpha1.ValueValidationFailure(strings.Join(msgs, "; ")), nddv1.Unavailable())
+		return managed.ExternalObservation{Ready: false}, nil
+	}
+	mg.SetConditions(srosv1alpha1.ValueValidationSuccess())
+	return c.ExternalClient.Observe(ctx, mg)
+}
+
+// getSpecData returns the json data of the spec of a resource
+func getSpecData(spec interface{}) (interface{}, error) {
+	d, err := json.Marshal(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, errJSONMarshal)
+	}
+	var x interface{}
+	if err := json.Unmarshal(d, &x); err != nil {
+		return nil, errors.Wrap(err, errJSONUnMarshal)
+	}
+	return x, nil
+}
+
+// constraintViolationMessages returns a message per constraint violation
+func constraintViolationMessages(violations []constraintViolation) []string {
+	msgs := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		msgs = append(msgs, violation.String())
+	}
+	return msgs
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
+	"github.com/yndd/ndd-runtime/pkg/reconciler/managed"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+type valueValidatorFn func(ctx context.Context, mg resource.Managed) ([]string, error)
+
+func (fn valueValidatorFn) ValidateValues(ctx context.Context, mg resource.Managed) ([]string, error) {
+	return fn(ctx, mg)
+}
+
+// observeFn is an external client that observes the resource with the function
+type observeFn struct {
+	managed.NopClient
+	fn func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error)
+}
+
+func (c *observeFn) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	return c.fn(ctx, mg)
+}
+
+// TestValueValidatingClientObserve checks that a resource with invalid values
+// is not observed and reported as not ready, such that the reconciler neither
+// deletes nor pushes its configuration
+func TestValueValidatingClientObserve(t *testing.T) {
+	cases := map[string]struct {
+		msgs      []string
+		err       error
+		deleted   bool
+		observed  bool
+		want      managed.ExternalObservation
+		condition corev1.ConditionStatus
+	}{
+		"Valid": {
+			observed:  true,
+			want:      managed.ExternalObservation{Ready: true, ResourceExists: true},
+			condition: corev1.ConditionTrue,
+		},
+		"Invalid": {
+			msgs:      []string{"/lag[lag-name=lag-1]/mode: x is not one of access, network, hybrid"},
+			want:      managed.ExternalObservation{Ready: false},
+			condition: corev1.ConditionFalse,
+		},
+		// a deleted resource is removed from the device, whatever its values
+		"InvalidDeleted": {
+			msgs:      []string{"/lag[lag-name=lag-1]/mode: x is not one of access, network, hybrid"},
+			deleted:   true,
+			observed:  true,
+			want:      managed.ExternalObservation{Ready: true, ResourceExists: true},
+			condition: corev1.ConditionUnknown,
+		},
+		"Error": {
+			err: errors.New("boom"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := testConfigureLag("lag-1", "")
+			if tc.deleted {
+				o.SetDeletionTimestamp(&metav1.Time{Time: testCreatedConfigureRouterInterface})
+			}
+			observed := false
+			e := withValueValidation(&observeFn{
+				fn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+					observed = true
+					return managed.ExternalObservation{Ready: true, ResourceExists: true}, nil
+				},
+			}, valueValidatorFn(func(ctx context.Context, mg resource.Managed) ([]string, error) {
+				return tc.msgs, tc.err
+			}))
+
+			got, err := e.Observe(context.Background(), o)
+			if tc.err != nil {
+				if err == nil {
+					t.Error("Observe(): the error of the validator must be returned")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Observe(): %v", err)
+			}
+			if observed != tc.observed {
+				t.Errorf("Observe(): observed %t, want %t", observed, tc.observed)
+			}
+			if got.Ready != tc.want.Ready || got.ResourceExists != tc.want.ResourceExists {
+				t.Errorf("Observe(): got %+v, want %+v", got, tc.want)
+			}
+			c := o.GetCondition(srosv1alpha1.ConditionKindValueValidation)
+			if c.Status != tc.condition {
+				t.Errorf("Observe(): got value validation condition %s: %s, want %s", c.Status, c.Message, tc.condition)
+			}
+			if len(tc.msgs) != 0 && !tc.deleted {
+				if c.Message != tc.msgs[0] {
+					t.Errorf("Observe(): got message %q, want %q", c.Message, tc.msgs[0])
+				}
+				if r := o.GetCondition(nddv1.ConditionKindReady); r.Status != corev1.ConditionFalse {
+					t.Errorf("Observe(): got ready condition %s, want %s", r.Status, corev1.ConditionFalse)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sros
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+const (
+	// Errors
+	errDecodeAdmissionRequest = "cannot decode admission request"
+)
+
+// specPath is the field path of the parameters that are configured on the
+// network node
+var specPath = field.NewPath("spec", "forNetworkNode")
+
+// denied returns an admission response that rejects the request with the
+// field errors, such that the api server reports every invalid field
+func denied(kind, name string, errs field.ErrorList) admission.Response {
+	err := apierrors.NewInvalid(schema.GroupKind{Group: srosv1alpha1.Group, Kind: kind}, name, errs)
+	return admission.Response{
+		AdmissionResponse: admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result:  &err.ErrStatus,
+		},
+	}
+}
+
+// decodeRequest decodes the object of the admission request and for updates
+// the old object, a non nil response is returned when decoding fails
+func decodeRequest(d *admission.Decoder, req admission.Request, o, old runtime.Object) *admission.Response {
+	if err := d.Decode(req, o); err != nil {
+		resp := admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecodeAdmissionRequest))
+		return &resp
+	}
+	if req.Operation != admissionv1.Update {
+		return nil
+	}
+	if err := d.DecodeRaw(req.OldObject, old); err != nil {
+		resp := admission.Errored(http.StatusBadRequest, errors.Wrap(err, errDecodeAdmissionRequest))
+		return &resp
+	}
+	return nil
+}
+
+// constraintViolationsToFieldErrors returns a field error for every leaf that
+// violates the constraints of the yang model
+func constraintViolationsToFieldErrors(violations []constraintViolation) field.ErrorList {
+	errs := field.ErrorList{}
+	for _, v := range violations {
+		fp := specPath
+		for _, elem := range v.elems {
+			fp = fp.Child(elem)
+		}
+		errs = append(errs, field.Invalid(fp, v.value, v.detail))
+	}
+	return errs
+}
+
+// gnmiPathToField returns the field path of a gnmi path relative to base, the
+// keys of list entries are included in xpath notation
+func gnmiPathToField(base *field.Path, p *gnmi.Path) *field.Path {
+	fp := base
+	for _, elem := range p.GetElem() {
+		name := elem.GetName()
+		if len(elem.GetKey()) > 0 {
+			keyNames := make([]string, 0, len(elem.GetKey()))
+			for keyName := range elem.GetKey() {
+				keyNames = append(keyNames, keyName)
+			}
+			sort.Strings(keyNames)
+			keys := make([]string, 0, len(keyNames))
+			for _, keyName := range keyNames {
+				keys = append(keys, keyName+"="+elem.GetKey()[keyName])
+			}
+			name += "[" + strings.Join(keys, ",") + "]"
+		}
+		fp = fp.Child(name)
+	}
+	return fp
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gnmitest provides a fake device driver for the tests of the
+// controllers.
+package gnmitest
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
+	"github.com/yndd/ndd-runtime/pkg/gext"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// A DeviceDriver is a gnmi server that answers the requests of the controllers
+// the way the device driver of a network node does for resources that are not
+// configured yet: a get with a gnmi extension reports the resource as not
+// existing and a get without extension returns the configuration of the
+// network node. Every set request is recorded and applied to the leafs that
+// are configured by the resources.
+type DeviceDriver struct {
+	gnmi.UnimplementedGNMIServer
+	config []byte
+	lis    *bufconn.Listener
+
+	// Sets receives the set requests, requests are dropped when it is full
+	Sets chan *gnmi.SetRequest
+
+	mu     sync.Mutex
+	leafs  map[string]*gnmi.TypedValue
+	setErr error
+	// capabilities blocks the capabilities requests until it is closed,
+	// capabilitiesWaiting is signalled when a request waits
+	capabilities        <-chan struct{}
+	capabilitiesWaiting chan struct{}
+
+	// subscriptions is the number of open subscribe streams
+	subscriptions int32
+}
+
+// NewDeviceDriver starts a fake device driver serving the configuration on an
+// in-memory listener, the server is stopped when the test ends.
+func NewDeviceDriver(t *testing.T, config interface{}) *DeviceDriver {
+	t.Helper()
+	d, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dd := &DeviceDriver{
+		config: d,
+		lis:    bufconn.Listen(1024 * 1024),
+		Sets:   make(chan *gnmi.SetRequest, 16),
+		leafs:  make(map[string]*gnmi.TypedValue),
+	}
+	s := grpc.NewServer()
+	gnmi.RegisterGNMIServer(s, dd)
+	go s.Serve(dd.lis) // nolint:errcheck
+	t.Cleanup(s.Stop)
+	return dd
+}
+
+// Dialer returns a grpc dial option that connects every target to the fake
+// device driver, independent of the address of the target.
+func (dd *DeviceDriver) Dialer() grpc.DialOption {
+	return grpc.WithContextDialer(dd.Dial)
+}
+
+// Dial connects to the fake device driver, independent of the address.
+func (dd *DeviceDriver) Dial(context.Context, string) (net.Conn, error) {
+	return dd.lis.Dial()
+}
+
+// Paths returns the sorted xpaths that are configured by the set requests.
+func (dd *DeviceDriver) Paths() []string {
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+	paths := make([]string, 0, len(dd.leafs))
+	for p := range dd.leafs {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// FailSets makes the set requests fail with the error, a nil error makes them
+// succeed again.
+func (dd *DeviceDriver) FailSets(err error) {
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+	dd.setErr = err
+}
+
+// BlockCapabilities makes the capabilities requests wait until the channel is
+// closed or the request is cancelled, a nil channel unblocks them. The returned
+// channel is signalled when a request waits.
+func (dd *DeviceDriver) BlockCapabilities(ch <-chan struct{}) <-chan struct{} {
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+	dd.capabilities = ch
+	dd.capabilitiesWaiting = make(chan struct{}, 1)
+	return dd.capabilitiesWaiting
+}
+
+func (dd *DeviceDriver) Capabilities(ctx context.Context, req *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+	dd.mu.Lock()
+	ch, waiting := dd.capabilities, dd.capabilitiesWaiting
+	dd.mu.Unlock()
+	if ch != nil {
+		select {
+		case waiting <- struct{}{}:
+		default:
+		}
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return &gnmi.CapabilityResponse{}, nil
+}
+
+func (dd *DeviceDriver) Get(ctx context.Context, req *gnmi.GetRequest) (*gnmi.GetResponse, error) {
+	if len(req.GetExtension()) == 0 {
+		return &gnmi.GetResponse{
+			Notification: []*gnmi.Notification{{
+				Update: []*gnmi.Update{{
+					Path: &gnmi.Path{},
+					Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: dd.config}},
+				}},
+			}},
+		}, nil
+	}
+	meta, err := (&gext.GEXT{
+		Action:     gext.GEXTActionGet,
+		Status:     gext.ResourceStatusNone,
+		Exists:     false,
+		HasData:    false,
+		CacheReady: true,
+	}).String()
+	if err != nil {
+		return nil, err
+	}
+	return &gnmi.GetResponse{
+		Notification: []*gnmi.Notification{},
+		Extension:    []*gnmi_ext.Extension{RegisteredExtension(meta)},
+	}, nil
+}
+
+func (dd *DeviceDriver) Set(ctx context.Context, req *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+	dd.mu.Lock()
+	if dd.setErr != nil {
+		dd.mu.Unlock()
+		return nil, dd.setErr
+	}
+	for _, p := range req.GetDelete() {
+		dd.deleteLocked(XPath(p))
+	}
+	for _, u := range req.GetReplace() {
+		dd.deleteLocked(XPath(u.GetPath()))
+		dd.leafs[XPath(u.GetPath())] = u.GetVal()
+	}
+	for _, u := range req.GetUpdate() {
+		dd.leafs[XPath(u.GetPath())] = u.GetVal()
+	}
+	dd.mu.Unlock()
+
+	select {
+	case dd.Sets <- req:
+	default:
+	}
+	return &gnmi.SetResponse{Extension: req.GetExtension()}, nil
+}
+
+// Subscribe answers a subscription with a sync response and keeps the stream
+// open until the client closes it.
+func (dd *DeviceDriver) Subscribe(stream gnmi.GNMI_SubscribeServer) error {
+	atomic.AddInt32(&dd.subscriptions, 1)
+	defer atomic.AddInt32(&dd.subscriptions, -1)
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	if err := stream.Send(&gnmi.SubscribeResponse{
+		Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true},
+	}); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+// Subscriptions returns the number of open subscribe streams.
+func (dd *DeviceDriver) Subscriptions() int {
+	return int(atomic.LoadInt32(&dd.subscriptions))
+}
+
+// deleteLocked deletes the path and every path below it, a path to a list
+// without keys deletes all entries of the list
+func (dd *DeviceDriver) deleteLocked(p string) {
+	for leaf := range dd.leafs {
+		if leaf == p || strings.HasPrefix(leaf, p+"/") || strings.HasPrefix(leaf, p+"[") {
+			delete(dd.leafs, leaf)
+		}
+	}
+}
+
+// RegisteredExtension returns the gnmi extension in which the device driver
+// and the controllers exchange the gext information.
+func RegisteredExtension(msg string) *gnmi_ext.Extension {
+	return &gnmi_ext.Extension{Ext: &gnmi_ext.Extension_RegisteredExt{
+		RegisteredExt: &gnmi_ext.RegisteredExtension{Id: gnmi_ext.ExtensionID_EID_EXPERIMENTAL, Msg: []byte(msg)}}}
+}
+
+// XPath returns the xpath of a gnmi path, the keys of an element are sorted
+// such that the xpath of a path is stable.
+func XPath(p *gnmi.Path) string {
+	sb := strings.Builder{}
+	for _, elem := range p.GetElem() {
+		sb.WriteString("/")
+		sb.WriteString(elem.GetName())
+		keys := make([]string, 0, len(elem.GetKey()))
+		for k := range elem.GetKey() {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			sb.WriteString("[" + k + "=" + elem.GetKey()[k] + "]")
+		}
+	}
+	if sb.Len() == 0 {
+		return "/"
+	}
+	return sb.String()
+}
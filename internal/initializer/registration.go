@@ -18,33 +18,127 @@ package initializer
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
 	nddv1 "github.com/yndd/ndd-runtime/apis/common/v1"
-	"github.com/yndd/ndd-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
 )
 
 const (
-	errApplyRegistration = "cannot apply Registration object"
+	// name of the Registration object
+	registrationName = "sros-registrations"
+
+	// keys of the ConfigMap that overrides the default paths of the
+	// Registration object, every line of a key holds one xpath
+	registrationConfigMapSubscriptions          = "subscriptions"
+	registrationConfigMapExceptionPaths         = "exceptionPaths"
+	registrationConfigMapExplicitExceptionPaths = "explicitExceptionPaths"
+	// keys of the ConfigMap that restrict the network nodes of the Registration
+	// object, the selector uses the label selector syntax of kubectl and the
+	// software version holds release constraints, e.g. >=21.7
+	registrationConfigMapNetworkNodeSelector = "networkNodeSelector"
+	registrationConfigMapSwVersion           = "swVersion"
+
+	// errors
+	errApplyRegistration        = "cannot apply Registration object"
+	errGetRegistration          = "cannot get Registration object"
+	errGetRegistrationConfigMap = "cannot get Registration ConfigMap"
+	errParseNetworkNodeSelector = "cannot parse network node selector of Registration ConfigMap"
 )
 
+var (
+	// defaultSubscriptions are the SR OS configuration trees the device driver
+	// reports changes for
+	defaultSubscriptions = []string{
+		"/configure/card",
+		"/configure/port",
+		"/configure/port-xc",
+		"/configure/port-policy",
+		"/configure/lag",
+		"/configure/router",
+		"/configure/service",
+		"/configure/qos",
+		"/configure/filter",
+		"/configure/policy-options",
+		"/configure/system",
+	}
+	// defaultExceptionPaths are the management parts of the SR OS configuration
+	// that are ignored, such that the provider never removes the access to the
+	// device
+	defaultExceptionPaths = []string{
+		"/configure/system/management-interface",
+		"/configure/system/security",
+		"/configure/system/grpc",
+		"/configure/router[router-name=management]",
+		"/configure/port[port-id=A/1]",
+	}
+	// defaultExplicitExceptionPaths are the roots of the subscriptions, which
+	// only change together with the resources below them
+	defaultExplicitExceptionPaths = []string{
+		"/configure/router",
+		"/configure/service",
+		"/configure/qos",
+		"/configure/filter",
+		"/configure/policy-options",
+		"/configure/system",
+	}
+)
+
+// RegistrationObjectOption configures the RegistrationObject initializer.
+type RegistrationObjectOption func(*RegistrationObject)
+
+// WithRegistrationConfigMap overrides the default paths of the Registration
+// object with the paths in the ConfigMap, when it exists.
+func WithRegistrationConfigMap(namespace, name string) RegistrationObjectOption {
+	return func(lo *RegistrationObject) {
+		lo.configMap = types.NamespacedName{Namespace: namespace, Name: name}
+	}
+}
+
 // NewRegistrationObject returns a new *RegistrationObject initializer.
-func NewRegistrationObject() *RegistrationObject {
-	return &RegistrationObject{}
+func NewRegistrationObject(opts ...RegistrationObjectOption) *RegistrationObject {
+	lo := &RegistrationObject{}
+	for _, o := range opts {
+		o(lo)
+	}
+	return lo
 }
 
 // RegistrationObject has the initializer for creating the Registration object.
-type RegistrationObject struct{}
+// The ConfigMap is read when the initializer runs, SetupWithManager applies
+// later changes of the ConfigMap to the Registration object.
+type RegistrationObject struct {
+	// configMap overrides the default paths, the keys subscriptions,
+	// exceptionPaths and explicitExceptionPaths hold one xpath per line and a
+	// missing key keeps the default. The keys networkNodeSelector and swVersion
+	// restrict the network nodes, by default all network nodes are registered.
+	configMap types.NamespacedName
+}
 
 // Run makes sure Registration object exists.
 func (lo *RegistrationObject) Run(ctx context.Context, kube client.Client) error {
+	cm, err := lo.getConfigMap(ctx, kube)
+	if err != nil {
+		return err
+	}
+	selector, err := getNetworkNodeSelector(cm)
+	if err != nil {
+		return err
+	}
 	l := &srosv1alpha1.Registration{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "sros-registrations",
+			Name: registrationName,
 			//OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(&d.ObjectMeta, appsv1.SchemeGroupVersion.WithKind("Deployment")))},
 		},
 		Spec: srosv1alpha1.RegistrationSpec{
@@ -55,54 +149,112 @@ func (lo *RegistrationObject) Run(ctx context.Context, kube client.Client) error
 				DeletionPolicy: nddv1.DeletionDelete,
 				Active:         true,
 			},
-			ForNetworkNode: srosv1alpha1.RegistrationParameters{
-				Subscriptions: []string{
-					"/acl",
-					"/bfd",
-					"/interface",
-					"/network-instance",
-					"/platform",
-					"/qos",
-					"/routing-policy",
-					"/tunnel",
-					"/tunnel-interface",
-					"/system/snmp",
-					"/system/sflow",
-					"/system/ntp",
-					"/system/network-instance",
-					"/system/name",
-					"/system/mtu",
-					"/system/maintenance",
-					"/system/lldp",
-					"/system/lacp",
-					"/system/authentication",
-					"/system/banner",
-					"/system/bridge-table",
-					"/system/ftp-server",
-					"/system/ip-load-balancing",
-					"/system/json-rpc-server",
-				},
-				ExceptionPaths: []string{
-					"/interface[name=mgmt0]",
-					"/network-instance[name=mgmt]",
-					"/system/gnmi-server",
-					"/system/tls",
-					"/system/ssh-server",
-					"/system/aaa",
-					"/system/logging",
-					"/acl/cpm-filter",
-				},
-				ExplicitExceptionPaths: []string{
-					"/acl",
-					"/bfd",
-					"/platform",
-					"/qos",
-					"/routing-policy",
-					"/system",
-					"/tunnel",
-				},
-			},
+			NetworkNodeSelector: selector,
+			SwVersion:           getSwVersion(cm),
+			ForNetworkNode:      getParameters(cm),
 		},
 	}
-	return errors.Wrap(resource.NewAPIPatchingApplicator(kube).Apply(ctx, l), errApplyRegistration)
+	// the spec is replaced rather than patched, such that keys removed from
+	// the ConfigMap fall back to the defaults
+	cur := &srosv1alpha1.Registration{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: registrationName}, cur); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return errors.Wrap(err, errGetRegistration)
+		}
+		return errors.Wrap(kube.Create(ctx, l), errApplyRegistration)
+	}
+	cur.Spec = l.Spec
+	return errors.Wrap(kube.Update(ctx, cur), errApplyRegistration)
+}
+
+// SetupWithManager watches the ConfigMap and updates the Registration object
+// whenever the ConfigMap is created, changed or deleted, such that changes do
+// not require a restart of the provider.
+func (lo *RegistrationObject) SetupWithManager(mgr ctrl.Manager) error {
+	if lo.configMap.Namespace == "" || lo.configMap.Name == "" {
+		return nil
+	}
+	isConfigMap := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetNamespace() == lo.configMap.Namespace && o.GetName() == lo.configMap.Name
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("registration-configmap").
+		For(&corev1.ConfigMap{}, builder.WithPredicates(isConfigMap)).
+		Complete(reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{}, lo.Run(ctx, mgr.GetClient())
+		}))
+}
+
+// getConfigMap returns the ConfigMap that overrides the defaults of the
+// Registration object, an empty ConfigMap is returned when it does not exist
+func (lo *RegistrationObject) getConfigMap(ctx context.Context, kube client.Client) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if lo.configMap.Namespace == "" || lo.configMap.Name == "" {
+		return cm, nil
+	}
+	if err := kube.Get(ctx, lo.configMap, cm); err != nil {
+		if kerrors.IsNotFound(err) {
+			return &corev1.ConfigMap{}, nil
+		}
+		return nil, errors.Wrap(err, errGetRegistrationConfigMap)
+	}
+	return cm, nil
+}
+
+// getParameters returns the default paths of the Registration object, with the
+// keys that are present in the ConfigMap overridden
+func getParameters(cm *corev1.ConfigMap) srosv1alpha1.RegistrationParameters {
+	p := srosv1alpha1.RegistrationParameters{
+		Subscriptions:          defaultSubscriptions,
+		ExceptionPaths:         defaultExceptionPaths,
+		ExplicitExceptionPaths: defaultExplicitExceptionPaths,
+	}
+	if v, ok := cm.Data[registrationConfigMapSubscriptions]; ok {
+		p.Subscriptions = splitPaths(v)
+	}
+	if v, ok := cm.Data[registrationConfigMapExceptionPaths]; ok {
+		p.ExceptionPaths = splitPaths(v)
+	}
+	if v, ok := cm.Data[registrationConfigMapExplicitExceptionPaths]; ok {
+		p.ExplicitExceptionPaths = splitPaths(v)
+	}
+	return p
+}
+
+// getNetworkNodeSelector returns the network node selector of the ConfigMap or
+// nil when the Registration object applies to all network nodes
+func getNetworkNodeSelector(cm *corev1.ConfigMap) (*metav1.LabelSelector, error) {
+	v := strings.TrimSpace(cm.Data[registrationConfigMapNetworkNodeSelector])
+	if v == "" {
+		return nil, nil
+	}
+	selector, err := metav1.ParseToLabelSelector(v)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseNetworkNodeSelector)
+	}
+	return selector, nil
+}
+
+// getSwVersion returns the release constraints of the ConfigMap or nil when
+// the Registration object applies to all releases
+func getSwVersion(cm *corev1.ConfigMap) *string {
+	v := strings.TrimSpace(cm.Data[registrationConfigMapSwVersion])
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+// splitPaths returns the xpaths of a ConfigMap value, empty lines and lines
+// starting with # are skipped
+func splitPaths(v string) []string {
+	paths := make([]string, 0)
+	for _, line := range strings.Split(v, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		paths = append(paths, line)
+	}
+	return paths
 }
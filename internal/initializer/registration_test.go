@@ -0,0 +1,103 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package initializer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	srosv1alpha1 "github.com/yndd/ndd-provider-sros/apis/sros/v1alpha1"
+)
+
+// TestRegistrationObjectConfigMapChanges runs the initializer after the
+// ConfigMap is created, changed and deleted, as the ConfigMap watch does, and
+// checks that the Registration object follows the ConfigMap
+func TestRegistrationObjectConfigMapChanges(t *testing.T) {
+	ctx := context.Background()
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := srosv1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).Build()
+	lo := NewRegistrationObject(WithRegistrationConfigMap(testNamespace, "sros-registrations"))
+
+	run := func() *srosv1alpha1.Registration {
+		t.Helper()
+		if err := lo.Run(ctx, kube); err != nil {
+			t.Fatalf("Run(): %v", err)
+		}
+		r := &srosv1alpha1.Registration{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: registrationName}, r); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	r := run()
+	if !reflect.DeepEqual(r.Spec.ForNetworkNode.Subscriptions, defaultSubscriptions) || r.Spec.NetworkNodeSelector != nil {
+		t.Errorf("without ConfigMap the defaults must be registered, got %v", r.Spec)
+	}
+	// the managed reconciler adds a finalizer, which must survive updates
+	r.SetFinalizers([]string{"finalizer.ndd.yndd.io"})
+	if err := kube.Update(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "sros-registrations"},
+		Data: map[string]string{
+			registrationConfigMapSubscriptions:       "/configure/port\n# comment\n\n/configure/lag\n",
+			registrationConfigMapNetworkNodeSelector: "site=a",
+			registrationConfigMapSwVersion:           ">=21.7",
+		},
+	}
+	if err := kube.Create(ctx, cm); err != nil {
+		t.Fatal(err)
+	}
+	r = run()
+	if want := []string{"/configure/port", "/configure/lag"}; !reflect.DeepEqual(r.Spec.ForNetworkNode.Subscriptions, want) {
+		t.Errorf("subscriptions: got %v, want %v", r.Spec.ForNetworkNode.Subscriptions, want)
+	}
+	if r.Spec.NetworkNodeSelector == nil || r.Spec.SwVersion == nil || *r.Spec.SwVersion != ">=21.7" {
+		t.Errorf("the network nodes must be restricted, got %v", r.Spec)
+	}
+
+	if err := kube.Delete(ctx, cm); err != nil {
+		t.Fatal(err)
+	}
+	r = run()
+	if !reflect.DeepEqual(r.Spec.ForNetworkNode.Subscriptions, defaultSubscriptions) {
+		t.Errorf("subscriptions: got %v, want the defaults", r.Spec.ForNetworkNode.Subscriptions)
+	}
+	if r.Spec.NetworkNodeSelector != nil || r.Spec.SwVersion != nil {
+		t.Errorf("removed restrictions must be cleared, got %v", r.Spec)
+	}
+	if !reflect.DeepEqual(r.GetFinalizers(), []string{"finalizer.ndd.yndd.io"}) {
+		t.Errorf("finalizers: got %v", r.GetFinalizers())
+	}
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package initializer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	_ "embed" // the webhook configurations are embedded
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/yndd/ndd-runtime/pkg/resource"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// name of the webhook configurations, the service and the secret with
+	// the serving certificate of the webhook server
+	webhookName          = "ndd-provider-sros-webhook"
+	webhookTLSSecretName = "ndd-provider-sros-webhook-tls"
+	webhookServicePort   = 443
+
+	// label that ndd sets on the pods of a provider revision
+	labelPackageRevision = "pkg.ndd.yndd.io/revision"
+
+	// keys of the webhook tls secret and file names in the certificate
+	// directory of the webhook server
+	webhookTLSCA   = "ca.crt"
+	webhookTLSCert = "tls.crt"
+	webhookTLSKey  = "tls.key"
+
+	webhookCertValidity = 10 * 365 * 24 * time.Hour
+
+	// errors
+	errGetProviderPod       = "cannot get provider pod"
+	errNoRevisionLabel      = "provider pod has no package revision label"
+	errGetWebhookTLSSecret  = "cannot get webhook tls secret"
+	errCreateWebhookTLS     = "cannot create webhook tls secret"
+	errWriteWebhookTLS      = "cannot write webhook tls certificate"
+	errApplyWebhookService  = "cannot apply webhook service"
+	errDecodeWebhookConfigs = "cannot decode webhook configurations"
+	errApplyWebhookConfigs  = "cannot apply webhook configurations"
+)
+
+// webhookConfigurations are the webhook configurations that controller-gen
+// generates from the webhook markers of the controllers
+//
+//go:embed webhookconfigurations/manifests.yaml
+var webhookConfigurations []byte
+
+// WebhookConfigurationsOption configures the WebhookConfigurations
+// initializer.
+type WebhookConfigurationsOption func(*WebhookConfigurations)
+
+// WithWebhookPort sets the port the webhook server listens on, the service
+// forwards to it.
+func WithWebhookPort(port int) WebhookConfigurationsOption {
+	return func(w *WebhookConfigurations) {
+		w.port = port
+	}
+}
+
+// NewWebhookConfigurations returns a new *WebhookConfigurations initializer.
+func NewWebhookConfigurations(namespace, podName, certDir string, opts ...WebhookConfigurationsOption) *WebhookConfigurations {
+	w := &WebhookConfigurations{
+		namespace: namespace,
+		podName:   podName,
+		certDir:   certDir,
+		port:      9443,
+	}
+	for _, o := range opts {
+		o(w)
+	}
+	return w
+}
+
+// WebhookConfigurations has the initializer that makes the webhook server of
+// the provider reachable for the api server: it provides the serving
+// certificate in the certificate directory, the service that selects the
+// provider pods and the webhook configurations with the CA bundle. The
+// certificate is kept in a secret, such that all replicas of the provider
+// serve the same certificate.
+type WebhookConfigurations struct {
+	namespace string
+	podName   string
+	certDir   string
+	port      int
+}
+
+// Run makes sure the webhook server of the provider can be called by the api
+// server.
+func (w *WebhookConfigurations) Run(ctx context.Context, kube client.Client) error {
+	pod := &corev1.Pod{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: w.namespace, Name: w.podName}, pod); err != nil {
+		return errors.Wrap(err, errGetProviderPod)
+	}
+	revision, ok := pod.GetLabels()[labelPackageRevision]
+	if !ok {
+		return errors.Errorf("%s: %s", errNoRevisionLabel, labelPackageRevision)
+	}
+
+	s, err := w.getTLSSecret(ctx, kube)
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{webhookTLSCert, webhookTLSKey} {
+		if err := writeFile(w.certDir, name, s.Data[name]); err != nil {
+			return errors.Wrap(err, errWriteWebhookTLS)
+		}
+	}
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: w.namespace, Name: webhookName},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{labelPackageRevision: revision},
+			Ports: []corev1.ServicePort{{
+				Name:       "webhook",
+				Protocol:   corev1.ProtocolTCP,
+				Port:       webhookServicePort,
+				TargetPort: intstr.FromInt(w.port),
+			}},
+		},
+	}
+	if err := resource.NewAPIPatchingApplicator(kube).Apply(ctx, svc); err != nil {
+		return errors.Wrap(err, errApplyWebhookService)
+	}
+
+	configs, err := getWebhookConfigurations(webhookConfigurations, w.namespace, s.Data[webhookTLSCA])
+	if err != nil {
+		return err
+	}
+	for _, o := range configs {
+		if err := applyClusterScoped(ctx, kube, o); err != nil {
+			return errors.Wrap(err, errApplyWebhookConfigs)
+		}
+	}
+	return nil
+}
+
+// applyClusterScoped creates or replaces the cluster scoped object, the
+// patching applicator of ndd-runtime only handles namespaced objects
+func applyClusterScoped(ctx context.Context, kube client.Client, o client.Object) error {
+	cur, ok := o.DeepCopyObject().(client.Object)
+	if !ok {
+		return errors.Errorf("unexpected object type %T", o)
+	}
+	if err := kube.Get(ctx, types.NamespacedName{Name: o.GetName()}, cur); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+		return kube.Create(ctx, o)
+	}
+	o.SetResourceVersion(cur.GetResourceVersion())
+	return kube.Update(ctx, o)
+}
+
+// getTLSSecret returns the secret with the serving certificate of the
+// webhook server, the secret is created when it does not exist
+func (w *WebhookConfigurations) getTLSSecret(ctx context.Context, kube client.Client) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	nn := types.NamespacedName{Namespace: w.namespace, Name: webhookTLSSecretName}
+	err := kube.Get(ctx, nn, s)
+	if err == nil {
+		return s, nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, errors.Wrap(err, errGetWebhookTLSSecret)
+	}
+
+	ca, cert, key, err := newServingCertificate(rand.Reader, webhookName, w.namespace, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateWebhookTLS)
+	}
+	s = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: w.namespace, Name: webhookTLSSecretName},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			webhookTLSCA:   ca,
+			webhookTLSCert: cert,
+			webhookTLSKey:  key,
+		},
+	}
+	if err := kube.Create(ctx, s); err != nil {
+		if !kerrors.IsAlreadyExists(err) {
+			return nil, errors.Wrap(err, errCreateWebhookTLS)
+		}
+		// another replica created the secret in the meantime
+		if err := kube.Get(ctx, nn, s); err != nil {
+			return nil, errors.Wrap(err, errGetWebhookTLSSecret)
+		}
+	}
+	return s, nil
+}
+
+// getWebhookConfigurations returns the webhook configurations of the
+// manifests, with the clients configured to call the webhook service in the
+// namespace and to trust the CA
+func getWebhookConfigurations(manifests []byte, namespace string, caBundle []byte) ([]client.Object, error) {
+	configs := make([]client.Object, 0, 2)
+	d := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifests), 4096)
+	for {
+		raw := map[string]interface{}{}
+		if err := d.Decode(&raw); err != nil {
+			if err == io.EOF {
+				return configs, nil
+			}
+			return nil, errors.Wrap(err, errDecodeWebhookConfigs)
+		}
+		if len(raw) == 0 {
+			continue
+		}
+		kind, _ := raw["kind"].(string)
+		switch kind {
+		case "MutatingWebhookConfiguration":
+			o := &admissionv1.MutatingWebhookConfiguration{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, o); err != nil {
+				return nil, errors.Wrap(err, errDecodeWebhookConfigs)
+			}
+			o.SetName(webhookName)
+			for i := range o.Webhooks {
+				setClientConfig(&o.Webhooks[i].ClientConfig, namespace, caBundle)
+			}
+			configs = append(configs, o)
+		case "ValidatingWebhookConfiguration":
+			o := &admissionv1.ValidatingWebhookConfiguration{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, o); err != nil {
+				return nil, errors.Wrap(err, errDecodeWebhookConfigs)
+			}
+			o.SetName(webhookName)
+			for i := range o.Webhooks {
+				setClientConfig(&o.Webhooks[i].ClientConfig, namespace, caBundle)
+			}
+			configs = append(configs, o)
+		default:
+			return nil, errors.Errorf("%s: unexpected kind %s", errDecodeWebhookConfigs, kind)
+		}
+	}
+}
+
+func setClientConfig(c *admissionv1.WebhookClientConfig, namespace string, caBundle []byte) {
+	path := ""
+	if c.Service != nil && c.Service.Path != nil {
+		path = *c.Service.Path
+	}
+	port := int32(webhookServicePort)
+	c.Service = &admissionv1.ServiceReference{
+		Namespace: namespace,
+		Name:      webhookName,
+		Path:      &path,
+		Port:      &port,
+	}
+	c.CABundle = caBundle
+}
+
+// newServingCertificate returns a self signed CA and a serving certificate
+// and key for the service signed by this CA, all pem encoded
+func newServingCertificate(r io.Reader, service, namespace string, now time.Time) (ca, cert, key []byte, err error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: service + "-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(webhookCertValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(r, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	servingKey, err := ecdsa.GenerateKey(elliptic.P256(), r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	dnsName := service + "." + namespace + ".svc"
+	servingTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{service, service + "." + namespace, dnsName, dnsName + ".cluster.local"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(webhookCertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	servingDER, err := x509.CreateCertificate(r, servingTemplate, caTemplate, &servingKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(servingKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: servingDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		nil
+}
+
+// writeFile writes the file through a temporary file that is renamed, such
+// that the certificate watcher of the webhook server never reads a partially
+// written file
+func writeFile(dir, name string, d []byte) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, "."+name+"-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name()) // nolint:errcheck
+	if _, err := f.Write(d); err != nil {
+		f.Close() // nolint:errcheck
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(dir, name))
+}
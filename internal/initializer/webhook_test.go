@@ -0,0 +1,164 @@
+/*
+Copyright 2021 NDD.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package initializer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testNamespace = "ndd-system"
+	testPodName   = "ndd-provider-sros-1234-abcd"
+	testRevision  = "ndd-provider-sros-1234"
+)
+
+func testProviderPod() *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Namespace: testNamespace,
+		Name:      testPodName,
+		Labels:    map[string]string{labelPackageRevision: testRevision},
+	}}
+}
+
+func newTestKube(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+}
+
+// TestWebhookConfigurations runs the initializer twice, as a second replica
+// or a restarted provider would, and checks that the certificate is served
+// from the secret, the service selects the provider pods and the webhook
+// configurations call the service with the CA of the certificate
+func TestWebhookConfigurations(t *testing.T) {
+	ctx := context.Background()
+	kube := newTestKube(t, testProviderPod())
+
+	var cert []byte
+	for i := 0; i < 2; i++ {
+		certDir := t.TempDir()
+		if err := NewWebhookConfigurations(testNamespace, testPodName, certDir).Run(ctx, kube); err != nil {
+			t.Fatalf("Run(): %v", err)
+		}
+		c, err := ioutil.ReadFile(filepath.Join(certDir, webhookTLSCert))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tls.LoadX509KeyPair(filepath.Join(certDir, webhookTLSCert), filepath.Join(certDir, webhookTLSKey)); err != nil {
+			t.Fatalf("invalid key pair in the certificate directory: %v", err)
+		}
+		if cert != nil && !bytes.Equal(c, cert) {
+			t.Error("a second run must serve the certificate of the secret")
+		}
+		cert = c
+	}
+
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: webhookTLSSecretName}, s); err != nil {
+		t.Fatal(err)
+	}
+	ca := s.Data[webhookTLSCA]
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		t.Fatal("the secret has no CA certificate")
+	}
+	kp, err := tls.X509KeyPair(s.Data[webhookTLSCert], s.Data[webhookTLSKey])
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(kp.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		DNSName: webhookName + "." + testNamespace + ".svc",
+		Roots:   pool,
+	}); err != nil {
+		t.Errorf("the serving certificate is not valid for the service: %v", err)
+	}
+
+	svc := &corev1.Service{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: webhookName}, svc); err != nil {
+		t.Fatal(err)
+	}
+	if got := svc.Spec.Selector[labelPackageRevision]; got != testRevision {
+		t.Errorf("service selects revision %q, want %q", got, testRevision)
+	}
+	if len(svc.Spec.Ports) != 1 || svc.Spec.Ports[0].TargetPort.IntValue() != 9443 {
+		t.Errorf("service must forward to the webhook server port, got %v", svc.Spec.Ports)
+	}
+
+	checkClientConfig := func(kind string, c admissionv1.WebhookClientConfig) {
+		t.Helper()
+		if c.Service == nil || c.Service.Name != webhookName || c.Service.Namespace != testNamespace {
+			t.Errorf("%s: webhook does not call the webhook service: %v", kind, c.Service)
+		}
+		if c.Service != nil && (c.Service.Path == nil || *c.Service.Path == "") {
+			t.Errorf("%s: webhook has no path", kind)
+		}
+		if !bytes.Equal(c.CABundle, ca) {
+			t.Errorf("%s: webhook does not trust the CA of the secret", kind)
+		}
+	}
+	m := &admissionv1.MutatingWebhookConfiguration{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: webhookName}, m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Webhooks) == 0 {
+		t.Error("no mutating webhooks")
+	}
+	for _, w := range m.Webhooks {
+		checkClientConfig("MutatingWebhookConfiguration", w.ClientConfig)
+	}
+	v := &admissionv1.ValidatingWebhookConfiguration{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: webhookName}, v); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Webhooks) == 0 {
+		t.Error("no validating webhooks")
+	}
+	for _, w := range v.Webhooks {
+		checkClientConfig("ValidatingWebhookConfiguration", w.ClientConfig)
+	}
+}
+
+func TestWebhookConfigurationsNoRevision(t *testing.T) {
+	pod := testProviderPod()
+	pod.SetLabels(nil)
+	kube := newTestKube(t, pod)
+	if err := NewWebhookConfigurations(testNamespace, testPodName, t.TempDir()).Run(context.Background(), kube); err == nil {
+		t.Error("Run(): a pod without revision label must fail")
+	}
+}